@@ -4,12 +4,22 @@ import (
 	// Import linters for registration side-effects.
 	// Each linter's register.go file contains an init() function
 	// that registers the linter with the global registry.
+	_ "github.com/DevSymphony/sym-cli/internal/linter/boundary"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/checkstyle"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/clippy"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/eslint"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/goanalysis"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/golangcilint"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/hadolint"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/pattern"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/pmd"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/prettier"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/pylint"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/rubocop"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/ruff"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/semgrep"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/shellcheck"
+	_ "github.com/DevSymphony/sym-cli/internal/linter/stylelint"
 	_ "github.com/DevSymphony/sym-cli/internal/linter/tsc"
 
 	// Import LLM providers for registration side-effects.
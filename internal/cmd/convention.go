@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/DevSymphony/sym-cli/internal/converter"
 	"github.com/DevSymphony/sym-cli/internal/policy"
 	"github.com/DevSymphony/sym-cli/internal/roles"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
@@ -14,31 +16,41 @@ import (
 
 // ConventionItem represents a convention for batch operations.
 type ConventionItem struct {
-	ID        string   `json:"id"`
-	Say       string   `json:"say"`
-	Category  string   `json:"category,omitempty"`
-	Languages []string `json:"languages,omitempty"`
-	Severity  string   `json:"severity,omitempty"`
-	Autofix   bool     `json:"autofix,omitempty"`
-	Message   string   `json:"message,omitempty"`
-	Example   string   `json:"example,omitempty"`
-	Include   []string `json:"include,omitempty"`
-	Exclude   []string `json:"exclude,omitempty"`
+	ID        string               `json:"id"`
+	Say       string               `json:"say"`
+	Category  string               `json:"category,omitempty"`
+	Languages []string             `json:"languages,omitempty"`
+	Severity  string               `json:"severity,omitempty"`
+	Autofix   bool                 `json:"autofix,omitempty"`
+	Message   string               `json:"message,omitempty"`
+	Example   string               `json:"example,omitempty"`
+	Examples  *schema.RuleExamples `json:"examples,omitempty"`
+	Include   []string             `json:"include,omitempty"`
+	Exclude   []string             `json:"exclude,omitempty"`
+	Params    map[string]any       `json:"params,omitempty"`
+	Engines   []string             `json:"engines,omitempty"`
+	Native    map[string]any       `json:"native,omitempty"`
+	NoLLM     bool                 `json:"noLlm,omitempty"`
 }
 
 // ConventionEditItem represents a convention edit for batch operations.
 type ConventionEditItem struct {
-	ID        string   `json:"id"`
-	NewID     string   `json:"new_id,omitempty"`
-	Say       string   `json:"say,omitempty"`
-	Category  string   `json:"category,omitempty"`
-	Languages []string `json:"languages,omitempty"`
-	Severity  string   `json:"severity,omitempty"`
-	Autofix   *bool    `json:"autofix,omitempty"`
-	Message   string   `json:"message,omitempty"`
-	Example   string   `json:"example,omitempty"`
-	Include   []string `json:"include,omitempty"`
-	Exclude   []string `json:"exclude,omitempty"`
+	ID        string               `json:"id"`
+	NewID     string               `json:"new_id,omitempty"`
+	Say       string               `json:"say,omitempty"`
+	Category  string               `json:"category,omitempty"`
+	Languages []string             `json:"languages,omitempty"`
+	Severity  string               `json:"severity,omitempty"`
+	Autofix   *bool                `json:"autofix,omitempty"`
+	Message   string               `json:"message,omitempty"`
+	Example   string               `json:"example,omitempty"`
+	Examples  *schema.RuleExamples `json:"examples,omitempty"`
+	Include   []string             `json:"include,omitempty"`
+	Exclude   []string             `json:"exclude,omitempty"`
+	Params    map[string]any       `json:"params,omitempty"`  // Merged into params; null removes a param
+	Engines   []string             `json:"engines,omitempty"` // Non-nil replaces the pinned engines; [] unpins
+	Native    map[string]any       `json:"native,omitempty"`  // Merged into native config; null removes an engine
+	NoLLM     *bool                `json:"noLlm,omitempty"`
 }
 
 var conventionCmd = &cobra.Command{
@@ -52,7 +64,8 @@ Available subcommands:
   list    - List all conventions
   add     - Add a new convention
   edit    - Edit an existing convention
-  remove  - Remove a convention`,
+  remove  - Remove a convention
+  test    - Check converted rules against their good/bad examples`,
 }
 
 var conventionListCmd = &cobra.Command{
@@ -79,6 +92,15 @@ var conventionAddCmd = &cobra.Command{
 
 Single mode:
   sym convention add NAMING-001 "Use snake_case for variables" --category naming --languages python --severity error
+  sym convention add STYLE-001 "Use const instead of var" --languages javascript --good "const a = 1;" --bad "var a = 1;"
+  sym convention add NO-CONSOLE "No console.log" --languages javascript --native 'eslint={"no-console":"error"}'
+  sym convention add SEC-001 "No hardcoded secrets" --engines semgrep --no-llm
+  sym convention add LEN-001 "Limit line length" --languages python --param max=120
+
+Routing overrides skip LLM routing for the rule:
+  --engines    Engines that enforce the rule
+  --native     engine=<json> native rule config, used as is (repeatable)
+  --no-llm     Never fall back to llm-validator
 
 Batch mode (JSON file):
   sym convention add -f conventions.json
@@ -91,7 +113,9 @@ Batch mode (JSON file):
       "category": "naming",
       "languages": ["python"],
       "severity": "error",
-      "message": "Variable names must use snake_case"
+      "message": "Variable names must use snake_case",
+      "params": {"min": 2},
+      "examples": {"good": ["user_name = 1"], "bad": ["userName = 1"]}
     }
   ]`,
 	Args: cobra.MaximumNArgs(2),
@@ -107,6 +131,10 @@ Single mode:
   sym convention edit NAMING-001 --say "Updated description" --severity warning
   sym convention edit NAMING-001 --id NAMING-002 --category style
   sym convention edit NAMING-001 --languages python,go
+  sym convention edit NAMING-001 --good "user_name = 1" --bad "userName = 1"
+  sym convention edit LEN-001 --param max=100
+  sym convention edit NO-CONSOLE --native 'eslint={"no-console":"warn"}'
+  sym convention edit NO-CONSOLE --native eslint= --engines "" --no-llm=false
 
 Batch mode (JSON file):
   sym convention edit -f edits.json
@@ -157,8 +185,15 @@ func init() {
 	conventionAddCmd.Flags().Bool("autofix", false, "Enable auto-fix")
 	conventionAddCmd.Flags().String("message", "", "Message to display on violation")
 	conventionAddCmd.Flags().String("example", "", "Code example")
+	conventionAddCmd.Flags().StringArray("good", nil, "Compliant code snippet (repeatable)")
+	conventionAddCmd.Flags().StringArray("bad", nil, "Violating code snippet (repeatable)")
+	conventionAddCmd.Flags().String("examples-language", "", "Language of the --good/--bad snippets")
 	conventionAddCmd.Flags().StringSlice("include", nil, "File patterns to include")
 	conventionAddCmd.Flags().StringSlice("exclude", nil, "File patterns to exclude")
+	conventionAddCmd.Flags().StringArray("param", nil, "Rule parameter as key=value, e.g. max=120 (repeatable, JSON values)")
+	conventionAddCmd.Flags().StringSlice("engines", nil, "Engines that enforce the rule, skipping LLM routing (comma-separated)")
+	conventionAddCmd.Flags().StringArray("native", nil, "Native rule config as engine=<json> (repeatable)")
+	conventionAddCmd.Flags().Bool("no-llm", false, "Never enforce the rule with llm-validator")
 
 	// Edit command flags
 	conventionEditCmd.Flags().StringP("file", "f", "", "JSON file with convention edits")
@@ -170,8 +205,15 @@ func init() {
 	conventionEditCmd.Flags().Bool("autofix", false, "Enable auto-fix")
 	conventionEditCmd.Flags().String("message", "", "New message to display on violation")
 	conventionEditCmd.Flags().String("example", "", "New code example")
+	conventionEditCmd.Flags().StringArray("good", nil, "Compliant code snippet, replaces the existing examples (repeatable)")
+	conventionEditCmd.Flags().StringArray("bad", nil, "Violating code snippet, replaces the existing examples (repeatable)")
+	conventionEditCmd.Flags().String("examples-language", "", "Language of the --good/--bad snippets")
 	conventionEditCmd.Flags().StringSlice("include", nil, "New file patterns to include")
 	conventionEditCmd.Flags().StringSlice("exclude", nil, "New file patterns to exclude")
+	conventionEditCmd.Flags().StringArray("param", nil, "Rule parameter as key=value, key= to remove (repeatable, JSON values)")
+	conventionEditCmd.Flags().StringSlice("engines", nil, "New pinned engines (comma-separated, empty to unpin)")
+	conventionEditCmd.Flags().StringArray("native", nil, "Native rule config as engine=<json>, engine= to remove (repeatable)")
+	conventionEditCmd.Flags().Bool("no-llm", false, "Never enforce the rule with llm-validator")
 
 	// Remove command flags
 	conventionRemoveCmd.Flags().StringP("file", "f", "", "JSON file with convention IDs to remove")
@@ -311,6 +353,16 @@ func runConventionAdd(cmd *cobra.Command, args []string) error {
 		example, _ := cmd.Flags().GetString("example")
 		include, _ := cmd.Flags().GetStringSlice("include")
 		exclude, _ := cmd.Flags().GetStringSlice("exclude")
+		engines, _ := cmd.Flags().GetStringSlice("engines")
+		noLLM, _ := cmd.Flags().GetBool("no-llm")
+		native, err := nativeFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		params, err := paramsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
 
 		conventions = []ConventionItem{{
 			ID:        args[0],
@@ -321,14 +373,20 @@ func runConventionAdd(cmd *cobra.Command, args []string) error {
 			Autofix:   autofix,
 			Message:   message,
 			Example:   example,
+			Examples:  examplesFromFlags(cmd),
 			Include:   include,
 			Exclude:   exclude,
+			Params:    policy.MergeRuleMap(nil, params),
+			Engines:   engines,
+			Native:    native,
+			NoLLM:     noLLM,
 		}}
 	}
 
 	var succeeded []string
 	var failed []string
 	var addedRules []schema.UserRule
+	engineChecker := newEngineChecker("")
 
 	// Process each convention
 	for _, conv := range conventions {
@@ -345,6 +403,11 @@ func runConventionAdd(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
+		if err := engineChecker.CheckEngines(schema.UserRule{ID: conv.ID, Engines: conv.Engines, Native: conv.Native}); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", conv.ID, err))
+			continue
+		}
+
 		rule := schema.UserRule{
 			ID:        conv.ID,
 			Say:       conv.Say,
@@ -354,8 +417,13 @@ func runConventionAdd(cmd *cobra.Command, args []string) error {
 			Autofix:   conv.Autofix,
 			Message:   conv.Message,
 			Example:   conv.Example,
+			Examples:  conv.Examples,
 			Include:   conv.Include,
 			Exclude:   conv.Exclude,
+			Params:    conv.Params,
+			Engines:   conv.Engines,
+			Native:    conv.Native,
+			NoLLM:     conv.NoLLM,
 		}
 
 		userPolicy.Rules = append(userPolicy.Rules, rule)
@@ -425,11 +493,26 @@ func runConventionEdit(cmd *cobra.Command, args []string) error {
 		example, _ := cmd.Flags().GetString("example")
 		include, _ := cmd.Flags().GetStringSlice("include")
 		exclude, _ := cmd.Flags().GetStringSlice("exclude")
+		native, err := nativeFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		params, err := paramsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		var engines []string
+		if cmd.Flags().Changed("engines") {
+			engines, _ = cmd.Flags().GetStringSlice("engines")
+			engines = nonEmpty(engines)
+		}
 
 		// Check if any edit flags were provided
 		hasChanges := newID != "" || say != "" || category != "" || len(languages) > 0 ||
 			severity != "" || message != "" || example != "" || len(include) > 0 || len(exclude) > 0 ||
-			cmd.Flags().Changed("autofix")
+			cmd.Flags().Changed("autofix") || examplesFromFlags(cmd) != nil ||
+			params != nil || engines != nil || native != nil || cmd.Flags().Changed("no-llm")
 
 		if !hasChanges {
 			return fmt.Errorf("at least one edit flag must be provided (--id, --say, --category, etc.)")
@@ -441,6 +524,12 @@ func runConventionEdit(cmd *cobra.Command, args []string) error {
 			autofix = &val
 		}
 
+		var noLLM *bool
+		if cmd.Flags().Changed("no-llm") {
+			val, _ := cmd.Flags().GetBool("no-llm")
+			noLLM = &val
+		}
+
 		edits = []ConventionEditItem{{
 			ID:        args[0],
 			NewID:     newID,
@@ -451,14 +540,20 @@ func runConventionEdit(cmd *cobra.Command, args []string) error {
 			Autofix:   autofix,
 			Message:   message,
 			Example:   example,
+			Examples:  examplesFromFlags(cmd),
 			Include:   include,
 			Exclude:   exclude,
+			Params:    params,
+			Engines:   engines,
+			Native:    native,
+			NoLLM:     noLLM,
 		}}
 	}
 
 	var succeeded []string
 	var failed []string
 	var editedRules []schema.UserRule
+	engineChecker := newEngineChecker("")
 
 	// Process each edit
 	for _, edit := range edits {
@@ -470,7 +565,9 @@ func runConventionEdit(cmd *cobra.Command, args []string) error {
 		// Check if at least one field to edit
 		hasEdit := edit.NewID != "" || edit.Say != "" || edit.Category != "" ||
 			len(edit.Languages) > 0 || edit.Severity != "" || edit.Autofix != nil ||
-			edit.Message != "" || edit.Example != "" || len(edit.Include) > 0 || len(edit.Exclude) > 0
+			edit.Message != "" || edit.Example != "" || edit.Examples != nil ||
+			len(edit.Include) > 0 || len(edit.Exclude) > 0 ||
+			edit.Params != nil || edit.Engines != nil || edit.Native != nil || edit.NoLLM != nil
 
 		if !hasEdit {
 			failed = append(failed, fmt.Sprintf("%s: at least one field to edit is required", edit.ID))
@@ -483,6 +580,12 @@ func runConventionEdit(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
+		// Native entries set to null are removals, which never need a registered engine
+		if err := engineChecker.CheckEngines(schema.UserRule{ID: edit.ID, Engines: edit.Engines, Native: policy.MergeRuleMap(nil, edit.Native)}); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", edit.ID, err))
+			continue
+		}
+
 		resultText := edit.ID
 
 		// If renaming ID
@@ -520,12 +623,27 @@ func runConventionEdit(cmd *cobra.Command, args []string) error {
 		if edit.Example != "" {
 			userPolicy.Rules[idx].Example = edit.Example
 		}
+		if edit.Examples != nil {
+			userPolicy.Rules[idx].Examples = edit.Examples
+		}
 		if len(edit.Include) > 0 {
 			userPolicy.Rules[idx].Include = edit.Include
 		}
 		if len(edit.Exclude) > 0 {
 			userPolicy.Rules[idx].Exclude = edit.Exclude
 		}
+		if edit.Params != nil {
+			userPolicy.Rules[idx].Params = policy.MergeRuleMap(userPolicy.Rules[idx].Params, edit.Params)
+		}
+		if edit.Engines != nil {
+			userPolicy.Rules[idx].Engines = nonEmpty(edit.Engines)
+		}
+		if edit.Native != nil {
+			userPolicy.Rules[idx].Native = policy.MergeRuleMap(userPolicy.Rules[idx].Native, edit.Native)
+		}
+		if edit.NoLLM != nil {
+			userPolicy.Rules[idx].NoLLM = *edit.NoLLM
+		}
 
 		editedRules = append(editedRules, userPolicy.Rules[idx])
 		succeeded = append(succeeded, resultText)
@@ -625,6 +743,84 @@ func runConventionRemove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// examplesFromFlags builds structured examples from --good, --bad and
+// --examples-language. Returns nil when none of them is set.
+func examplesFromFlags(cmd *cobra.Command) *schema.RuleExamples {
+	good, _ := cmd.Flags().GetStringArray("good")
+	bad, _ := cmd.Flags().GetStringArray("bad")
+	language, _ := cmd.Flags().GetString("examples-language")
+	if len(good) == 0 && len(bad) == 0 && language == "" {
+		return nil
+	}
+	return &schema.RuleExamples{Language: language, Good: good, Bad: bad}
+}
+
+// nativeFromFlags parses --native engine=<json> flags. An empty JSON value
+// maps the engine to nil, which removes its native config on edit. Returns
+// nil when the flag is not set.
+func nativeFromFlags(cmd *cobra.Command) (map[string]any, error) {
+	values, _ := cmd.Flags().GetStringArray("native")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	native := make(map[string]any, len(values))
+	for _, value := range values {
+		engine, config, ok := strings.Cut(value, "=")
+		if !ok || engine == "" {
+			return nil, fmt.Errorf("invalid --native %q: expected engine=<json>", value)
+		}
+		if strings.TrimSpace(config) == "" {
+			native[engine] = nil
+			continue
+		}
+		var v any
+		if err := json.Unmarshal([]byte(config), &v); err != nil {
+			return nil, fmt.Errorf("invalid --native config for %s: %w", engine, err)
+		}
+		native[engine] = v
+	}
+	return native, nil
+}
+
+// paramsFromFlags parses --param key=value flags. Values are decoded as JSON
+// (max=120 is a number) and fall back to plain text; an empty value maps the
+// key to nil, which removes the param on edit. Returns nil when the flag is not set.
+func paramsFromFlags(cmd *cobra.Command) (map[string]any, error) {
+	values, _ := cmd.Flags().GetStringArray("param")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	params := make(map[string]any, len(values))
+	for _, value := range values {
+		key, raw, ok := strings.Cut(value, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid --param %q: expected key=value", value)
+		}
+		key = strings.TrimSpace(key)
+		if strings.TrimSpace(raw) == "" {
+			params[key] = nil
+			continue
+		}
+		var v any
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			v = raw
+		}
+		params[key] = v
+	}
+	return params, nil
+}
+
+// nonEmpty drops empty strings, keeping a non-nil result.
+func nonEmpty(values []string) []string {
+	result := []string{}
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 // containsAny checks if haystack contains any of the needles.
 func containsAny(haystack, needles []string) bool {
 	for _, needle := range needles {
@@ -667,3 +863,13 @@ func printConventionBatchResult(action string, succeeded, failed []string) {
 		printWarn("No conventions to process")
 	}
 }
+
+// newEngineChecker returns a converter that only checks pinned engine names
+// against the registered linters and the policy's declarative plugins.
+func newEngineChecker(customPath string) *converter.Converter {
+	symDir := ".sym"
+	if policyPath, err := policy.GetPolicyPath(""); err == nil {
+		symDir = filepath.Dir(policyPath)
+	}
+	return converter.NewConverter(nil, symDir)
+}
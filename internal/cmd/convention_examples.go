@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/converter"
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/plugin"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/policy"
+	"github.com/DevSymphony/sym-cli/internal/validator"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/spf13/cobra"
+)
+
+var (
+	conventionTestLLM     bool
+	conventionTestInstall bool
+	conventionTestJSON    bool
+)
+
+var conventionTestCmd = &cobra.Command{
+	Use:   "test [ids...]",
+	Short: "Check converted rules against their good/bad examples",
+	Long: `Check that each converted rule behaves as its examples say.
+
+The good and bad examples of each convention (the "examples" field, or the
+✅/❌ markers of "example") are written to temporary files and checked with
+every engine the rule was converted to: each bad example must be reported
+and no good example may be. Results are reported per rule and engine.
+
+Linter engines use the rule's own config rebuilt from
+.sym/conversion-manifest.json. llm-validator rules are only checked with
+--llm, since every example is an LLM call. Linters that are not installed
+are skipped unless --install is given.
+
+Exits with an error if any engine fails its examples.`,
+	Example: `  # Test every convention with examples
+  sym convention test
+
+  # Test specific conventions, including llm-validator rules
+  sym convention test NAMING-001 STYLE-001 --llm
+
+  # Machine-readable results
+  sym convention test --json`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runConventionTest,
+}
+
+func init() {
+	conventionCmd.AddCommand(conventionTestCmd)
+
+	conventionTestCmd.Flags().BoolVar(&conventionTestLLM, "llm", false, "Also test llm-validator rules (one LLM call per example)")
+	conventionTestCmd.Flags().BoolVar(&conventionTestInstall, "install", false, "Install missing linters instead of skipping them")
+	conventionTestCmd.Flags().BoolVar(&conventionTestJSON, "json", false, "Output in JSON format")
+}
+
+func runConventionTest(cmd *cobra.Command, args []string) error {
+	policyPath, err := policy.GetPolicyPath("")
+	if err != nil {
+		return fmt.Errorf("failed to find policy: %w", err)
+	}
+	symDir := filepath.Dir(policyPath)
+
+	userPolicy, err := policy.NewLoader(verbose).ResolveUserPolicy(policyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load user policy: %w", err)
+	}
+	codePolicy, err := policy.NewLoader(verbose).LoadCodePolicy(filepath.Join(symDir, "code-policy.json"))
+	if err != nil {
+		return fmt.Errorf("failed to load code policy: %w\nRun 'sym convert' first", err)
+	}
+
+	rules, err := selectConventions(userPolicy.Rules, args)
+	if err != nil {
+		return err
+	}
+
+	conv := converter.NewConverter(nil, symDir)
+	if changed, ok := conv.ChangedRules(userPolicy); ok {
+		if stale := intersectIDs(changed, rules); len(stale) > 0 {
+			msg := fmt.Sprintf("Changed since the last conversion, results may be outdated: %s (run 'sym convert')", strings.Join(stale, ", "))
+			if conventionTestJSON {
+				fmt.Fprintln(os.Stderr, msg)
+			} else {
+				printWarn(msg)
+			}
+		}
+	}
+
+	opts := validator.ExampleOptions{SymDir: symDir, Install: conventionTestInstall}
+	if conventionTestLLM {
+		cfg := llm.LoadConfig()
+		cfg.Verbose = verbose
+		provider, err := llm.New(cfg)
+		if err != nil {
+			return fmt.Errorf("no available LLM backend for --llm: %w", err)
+		}
+		defer func() { _ = provider.Close() }()
+		opts.LLMProvider = provider
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	var results []*validator.ExampleResult
+	for _, rule := range rules {
+		examples := linter.Examples(rule)
+		if len(examples.Good) == 0 && len(examples.Bad) == 0 {
+			continue
+		}
+
+		tested := false
+		for _, policyRule := range codePolicy.Rules {
+			engine, _ := policyRule.Check["engine"].(string)
+			if engine == "" || policyRule.ID != rule.ID+"-"+engine {
+				continue
+			}
+			tested = true
+
+			if engine == "llm-validator" && !conventionTestLLM {
+				results = append(results, &validator.ExampleResult{RuleID: policyRule.ID, Engine: engine, Skipped: "llm-validator needs --llm"})
+				continue
+			}
+			if !conventionTestJSON {
+				fmt.Printf("Testing %s with %s...\n", rule.ID, engine)
+			}
+			results = append(results, validator.TestExamples(ctx, exampleTest(conv, symDir, rule, policyRule, engine, examples), opts))
+		}
+		if !tested {
+			results = append(results, &validator.ExampleResult{RuleID: rule.ID, Skipped: "not converted (run 'sym convert')"})
+		}
+	}
+
+	return reportExampleResults(results)
+}
+
+// selectConventions returns the rules with the given IDs, or all rules.
+func selectConventions(rules []schema.UserRule, ids []string) ([]schema.UserRule, error) {
+	if len(ids) == 0 {
+		return rules, nil
+	}
+	byID := make(map[string]schema.UserRule, len(rules))
+	for _, rule := range rules {
+		byID[rule.ID] = rule
+	}
+	var selected []schema.UserRule
+	for _, id := range ids {
+		rule, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("convention not found: %s", id)
+		}
+		selected = append(selected, rule)
+	}
+	return selected, nil
+}
+
+// intersectIDs returns the IDs of rules that appear in ids.
+func intersectIDs(ids []string, rules []schema.UserRule) []string {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	var result []string
+	for _, rule := range rules {
+		if set[rule.ID] {
+			result = append(result, rule.ID)
+		}
+	}
+	return result
+}
+
+// exampleTest builds the example run of a rule on one engine. Linters use the
+// rule's own config from the conversion manifest, falling back to the
+// engine's generated config filtered by the rule's native rule IDs.
+func exampleTest(conv *converter.Converter, symDir string, rule schema.UserRule, policyRule schema.PolicyRule, engine string, examples schema.RuleExamples) validator.ExampleTest {
+	var candidates []string
+	if policyRule.When != nil {
+		candidates = policyRule.When.Languages
+	}
+
+	test := validator.ExampleTest{Rule: policyRule, Examples: examples}
+	if engine != "llm-validator" {
+		registry, _, _ := plugin.NewRegistry(linter.Global(), symDir)
+		if l, err := registry.GetLinter(engine); err == nil {
+			candidates = supportedLanguages(candidates, l.GetCapabilities().SupportedLanguages)
+		}
+		if config, nativeRuleIDs, ok := conv.RuleConfig(rule.ID, engine); ok {
+			test.Config = config.Content
+			test.Rule.Check = map[string]interface{}{"engine": engine, "ruleIds": nativeRuleIDs}
+		} else if configFile := registry.GetConfigFile(engine); configFile != "" {
+			test.Config, _ = os.ReadFile(filepath.Join(symDir, configFile))
+		}
+	}
+	test.Language = linter.ExampleLanguage(rule, candidates)
+	return test
+}
+
+// supportedLanguages keeps the languages a linter supports. A linter without
+// declared languages supports all of them.
+func supportedLanguages(languages, supported []string) []string {
+	if len(supported) == 0 {
+		return languages
+	}
+	var result []string
+	for _, lang := range languages {
+		if containsAny(supported, []string{lang}) {
+			result = append(result, lang)
+		}
+	}
+	return result
+}
+
+// reportExampleResults prints results and returns an error if any engine
+// failed its examples.
+func reportExampleResults(results []*validator.ExampleResult) error {
+	failed := 0
+	for _, r := range results {
+		if r.Skipped == "" && !r.Passed() {
+			failed++
+		}
+	}
+
+	if conventionTestJSON {
+		if results == nil {
+			results = []*validator.ExampleResult{}
+		}
+		if err := printJSON(results); err != nil {
+			return err
+		}
+	} else {
+		if len(results) == 0 {
+			printWarn("No conventions with examples")
+			fmt.Println("Add examples with 'sym convention edit <id> --good <code> --bad <code>'")
+			return nil
+		}
+		fmt.Println()
+		for _, r := range results {
+			name := r.RuleID
+			if r.Engine != "" {
+				name = fmt.Sprintf("%s (%s)", strings.TrimSuffix(r.RuleID, "-"+r.Engine), r.Engine)
+			}
+			switch {
+			case r.Skipped != "":
+				printWarn(fmt.Sprintf("%s: skipped, %s", name, r.Skipped))
+			case r.Error != "":
+				printError(fmt.Sprintf("%s: %s", name, r.Error))
+			case !r.Passed():
+				printError(fmt.Sprintf("%s: %s", name, strings.Join(r.Failures(), ", ")))
+			default:
+				printOK(fmt.Sprintf("%s: %d example(s) passed", name, len(r.Cases)))
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d rule engine(s) failed their examples", failed)
+	}
+	return nil
+}
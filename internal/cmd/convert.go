@@ -2,14 +2,16 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/DevSymphony/sym-cli/internal/converter"
 	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/policy"
 	"github.com/DevSymphony/sym-cli/internal/util/config"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +19,12 @@ import (
 var (
 	convertInputFile string
 	convertOutputDir string
+	convertPackage   string
+	convertAll       bool
+	convertFull      bool
+	convertVerify    bool
+	convertDryRun    bool
+	convertDiff      bool
 )
 
 var convertCmd = &cobra.Command{
@@ -27,21 +35,74 @@ into linter-specific configurations and internal validation schema (Schema B).
 
 The conversion uses language-based routing with LLM inference to determine
 which linters apply to each rule. Supported linters include ESLint, Prettier,
-Pylint, TSC, Checkstyle, and PMD.`,
+Pylint, TSC, Checkstyle, and PMD.
+
+In a monorepo, packages can have their own .sym directory. Use --package to
+convert one package's .sym/user-policy.json into that .sym directory, or --all
+to convert every package that has a user policy.
+
+Conversion is incremental: .sym/conversion-manifest.json records each rule's
+content hash with its routing and conversion results, and only new or changed
+rules are sent to the LLM. Use --full to reconvert every rule.
+
+With --verify-examples, each fresh linter conversion is checked against the
+rule's good/bad examples; a conversion that misses a bad example or flags a
+good one falls back to llm-validator. Use 'sym convention test' to check an
+existing conversion.
+
+With --dry-run, nothing is written: the routing of each rule (linters, the
+LLM's reasoning and llm-validator fallbacks) and the files that would change
+are printed for review. --diff also prints a unified diff of each changed
+file against the existing output. A dry run still calls the LLM for new or
+changed rules, and does not update the conversion manifest.`,
 	Example: `  # Convert policy (outputs to .sym directory)
   sym convert -i user-policy.json
 
   # Convert with custom output directory
-  sym convert -i user-policy.json -o ./custom-dir`,
+  sym convert -i user-policy.json -o ./custom-dir
+
+  # Convert the policy of a monorepo package (services/api/.sym)
+  sym convert --package services/api
+
+  # Convert every package with a user policy
+  sym convert --all
+
+  # Ignore cached conversions and reconvert every rule
+  sym convert --full
+
+  # Fall back to llm-validator when a conversion fails its examples
+  sym convert --verify-examples
+
+  # Review routing and changed files without writing anything
+  sym convert --dry-run
+
+  # Review the full diff of the generated configs
+  sym convert --diff`,
 	RunE: runConvert,
 }
 
 func init() {
 	convertCmd.Flags().StringVarP(&convertInputFile, "input", "i", "", "input user policy file (default: from .sym/config.json)")
 	convertCmd.Flags().StringVarP(&convertOutputDir, "output-dir", "o", "", "output directory for linter configs (default: .sym)")
+	convertCmd.Flags().StringVar(&convertPackage, "package", "", "convert the .sym policy of a package directory (relative to the repository root)")
+	convertCmd.Flags().BoolVar(&convertAll, "all", false, "convert the policies of all packages with a .sym/user-policy.json")
+	convertCmd.Flags().BoolVar(&convertFull, "full", false, "reconvert every rule instead of only new or changed ones")
+	convertCmd.Flags().BoolVar(&convertVerify, "verify-examples", false, "check converted rules against their good/bad examples (installed linters only)")
+	convertCmd.Flags().BoolVar(&convertDryRun, "dry-run", false, "show routing decisions and changed files without writing anything")
+	convertCmd.Flags().BoolVar(&convertDiff, "diff", false, "like --dry-run, and print a diff of each changed file")
 }
 
 func runConvert(cmd *cobra.Command, args []string) error {
+	if convertPackage != "" || convertAll {
+		if convertInputFile != "" || convertOutputDir != "" {
+			return fmt.Errorf("--package and --all cannot be combined with --input or --output-dir")
+		}
+		if convertPackage != "" && convertAll {
+			return fmt.Errorf("--package cannot be combined with --all")
+		}
+		return runConvertPackages()
+	}
+
 	// Determine input file path
 	if convertInputFile == "" {
 		// Load from config.json
@@ -54,30 +115,75 @@ func runConvert(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Using policy path from config: %s\n", convertInputFile)
 	}
 
-	// Read input file
-	data, err := os.ReadFile(convertInputFile)
+	// Read input file with inherited (extends) policies merged
+	userPolicy, err := policy.NewLoader(verbose).ResolveUserPolicy(convertInputFile)
 	if err != nil {
-		return fmt.Errorf("failed to read input file: %w", err)
-	}
-
-	var userPolicy schema.UserPolicy
-	if err := json.Unmarshal(data, &userPolicy); err != nil {
-		return fmt.Errorf("failed to parse user policy: %w", err)
+		return fmt.Errorf("failed to load user policy: %w", err)
 	}
 
 	fmt.Printf("Loaded user policy with %d rules\n", len(userPolicy.Rules))
 
-	// Use new converter by default (language-based routing with parallel LLM)
-	return runNewConverter(&userPolicy)
-}
-
-func runNewConverter(userPolicy *schema.UserPolicy) error {
 	// Determine output directory
 	if convertOutputDir == "" {
 		// Default to .sym directory
 		convertOutputDir = ".sym"
 	}
 
+	// Use new converter by default (language-based routing with parallel LLM)
+	return runNewConverter(userPolicy, convertOutputDir)
+}
+
+// runConvertPackages converts the user policy of the --package directory,
+// or of every package with a user policy when --all is set.
+func runConvertPackages() error {
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return fmt.Errorf("failed to find git repository: %w", err)
+	}
+
+	packages, err := policy.FindPackages(repoRoot)
+	if err != nil {
+		return fmt.Errorf("failed to find .sym directories: %w", err)
+	}
+
+	var targets []policy.Package
+	if convertAll {
+		for _, pkg := range packages {
+			if pkg.HasUserPolicy() {
+				targets = append(targets, pkg)
+			}
+		}
+		if len(targets) == 0 {
+			return fmt.Errorf("no .sym/user-policy.json found in %s", repoRoot)
+		}
+	} else {
+		pkg, ok := policy.FindPackage(packages, convertPackage)
+		if !ok || !pkg.HasUserPolicy() {
+			return fmt.Errorf("no user policy for package %s: create %s first", convertPackage, filepath.Join(convertPackage, ".sym", "user-policy.json"))
+		}
+		targets = append(targets, pkg)
+	}
+
+	loader := policy.NewLoader(verbose)
+	for i, pkg := range targets {
+		if i > 0 {
+			fmt.Println()
+		}
+		userPolicy, err := loader.ResolveUserPolicy(pkg.UserPolicyPath())
+		if err != nil {
+			return fmt.Errorf("package %s: %w", pkg.Dir, err)
+		}
+
+		fmt.Printf("📦 Package %s: %d rules\n", pkg.Dir, len(userPolicy.Rules))
+		if err := runNewConverter(userPolicy, pkg.SymDir); err != nil {
+			return fmt.Errorf("package %s: %w", pkg.Dir, err)
+		}
+	}
+
+	return nil
+}
+
+func runNewConverter(userPolicy *schema.UserPolicy, outputDir string) error {
 	// Create LLM provider
 	cfg := llm.LoadConfig()
 	cfg.Verbose = verbose
@@ -88,14 +194,18 @@ func runNewConverter(userPolicy *schema.UserPolicy) error {
 	defer func() { _ = llmProvider.Close() }()
 
 	// Create new converter
-	conv := converter.NewConverter(llmProvider, convertOutputDir)
+	conv := converter.NewConverter(llmProvider, outputDir)
+	conv.SetFullConversion(convertFull)
+	conv.SetVerifyExamples(convertVerify)
+	dryRun := convertDryRun || convertDiff
+	conv.SetDryRun(dryRun)
 
 	// Setup context with generous timeout for parallel processing (10 minutes to match validator)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 	defer cancel()
 
 	printTitle("Convert", "Language-based routing with parallel LLM inference")
-	fmt.Printf("Output: %s\n\n", convertOutputDir)
+	fmt.Printf("Output: %s\n\n", outputDir)
 
 	// Convert
 	result, err := conv.Convert(ctx, userPolicy)
@@ -103,13 +213,26 @@ func runNewConverter(userPolicy *schema.UserPolicy) error {
 		return fmt.Errorf("conversion failed: %w", err)
 	}
 
+	if dryRun {
+		return printConvertPreview(result)
+	}
+
 	// Print results
 	fmt.Println()
 	printOK("Conversion completed successfully")
+	if len(result.ReusedRules) > 0 {
+		fmt.Printf("Reused %d unchanged rule(s) from the conversion manifest\n", len(result.ReusedRules))
+	}
 	fmt.Printf("Generated %d configuration file(s):\n", len(result.GeneratedFiles))
 	for _, file := range result.GeneratedFiles {
 		fmt.Printf("  - %s\n", file)
 	}
+	if len(result.RemovedFiles) > 0 {
+		fmt.Printf("Removed %d stale configuration file(s):\n", len(result.RemovedFiles))
+		for _, file := range result.RemovedFiles {
+			fmt.Printf("  - %s\n", file)
+		}
+	}
 
 	if len(result.Errors) > 0 {
 		fmt.Println()
@@ -129,3 +252,97 @@ func runNewConverter(userPolicy *schema.UserPolicy) error {
 
 	return nil
 }
+
+// printConvertPreview prints the routing decisions and file changes of a dry run.
+func printConvertPreview(result *converter.ConvertResult) error {
+	changes, err := converter.DiffFiles(result.Files, result.RemovedFiles)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	printTitle("Routing", fmt.Sprintf("%d rule(s)", len(result.Routing)))
+	for _, decision := range result.Routing {
+		linters := strings.Join(decision.Linters, ", ")
+		if linters == "" {
+			linters = "(none)"
+		}
+		cached := ""
+		if decision.Cached {
+			cached = " (unchanged)"
+		}
+		fmt.Printf("  %s %s → %s%s\n", colorize(bold, "•"), colorize(cyan, decision.RuleID), linters, cached)
+		if decision.Reason != "" {
+			fmt.Printf("    reason: %s\n", decision.Reason)
+		}
+		for _, linterName := range sortedKeys(decision.Fallbacks) {
+			fmt.Printf("    %s\n", colorize(yellow, fmt.Sprintf("%s → llm-validator: %s", linterName, decision.Fallbacks[linterName])))
+		}
+	}
+
+	fmt.Println()
+	changed := 0
+	for _, change := range changes {
+		if change.Status != converter.FileUnchanged {
+			changed++
+		}
+	}
+	printTitle("Files", fmt.Sprintf("%d of %d would change (dry run, nothing written)", changed, len(changes)))
+	for _, change := range changes {
+		switch change.Status {
+		case converter.FileAdded:
+			fmt.Printf("  %s %s (new)\n", colorize(green, "+"), change.Path)
+		case converter.FileModified:
+			fmt.Printf("  %s %s (modified)\n", colorize(yellow, "~"), change.Path)
+		case converter.FileRemoved:
+			fmt.Printf("  %s %s (removed)\n", colorize(red, "-"), change.Path)
+		default:
+			fmt.Printf("  = %s (unchanged)\n", change.Path)
+		}
+	}
+
+	if convertDiff {
+		for _, change := range changes {
+			if change.Diff == "" {
+				continue
+			}
+			fmt.Println()
+			printDiff(change.Diff)
+		}
+	}
+
+	if len(result.Errors) > 0 {
+		fmt.Println()
+		printWarn(fmt.Sprintf("Errors (%d):", len(result.Errors)))
+		for linter, err := range result.Errors {
+			fmt.Printf("  - %s: %v\n", linter, err)
+		}
+	}
+
+	if len(result.Warnings) > 0 {
+		fmt.Println()
+		printWarn(fmt.Sprintf("Warnings (%d):", len(result.Warnings)))
+		for _, warning := range result.Warnings {
+			fmt.Printf("  - %s\n", warning)
+		}
+	}
+	return nil
+}
+
+// printDiff prints a unified diff with added and removed lines colored.
+func printDiff(diff string) {
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			fmt.Println(colorize(bold, line))
+		case strings.HasPrefix(line, "+"):
+			fmt.Println(colorize(green, line))
+		case strings.HasPrefix(line, "-"):
+			fmt.Println(colorize(red, line))
+		case strings.HasPrefix(line, "@@"):
+			fmt.Println(colorize(cyan, line))
+		default:
+			fmt.Println(line)
+		}
+	}
+}
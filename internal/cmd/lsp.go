@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/lsp"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/spf13/cobra"
+)
+
+var lspPolicyFile string
+
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Start Language Server Protocol server for editor diagnostics",
+	Long: `Start a Language Server Protocol (LSP) server over stdio.
+
+Editors show Symphony convention violations inline:
+- Linter rules run when a file is opened or saved and are published as diagnostics
+  (rule ID, severity, message)
+- LLM rules run on demand via the "Run Symphony LLM rules" code action
+  (command: sym.runLLMRules)
+- Rules with autofix enabled offer a quick fix (command: sym.applyRemedy)
+
+Each file is validated with the code policy of its nearest .sym package.
+Saving a code-policy.json reloads the policies.`,
+	Example: `  sym lsp
+  sym lsp --policy .sym/code-policy.json`,
+	RunE: runLSP,
+}
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+
+	lspCmd.Flags().StringVarP(&lspPolicyFile, "policy", "p", "", "Path to code-policy.json for the root package (default: each package's .sym/code-policy.json)")
+}
+
+func runLSP(cmd *cobra.Command, args []string) error {
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return fmt.Errorf("not in a git repository: %w", err)
+	}
+
+	policyPath := lspPolicyFile
+	if policyPath != "" {
+		if policyPath, err = filepath.Abs(policyPath); err != nil {
+			return fmt.Errorf("failed to resolve policy path: %w", err)
+		}
+	}
+
+	// Linters and git operations run relative to the project root
+	if err := os.Chdir(repoRoot); err != nil {
+		return fmt.Errorf("failed to change to project root: %w", err)
+	}
+
+	server, err := lsp.NewServer(repoRoot, policyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load code policy: %w\nRun 'sym convert' first", err)
+	}
+
+	// stdout carries the protocol, so warnings go to stderr
+	llmProvider, err := llm.New(llm.LoadConfig())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sym lsp: LLM rules disabled: %v\n", err)
+	} else {
+		defer func() { _ = llmProvider.Close() }()
+		server.SetLLMProvider(llmProvider)
+	}
+
+	return server.Serve(context.Background(), os.Stdin, os.Stdout)
+}
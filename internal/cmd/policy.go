@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/DevSymphony/sym-cli/internal/policy"
 	"github.com/DevSymphony/sym-cli/internal/util/config"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
 
 	"github.com/spf13/cobra"
 )
@@ -18,7 +20,8 @@ var policyCmd = &cobra.Command{
 Commands:
   sym policy path           # Show current policy file path
   sym policy path --set PATH  # Set policy file path
-  sym policy validate        # Validate policy file`,
+  sym policy validate        # Validate policy file
+  sym policy resolve         # Print effective policy (extends merged)`,
 }
 
 var policyPathCmd = &cobra.Command{
@@ -35,6 +38,20 @@ var policyValidateCmd = &cobra.Command{
 	Run:   runPolicyValidate,
 }
 
+var policyResolveCmd = &cobra.Command{
+	Use:   "resolve [file]",
+	Short: "Print the effective policy with inherited policies merged",
+	Long: `Print the effective user policy as JSON after resolving "extends".
+
+Parent policies are merged in order and the policy itself is merged on top:
+rules with the same ID replace inherited rules, categories merge by name, and
+rule IDs listed in "disable" are dropped from the inherited rules.`,
+	Example: `  sym policy resolve
+  sym policy resolve services/api/.sym/user-policy.json`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runPolicyResolve,
+}
+
 var (
 	policyPathSet string
 )
@@ -44,6 +61,7 @@ func init() {
 
 	policyCmd.AddCommand(policyPathCmd)
 	policyCmd.AddCommand(policyValidateCmd)
+	policyCmd.AddCommand(policyResolveCmd)
 }
 
 func runPolicyPath(cmd *cobra.Command, args []string) {
@@ -90,7 +108,7 @@ func runPolicyPath(cmd *cobra.Command, args []string) {
 }
 
 func runPolicyValidate(cmd *cobra.Command, args []string) {
-	cfg, err := config.LoadConfig()
+	cfg, err := config.LoadProjectConfig()
 	if err != nil {
 		printError(fmt.Sprintf("Failed to load config: %v", err))
 		os.Exit(1)
@@ -109,10 +127,27 @@ func runPolicyValidate(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
+	engineChecker := newEngineChecker(cfg.PolicyPath)
+	for _, rule := range policyData.Rules {
+		if err := engineChecker.CheckEngines(rule); err != nil {
+			printError(fmt.Sprintf("Validation failed: rule %s: %v", rule.ID, err))
+			os.Exit(1)
+		}
+	}
+
 	printOK("Policy file is valid")
 	fmt.Printf("  Version: %s\n", policyData.Version)
 	fmt.Printf("  Rules: %d\n", len(policyData.Rules))
 
+	if len(policyData.Extends) > 0 {
+		resolved, err := resolveConfiguredPolicy(cfg.PolicyPath)
+		if err != nil {
+			printError(fmt.Sprintf("Failed to resolve extends: %v", err))
+			os.Exit(1)
+		}
+		fmt.Printf("  Extends: %v (%d effective rules)\n", policyData.Extends, len(resolved.Rules))
+	}
+
 	if policyData.RBAC != nil {
 		fmt.Printf("  RBAC roles: %d\n", len(policyData.RBAC.Roles))
 	}
@@ -123,3 +158,36 @@ func runPolicyValidate(cmd *cobra.Command, args []string) {
 		}
 	}
 }
+
+func runPolicyResolve(cmd *cobra.Command, args []string) {
+	var resolved *schema.UserPolicy
+	var err error
+	if len(args) > 0 {
+		resolved, err = policy.NewLoader(verbose).ResolveUserPolicy(args[0])
+	} else {
+		var projectCfg *config.ProjectConfig
+		if projectCfg, err = config.LoadProjectConfig(); err == nil {
+			resolved, err = resolveConfiguredPolicy(projectCfg.PolicyPath)
+		}
+	}
+	if err != nil {
+		printError(fmt.Sprintf("Failed to resolve policy: %v", err))
+		os.Exit(1)
+	}
+
+	data, err := json.MarshalIndent(resolved, "", "  ")
+	if err != nil {
+		printError(fmt.Sprintf("Failed to encode policy: %v", err))
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
+
+// resolveConfiguredPolicy resolves the configured user policy with its extends merged.
+func resolveConfiguredPolicy(customPath string) (*schema.UserPolicy, error) {
+	policyPath, err := policy.GetPolicyPath(customPath)
+	if err != nil {
+		return nil, err
+	}
+	return policy.NewLoader(verbose).ResolveUserPolicy(policyPath)
+}
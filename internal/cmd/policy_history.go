@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/policy"
+	"github.com/DevSymphony/sym-cli/internal/util/config"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/spf13/cobra"
+)
+
+var (
+	policyDiffJSON bool
+	policyLogJSON  bool
+	policyLogLimit int
+)
+
+var policyDiffCmd = &cobra.Command{
+	Use:   "diff [revision | old-file new-file]",
+	Short: "Show rule and category changes between two user policies",
+	Long: `Show a semantic diff of two user policies: added, removed and changed
+rules (by ID) and categories (by name), with the changed fields.
+
+With no argument the working tree policy is compared to HEAD; with one
+argument it is compared to that git revision. With two arguments the two
+policy files are compared.`,
+	Example: `  sym policy diff
+  sym policy diff main~3
+  sym policy diff old-policy.json .sym/user-policy.json
+  sym policy diff --json`,
+	Args: cobra.MaximumNArgs(2),
+	RunE: runPolicyDiff,
+}
+
+var policyLogCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Summarize rule changes in the git history of the user policy",
+	Long: `Walk the git history of user-policy.json and summarize the rules and
+categories added, removed and changed in each commit.`,
+	Example: `  sym policy log
+  sym policy log -n 5
+  sym policy log --json`,
+	Args: cobra.NoArgs,
+	RunE: runPolicyLog,
+}
+
+// policyLogEntry is one commit of `sym policy log`.
+type policyLogEntry struct {
+	Commit  string             `json:"commit"`
+	Author  string             `json:"author"`
+	Date    string             `json:"date"`
+	Subject string             `json:"subject"`
+	Diff    *policy.PolicyDiff `json:"diff"`
+}
+
+func init() {
+	policyDiffCmd.Flags().BoolVar(&policyDiffJSON, "json", false, "Output in JSON format")
+	policyLogCmd.Flags().BoolVar(&policyLogJSON, "json", false, "Output in JSON format")
+	policyLogCmd.Flags().IntVarP(&policyLogLimit, "max-count", "n", 0, "Limit the number of commits (0 = all)")
+
+	policyCmd.AddCommand(policyDiffCmd)
+	policyCmd.AddCommand(policyLogCmd)
+}
+
+func runPolicyDiff(cmd *cobra.Command, args []string) error {
+	var oldPolicy, newPolicy *schema.UserPolicy
+	var oldLabel, newLabel string
+
+	if len(args) == 2 {
+		loader := policy.NewLoader(verbose)
+		var err error
+		if oldPolicy, err = loader.LoadUserPolicy(args[0]); err != nil {
+			return fmt.Errorf("failed to load %s: %w", args[0], err)
+		}
+		if newPolicy, err = loader.LoadUserPolicy(args[1]); err != nil {
+			return fmt.Errorf("failed to load %s: %w", args[1], err)
+		}
+		oldLabel, newLabel = args[0], args[1]
+	} else {
+		rev := "HEAD"
+		if len(args) == 1 {
+			rev = args[0]
+		}
+
+		if _, err := git.ResolveRevision(rev); err != nil {
+			return err
+		}
+		policyPath, relPath, err := policyGitPath()
+		if err != nil {
+			return err
+		}
+		if oldPolicy, err = loadPolicyAtRevision(rev, relPath); err != nil {
+			return err
+		}
+		if newPolicy, err = loadWorkingPolicy(policyPath); err != nil {
+			return err
+		}
+		oldLabel, newLabel = rev, "working tree"
+	}
+
+	diff := policy.DiffUserPolicies(oldPolicy, newPolicy)
+
+	if policyDiffJSON {
+		return printJSON(diff)
+	}
+
+	fmt.Printf("Policy diff: %s → %s (%s)\n", oldLabel, newLabel, diff.Summary())
+	if diff.IsEmpty() {
+		fmt.Println("No rule or category changes")
+		return nil
+	}
+	fmt.Println()
+	printPolicyDiff(diff, true)
+	return nil
+}
+
+func runPolicyLog(cmd *cobra.Command, args []string) error {
+	_, relPath, err := policyGitPath()
+	if err != nil {
+		return err
+	}
+
+	commits, err := git.FileHistory(relPath, policyLogLimit)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]policyLogEntry, 0, len(commits))
+	for _, commit := range commits {
+		newPolicy, err := loadPolicyAtRevision(commit.Hash, relPath)
+		if err != nil {
+			return err
+		}
+		oldPolicy, err := loadPolicyAtRevision(commit.Hash+"^", relPath)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, policyLogEntry{
+			Commit:  commit.Hash,
+			Author:  commit.Author,
+			Date:    commit.Date.Format("2006-01-02"),
+			Subject: commit.Subject,
+			Diff:    policy.DiffUserPolicies(oldPolicy, newPolicy),
+		})
+	}
+
+	if policyLogJSON {
+		return printJSON(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No commits found for %s\n", relPath)
+		return nil
+	}
+
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s  %s  %s  %s\n", colorize(yellow, entry.Commit[:7]), entry.Date, entry.Author, entry.Subject)
+		fmt.Printf("  %s\n", entry.Diff.Summary())
+		printPolicyDiff(entry.Diff, false)
+	}
+	return nil
+}
+
+// printPolicyDiff prints rule and category changes; detailed adds changed field values.
+func printPolicyDiff(diff *policy.PolicyDiff, detailed bool) {
+	if len(diff.Rules) > 0 && detailed {
+		fmt.Println("Rules")
+	}
+	for _, rule := range diff.Rules {
+		line := fmt.Sprintf("  %s %s", changeMarker(rule.Kind), rule.ID)
+		switch {
+		case detailed && rule.Say != "":
+			line += "  " + rule.Say
+		case !detailed && rule.Kind == policy.ChangeChanged:
+			line += fmt.Sprintf(" (%s)", strings.Join(fieldNames(rule.Fields), ", "))
+		}
+		fmt.Println(line)
+		if detailed {
+			printFieldChanges(rule.Fields)
+		}
+	}
+
+	if len(diff.Categories) > 0 && detailed {
+		fmt.Println("Categories")
+	}
+	for _, cat := range diff.Categories {
+		prefix := ""
+		if !detailed {
+			prefix = "category "
+		}
+		fmt.Printf("  %s %s%s\n", changeMarker(cat.Kind), prefix, cat.Name)
+		if detailed {
+			printFieldChanges(cat.Fields)
+		}
+	}
+}
+
+func printFieldChanges(fields []policy.FieldChange) {
+	for _, f := range fields {
+		fmt.Printf("      %s: %s → %s\n", f.Field, formatFieldChangeValue(f.Old), formatFieldChangeValue(f.New))
+	}
+}
+
+// changeMarker returns the colored +/~/- marker of a change kind.
+func changeMarker(kind policy.ChangeKind) string {
+	switch kind {
+	case policy.ChangeAdded:
+		return colorize(green, "+")
+	case policy.ChangeRemoved:
+		return colorize(red, "-")
+	default:
+		return colorize(yellow, "~")
+	}
+}
+
+func fieldNames(fields []policy.FieldChange) []string {
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.Field)
+	}
+	return names
+}
+
+func formatFieldChangeValue(value string) string {
+	if value == "" {
+		return "(unset)"
+	}
+	return value
+}
+
+// policyGitPath returns the configured user policy path and its path relative to the repository root.
+func policyGitPath() (string, string, error) {
+	projectCfg, err := config.LoadProjectConfig()
+	if err != nil {
+		return "", "", err
+	}
+	policyPath, err := policy.GetPolicyPath(projectCfg.PolicyPath)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to find policy file: %w", err)
+	}
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return "", "", err
+	}
+	relPath, err := filepath.Rel(repoRoot, policyPath)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve policy path: %w", err)
+	}
+	return policyPath, filepath.ToSlash(relPath), nil
+}
+
+// loadPolicyAtRevision loads the user policy at a git revision; a missing file is an empty policy.
+func loadPolicyAtRevision(rev, relPath string) (*schema.UserPolicy, error) {
+	if !git.FileExistsAt(rev, relPath) {
+		return &schema.UserPolicy{}, nil
+	}
+	data, err := git.ShowFile(rev, relPath)
+	if err != nil {
+		return nil, err
+	}
+	p, err := policy.ParseUserPolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s at %s: %w", relPath, rev, err)
+	}
+	return p, nil
+}
+
+// loadWorkingPolicy loads the working tree user policy; a missing file is an empty policy.
+func loadWorkingPolicy(path string) (*schema.UserPolicy, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &schema.UserPolicy{}, nil
+	}
+	return policy.NewLoader(verbose).LoadUserPolicy(path)
+}
+
+func printJSON(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,707 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/spf13/cobra"
+)
+
+var toolsCmd = &cobra.Command{
+	Use:   "tools",
+	Short: "Manage the external linter tools",
+	Long: `Manage the external linter tools used by 'sym validate'.
+
+Tool versions and artifact checksums are pinned in .sym/tools.lock.
+Every install verifies downloaded artifacts against it.
+
+Available subcommands:
+  list     - List registered tools with their capabilities and installations
+  install  - Install tools into ~/.sym/tools
+  update   - Reinstall Symphony-managed tools at the pinned or latest allowed version
+  remove   - Remove Symphony-managed installations
+  check    - Check that tools are available (non-zero exit if any is missing)
+  vendor   - Download tool artifacts into a local mirror and pin their checksums
+
+All subcommands except vendor accept --json for provisioning scripts.`,
+}
+
+var toolsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered tools",
+	Long: `List the registered linter tools with their languages, categories and
+installation: the version found, its path, and where it was found
+(project, venv, go-tool, path, managed or builtin).
+
+Examples:
+  sym tools list
+  sym tools list --json`,
+	Args: cobra.NoArgs,
+	RunE: runToolsList,
+}
+
+var toolsInstallCmd = &cobra.Command{
+	Use:   "install [name...]",
+	Short: "Install tools",
+	Long: `Install tools into ~/.sym/tools ahead of 'sym validate', which would otherwise
+install them on first use. Without names, every unavailable tool is installed.
+
+Tools that are already available (in the project, PATH or ~/.sym/tools) are
+skipped unless --force is given. --version installs a specific version and
+pins it in .sym/tools.lock so validation uses the same version; downloaded
+artifacts are pinned by their SHA-256 digest.
+
+Examples:
+  sym tools install
+  sym tools install eslint prettier
+  sym tools install golangci-lint --version 2.6.0
+  sym tools install pylint --force --json`,
+	RunE:         runToolsInstall,
+	SilenceUsage: true,
+}
+
+var toolsUpdateCmd = &cobra.Command{
+	Use:   "update [name...]",
+	Short: "Update Symphony-managed tools",
+	Long: `Reinstall tools installed in ~/.sym/tools. Package-manager tools get the
+latest version allowed by their default range; downloaded tools get the
+version pinned in .sym/tools.lock (or the default version).
+
+Tools found in the project or PATH are skipped: update them with their own
+package manager. Without names, every managed tool is updated.
+
+Examples:
+  sym tools update
+  sym tools update eslint --json`,
+	RunE:         runToolsUpdate,
+	SilenceUsage: true,
+}
+
+var toolsRemoveCmd = &cobra.Command{
+	Use:   "remove <name...>",
+	Short: "Remove Symphony-managed installations",
+	Long: `Remove the installations of tools in ~/.sym/tools. Project-local and global
+installations are never touched.
+
+Examples:
+  sym tools remove pmd checkstyle`,
+	Args:         cobra.MinimumNArgs(1),
+	RunE:         runToolsRemove,
+	SilenceUsage: true,
+}
+
+var toolsCheckCmd = &cobra.Command{
+	Use:   "check [name...]",
+	Short: "Check tool availability",
+	Long: `Run each tool's availability check. Exits with a non-zero status if any
+tool is unavailable. Without names, every registered tool is checked.
+
+Examples:
+  sym tools check
+  sym tools check eslint pylint --json`,
+	RunE:         runToolsCheck,
+	SilenceUsage: true,
+}
+
+var toolsVendorCmd = &cobra.Command{
+	Use:   "vendor [dir]",
+	Short: "Download tool artifacts into a local mirror",
+	Long: `Download the artifacts of downloadable tools (golangci-lint, PMD,
+Checkstyle, ShellCheck, Hadolint) into a mirror directory and record their
+SHA-256 digests in .sym/tools.lock.
+
+The mirror is laid out as <dir>/<tool>/<version>/<file>. Point validation at it
+with the SYM_TOOLS_SOURCE environment variable to install without network
+access:
+
+  SYM_TOOLS_SOURCE=.sym/vendor sym validate
+
+Versions pinned in tools.lock are kept; artifacts already in the mirror are
+verified instead of downloaded again. Tools installed by package managers
+(npm, pip, gem) are not vendored.
+
+Examples:
+  sym tools vendor
+  sym tools vendor /mnt/mirror --platform linux/amd64 --platform darwin/arm64
+  sym tools vendor --tool pmd --tool checkstyle`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runToolsVendor,
+}
+
+var (
+	toolsJSON            bool
+	toolsInstallVersion  string
+	toolsInstallForce    bool
+	toolsVendorPlatforms []string
+	toolsVendorTools     []string
+)
+
+// toolResult is the outcome of a tools subcommand for one tool.
+type toolResult struct {
+	Name string `json:"name"`
+
+	// Status is installed, updated, removed, skipped, available, unavailable or failed
+	Status string `json:"status"`
+
+	Installation *linter.Installation `json:"installation,omitempty"`
+	Message      string               `json:"message,omitempty"`
+}
+
+// toolInfo describes a registered tool for 'sym tools list'.
+type toolInfo struct {
+	Name          string               `json:"name"`
+	Languages     []string             `json:"languages"`
+	Categories    []string             `json:"categories"`
+	Available     bool                 `json:"available"`
+	Installation  *linter.Installation `json:"installation,omitempty"`
+	LockedVersion string               `json:"lockedVersion,omitempty"`
+	Removable     bool                 `json:"removable"`
+	Downloadable  bool                 `json:"downloadable"`
+}
+
+func init() {
+	rootCmd.AddCommand(toolsCmd)
+	toolsCmd.AddCommand(toolsListCmd)
+	toolsCmd.AddCommand(toolsInstallCmd)
+	toolsCmd.AddCommand(toolsUpdateCmd)
+	toolsCmd.AddCommand(toolsRemoveCmd)
+	toolsCmd.AddCommand(toolsCheckCmd)
+	toolsCmd.AddCommand(toolsVendorCmd)
+
+	for _, c := range []*cobra.Command{toolsListCmd, toolsInstallCmd, toolsUpdateCmd, toolsRemoveCmd, toolsCheckCmd} {
+		c.Flags().BoolVar(&toolsJSON, "json", false, "Output in JSON format")
+	}
+	toolsInstallCmd.Flags().StringVar(&toolsInstallVersion, "version", "", "Version to install and pin in .sym/tools.lock (requires a single tool name)")
+	toolsInstallCmd.Flags().BoolVar(&toolsInstallForce, "force", false, "Reinstall even if the tool is available")
+
+	toolsVendorCmd.Flags().StringSliceVar(&toolsVendorPlatforms, "platform", nil, "Target platform as os/arch (repeatable, default: current platform)")
+	toolsVendorCmd.Flags().StringSliceVar(&toolsVendorTools, "tool", nil, "Tools to vendor (repeatable, default: all downloadable tools)")
+}
+
+func runToolsList(cmd *cobra.Command, args []string) error {
+	ctx := commandContext(cmd)
+	registry := linter.Global()
+
+	lock, err := linter.LoadToolLock(filepath.Join(toolsSymDir(), linter.ToolLockFile))
+	if err != nil {
+		return err
+	}
+	ctx = linter.WithToolLock(ctx, lock)
+
+	names := registry.GetAllToolNames()
+	sort.Strings(names)
+
+	infos := make([]toolInfo, 0, len(names))
+	for _, name := range names {
+		l, err := registry.GetLinter(name)
+		if err != nil {
+			return err
+		}
+		caps := l.GetCapabilities()
+		info := toolInfo{
+			Name:         name,
+			Languages:    caps.SupportedLanguages,
+			Categories:   caps.SupportedCategories,
+			Available:    l.CheckAvailability(ctx) == nil,
+			Installation: locateTool(ctx, l),
+		}
+		if locked := lock.Get(name); locked != nil {
+			info.LockedVersion = locked.Version
+		}
+		_, info.Removable = l.(linter.Uninstaller)
+		_, info.Downloadable = l.(linter.ArtifactProvider)
+		infos = append(infos, info)
+	}
+
+	if toolsJSON {
+		return printJSON(infos)
+	}
+
+	printTitle("Tools", fmt.Sprintf("%d registered tool(s)", len(infos)))
+	fmt.Println()
+	for _, info := range infos {
+		status := colorize(green, "available")
+		if !info.Available {
+			status = colorize(yellow, "not installed")
+		}
+		fmt.Printf("  %-16s %s\n", info.Name, status)
+		if inst := info.Installation; inst != nil {
+			fmt.Println(indent(formatInstallation(*inst)))
+		}
+		if info.LockedVersion != "" {
+			fmt.Println(indent(fmt.Sprintf("Pinned: %s (%s)", info.LockedVersion, linter.ToolLockFile)))
+		}
+		fmt.Println(indent("Languages: " + strings.Join(info.Languages, ", ")))
+		fmt.Println(indent("Categories: " + strings.Join(info.Categories, ", ")))
+	}
+	return nil
+}
+
+func runToolsInstall(cmd *cobra.Command, args []string) error {
+	if toolsInstallVersion != "" && len(args) != 1 {
+		return fmt.Errorf("--version requires exactly one tool name")
+	}
+
+	ctx := commandContext(cmd)
+	symDir := toolsSymDir()
+	config, err := linter.NewInstallConfig(symDir)
+	if err != nil {
+		return err
+	}
+	config.Force = toolsInstallForce
+
+	linters, err := selectTools(args)
+	if err != nil {
+		return err
+	}
+
+	if toolsInstallVersion != "" {
+		cleanup, err := pinToolVersion(ctx, &config, linters[0], toolsInstallVersion)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
+	ctx = linter.WithToolLock(ctx, config.Lock)
+
+	results := make([]toolResult, 0, len(linters))
+	for _, l := range linters {
+		if !toolsInstallForce && l.CheckAvailability(ctx) == nil {
+			if len(args) > 0 {
+				results = append(results, toolResult{Name: l.Name(), Status: "skipped", Installation: locateTool(ctx, l), Message: "already available (use --force to reinstall)"})
+			}
+			continue
+		}
+		results = append(results, installTool(ctx, l, config, "installed"))
+	}
+
+	// Pin the version once it is installed, even if a runtime (e.g., Java) is missing
+	if toolsInstallVersion != "" && !hasFailure(results) {
+		lockPath := filepath.Join(symDir, linter.ToolLockFile)
+		if err := config.Lock.Save(lockPath); err != nil {
+			return fmt.Errorf("failed to save %s: %w", lockPath, err)
+		}
+	}
+
+	return reportToolResults(results)
+}
+
+func runToolsUpdate(cmd *cobra.Command, args []string) error {
+	ctx := commandContext(cmd)
+	config, err := linter.NewInstallConfig(toolsSymDir())
+	if err != nil {
+		return err
+	}
+	config.Force = true
+	ctx = linter.WithToolLock(ctx, config.Lock)
+
+	linters, err := selectTools(args)
+	if err != nil {
+		return err
+	}
+
+	results := make([]toolResult, 0, len(linters))
+	for _, l := range linters {
+		inst := locateTool(ctx, l)
+		_, removable := l.(linter.Uninstaller)
+		switch {
+		case !removable:
+			if len(args) > 0 {
+				results = append(results, toolResult{Name: l.Name(), Status: "skipped", Installation: inst, Message: "not installed by Symphony"})
+			}
+		case inst == nil:
+			if len(args) > 0 {
+				results = append(results, toolResult{Name: l.Name(), Status: "skipped", Message: "not installed (use 'sym tools install')"})
+			}
+		case inst.Source != linter.ToolSourceManaged:
+			results = append(results, toolResult{Name: l.Name(), Status: "skipped", Installation: inst, Message: fmt.Sprintf("using the %s installation: update it with its package manager", inst.Source)})
+		default:
+			results = append(results, installTool(ctx, l, config, "updated"))
+		}
+	}
+
+	return reportToolResults(results)
+}
+
+func runToolsRemove(cmd *cobra.Command, args []string) error {
+	ctx := commandContext(cmd)
+
+	linters, err := selectTools(args)
+	if err != nil {
+		return err
+	}
+
+	results := make([]toolResult, 0, len(linters))
+	for _, l := range linters {
+		uninstaller, ok := l.(linter.Uninstaller)
+		if !ok {
+			results = append(results, toolResult{Name: l.Name(), Status: "failed", Message: "not installed by Symphony: nothing to remove"})
+			continue
+		}
+		if err := uninstaller.Uninstall(ctx); err != nil {
+			results = append(results, toolResult{Name: l.Name(), Status: "failed", Message: err.Error()})
+			continue
+		}
+
+		result := toolResult{Name: l.Name(), Status: "removed"}
+		if inst := locateTool(ctx, l); inst != nil {
+			result.Installation = inst
+			result.Message = fmt.Sprintf("still available from %s", inst.Source)
+		}
+		results = append(results, result)
+	}
+
+	return reportToolResults(results)
+}
+
+func runToolsCheck(cmd *cobra.Command, args []string) error {
+	ctx := commandContext(cmd)
+	lock, err := linter.LoadToolLock(filepath.Join(toolsSymDir(), linter.ToolLockFile))
+	if err != nil {
+		return err
+	}
+	ctx = linter.WithToolLock(ctx, lock)
+
+	linters, err := selectTools(args)
+	if err != nil {
+		return err
+	}
+
+	results := make([]toolResult, 0, len(linters))
+	for _, l := range linters {
+		if err := l.CheckAvailability(ctx); err != nil {
+			results = append(results, toolResult{Name: l.Name(), Status: "unavailable", Message: err.Error()})
+			continue
+		}
+		results = append(results, toolResult{Name: l.Name(), Status: "available", Installation: locateTool(ctx, l)})
+	}
+
+	return reportToolResults(results)
+}
+
+func runToolsVendor(cmd *cobra.Command, args []string) error {
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return fmt.Errorf("failed to find git repository: %w", err)
+	}
+	symDir := filepath.Join(repoRoot, ".sym")
+
+	mirror := filepath.Join(symDir, "vendor")
+	if len(args) > 0 {
+		mirror = args[0]
+	}
+
+	platforms, err := parsePlatforms(toolsVendorPlatforms)
+	if err != nil {
+		return err
+	}
+
+	providers, err := artifactProviders(toolsVendorTools)
+	if err != nil {
+		return err
+	}
+
+	lockPath := filepath.Join(symDir, linter.ToolLockFile)
+	lock, err := linter.LoadToolLock(lockPath)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		lock = linter.NewToolLock()
+	}
+
+	printTitle("Tools", fmt.Sprintf("Vendoring into %s", mirror))
+
+	ctx := commandContext(cmd)
+
+	for _, name := range sortedKeys(providers) {
+		version := ""
+		if locked := lock.Get(name); locked != nil {
+			version = locked.Version
+		}
+
+		seen := make(map[string]bool)
+		for _, p := range platforms {
+			art, err := providers[name].Artifact(version, p[0], p[1])
+			if err != nil {
+				printWarn(fmt.Sprintf("%s: %v", name, err))
+				continue
+			}
+			// Platform-independent artifacts (jars, zips) are shared
+			if seen[art.Name] {
+				continue
+			}
+			seen[art.Name] = true
+
+			digest, err := vendorArtifact(ctx, mirror, art)
+			if err != nil {
+				return fmt.Errorf("%s: %w", art.Name, err)
+			}
+
+			if locked := lock.Get(name); locked != nil && locked.Version == art.Version {
+				if pinned := locked.Artifacts[art.Name]; pinned != "" && pinned != digest {
+					return fmt.Errorf("checksum mismatch for %s: got sha256 %s, %s pins %s", art.Name, digest, linter.ToolLockFile, pinned)
+				}
+			}
+			lock.Pin(name, art.Version, art.Name, digest)
+			printOK(fmt.Sprintf("%s %s (%s)", name, art.Version, art.Name))
+		}
+	}
+
+	if err := lock.Save(lockPath); err != nil {
+		return fmt.Errorf("failed to save %s: %w", lockPath, err)
+	}
+
+	fmt.Println()
+	printDone(fmt.Sprintf("Pinned %d tool(s) in %s", len(providers), lockPath))
+	fmt.Println(indent(fmt.Sprintf("Install from the mirror with %s=%s", linter.ToolsSourceEnv, mirror)))
+	return nil
+}
+
+// installTool installs a tool and reports the installation it resolves to.
+func installTool(ctx context.Context, l linter.Linter, config linter.InstallConfig, status string) toolResult {
+	if !toolsJSON {
+		fmt.Printf("Installing %s...\n", l.Name())
+	}
+	if err := l.Install(ctx, config); err != nil {
+		return toolResult{Name: l.Name(), Status: "failed", Message: err.Error()}
+	}
+	if err := l.CheckAvailability(ctx); err != nil {
+		return toolResult{Name: l.Name(), Status: "unavailable", Message: fmt.Sprintf("installed, but %v", err)}
+	}
+	return toolResult{Name: l.Name(), Status: status, Installation: locateTool(ctx, l)}
+}
+
+// pinToolVersion pins version in config.Lock (creating it if needed) so the
+// install and later validation runs agree on the version. A downloaded
+// artifact is fetched once, pinned by its SHA-256 and used as config.Source.
+// The returned cleanup removes the fetched artifact.
+func pinToolVersion(ctx context.Context, config *linter.InstallConfig, l linter.Linter, version string) (func(), error) {
+	cleanup := func() {}
+	if config.Lock == nil {
+		config.Lock = linter.NewToolLock()
+	}
+
+	provider, ok := l.(linter.ArtifactProvider)
+	if !ok {
+		config.Lock.Pin(l.Name(), version, "", "")
+		return cleanup, nil
+	}
+
+	art, err := provider.Artifact(version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return cleanup, err
+	}
+	if locked := config.Lock.Get(l.Name()); locked != nil && locked.Version == art.Version && locked.Artifacts[art.Name] != "" {
+		return cleanup, nil // Already pinned: Install verifies it
+	}
+
+	tempDir, err := os.MkdirTemp("", "sym-tools-")
+	if err != nil {
+		return cleanup, err
+	}
+	cleanup = func() { _ = os.RemoveAll(tempDir) }
+
+	archive := filepath.Join(tempDir, art.Name)
+	if err := linter.FetchArtifact(ctx, linter.InstallConfig{Source: config.Source}, art, archive); err != nil {
+		cleanup()
+		return func() {}, fmt.Errorf("failed to download %s: %w", art.Name, err)
+	}
+	digest, err := linter.FileSHA256(archive)
+	if err != nil {
+		cleanup()
+		return func() {}, err
+	}
+
+	config.Lock.Pin(l.Name(), art.Version, art.Name, digest)
+	config.Source = archive
+	return cleanup, nil
+}
+
+// reportToolResults prints results and returns an error if any tool failed
+// or is unavailable.
+func reportToolResults(results []toolResult) error {
+	if toolsJSON {
+		if err := printJSON(results); err != nil {
+			return err
+		}
+	} else {
+		if len(results) == 0 {
+			fmt.Println("Nothing to do")
+		}
+		for _, r := range results {
+			switch r.Status {
+			case "failed", "unavailable":
+				printError(fmt.Sprintf("%s: %s", r.Name, r.Message))
+			case "skipped":
+				printWarn(fmt.Sprintf("%s: %s", r.Name, r.Message))
+			default:
+				msg := fmt.Sprintf("%s: %s", r.Name, r.Status)
+				if r.Message != "" {
+					msg += " (" + r.Message + ")"
+				}
+				printOK(msg)
+			}
+			if r.Installation != nil {
+				fmt.Println(indent(formatInstallation(*r.Installation)))
+			}
+		}
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Status == "failed" || r.Status == "unavailable" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d tool(s) failed or unavailable", failed)
+	}
+	return nil
+}
+
+func hasFailure(results []toolResult) bool {
+	for _, r := range results {
+		if r.Status == "failed" {
+			return true
+		}
+	}
+	return false
+}
+
+// formatInstallation formats an installation as "version (source) path".
+func formatInstallation(inst linter.Installation) string {
+	if inst.Source == linter.ToolSourceBuiltin {
+		return "built in"
+	}
+	version := inst.Version
+	if version == "" {
+		version = "unknown version"
+	}
+	return fmt.Sprintf("%s (%s) %s", version, inst.Source, inst.Path)
+}
+
+// locateTool returns the installation of a linter implementing
+// linter.Locator, or nil if it is not installed or cannot be located.
+func locateTool(ctx context.Context, l linter.Linter) *linter.Installation {
+	locator, ok := l.(linter.Locator)
+	if !ok {
+		return nil
+	}
+	inst, err := locator.Locate(ctx)
+	if err != nil {
+		return nil
+	}
+	return &inst
+}
+
+// selectTools returns the named linters, or all registered linters sorted by name.
+func selectTools(names []string) ([]linter.Linter, error) {
+	registry := linter.Global()
+	if len(names) == 0 {
+		names = registry.GetAllToolNames()
+		sort.Strings(names)
+	}
+
+	linters := make([]linter.Linter, 0, len(names))
+	for _, name := range names {
+		l, err := registry.GetLinter(name)
+		if err != nil {
+			return nil, err
+		}
+		linters = append(linters, l)
+	}
+	return linters, nil
+}
+
+// toolsSymDir returns the repository's .sym directory, or .sym in the
+// current directory outside a git repository.
+func toolsSymDir() string {
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return ".sym"
+	}
+	return filepath.Join(repoRoot, ".sym")
+}
+
+func commandContext(cmd *cobra.Command) context.Context {
+	if ctx := cmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// vendorArtifact places an artifact in the mirror, downloading it unless it is
+// already present, and returns its SHA-256 digest.
+func vendorArtifact(ctx context.Context, mirror string, art linter.Artifact) (string, error) {
+	path := linter.MirrorPath(mirror, art)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := linter.EnsureDir(filepath.Dir(path)); err != nil {
+			return "", err
+		}
+		if err := linter.DownloadFile(ctx, art.URL, path); err != nil {
+			return "", err
+		}
+	}
+	return linter.FileSHA256(path)
+}
+
+// artifactProviders returns the registered linters installed from artifacts,
+// restricted to names if given.
+func artifactProviders(names []string) (map[string]linter.ArtifactProvider, error) {
+	registry := linter.Global()
+	explicit := len(names) > 0
+	if !explicit {
+		names = registry.GetAllToolNames()
+	}
+
+	providers := make(map[string]linter.ArtifactProvider)
+	for _, name := range names {
+		l, err := registry.GetLinter(name)
+		if err != nil {
+			return nil, err
+		}
+		provider, ok := l.(linter.ArtifactProvider)
+		if !ok {
+			if explicit {
+				return nil, fmt.Errorf("%s is installed by a package manager and cannot be vendored", name)
+			}
+			continue
+		}
+		providers[name] = provider
+	}
+	return providers, nil
+}
+
+// parsePlatforms parses os/arch pairs, defaulting to the current platform.
+func parsePlatforms(values []string) ([][2]string, error) {
+	if len(values) == 0 {
+		return [][2]string{{runtime.GOOS, runtime.GOARCH}}, nil
+	}
+
+	platforms := make([][2]string, 0, len(values))
+	for _, v := range values {
+		goos, goarch, found := strings.Cut(v, "/")
+		if !found || goos == "" || goarch == "" {
+			return nil, fmt.Errorf("invalid platform %q: expected os/arch (e.g., linux/amd64)", v)
+		}
+		platforms = append(platforms, [2]string{goos, goarch})
+	}
+	return platforms, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -15,9 +15,12 @@ import (
 )
 
 var (
-	validatePolicyFile string
-	validateStaged     bool
-	validateTimeout    int
+	validatePolicyFile  string
+	validateStaged      bool
+	validateTimeout     int
+	validateWatch       bool
+	validateDebounce    int
+	validateLLMDebounce int
 )
 
 var validateCmd = &cobra.Command{
@@ -34,6 +37,10 @@ By default, this validates ALL uncommitted changes including:
   - Unstaged changes (modified but not staged)
   - Untracked files (new files not yet added)
 
+In a monorepo, packages can have their own .sym directory. Each changed file
+is validated with the code policy and linter configs of its nearest .sym
+ancestor, and the results of all packages are merged.
+
 Examples:
   # Validate all uncommitted changes (default)
   sym validate
@@ -42,35 +49,60 @@ Examples:
   sym validate --staged
 
   # Use custom policy file
-  sym validate --policy custom-policy.json`,
+  sym validate --policy custom-policy.json
+
+  # Re-validate changed files on every save
+  sym validate --watch`,
 	RunE: runValidate,
 }
 
 func init() {
-	validateCmd.Flags().StringVarP(&validatePolicyFile, "policy", "p", "", "Path to code-policy.json (default: .sym/code-policy.json)")
+	validateCmd.Flags().StringVarP(&validatePolicyFile, "policy", "p", "", "Path to code-policy.json (default: nearest .sym/code-policy.json of each file)")
 	validateCmd.Flags().BoolVar(&validateStaged, "staged", false, "Validate only staged changes (default: all uncommitted changes)")
 	validateCmd.Flags().IntVar(&validateTimeout, "timeout", 30, "Timeout per rule check in seconds")
+	validateCmd.Flags().BoolVarP(&validateWatch, "watch", "w", false, "Watch files and re-validate changed files on save")
+	validateCmd.Flags().IntVar(&validateDebounce, "debounce", 300, "Watch mode: quiet period in milliseconds before re-running linters")
+	validateCmd.Flags().IntVar(&validateLLMDebounce, "llm-debounce", 30, "Watch mode: idle seconds before running LLM rules (0 = only on keypress)")
+}
+
+// changeValidator validates git changes; implemented by a single-policy
+// validator.Validator and by a monorepo validator.Workspace.
+type changeValidator interface {
+	SetLLMProvider(provider llm.Provider)
+	ValidateChangesWithOptions(ctx context.Context, changes []git.Change, opts validator.ValidateOptions) (*validator.ValidationResult, error)
+	Close() error
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
-	// Load code policy
-	policyPath := validatePolicyFile
-	if policyPath == "" {
-		repoRoot, err := git.GetRepoRoot()
-		if err != nil {
-			return fmt.Errorf("failed to find git repository: %w", err)
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return fmt.Errorf("failed to find git repository: %w", err)
+	}
+	if validatePolicyFile != "" {
+		if validatePolicyFile, err = filepath.Abs(validatePolicyFile); err != nil {
+			return fmt.Errorf("failed to resolve policy path: %w", err)
 		}
-		policyPath = filepath.Join(repoRoot, ".sym", "code-policy.json")
+	}
+	// Git paths and nested .sym packages are relative to the repository root
+	if err := os.Chdir(repoRoot); err != nil {
+		return fmt.Errorf("failed to change to repository root: %w", err)
 	}
 
-	policyData, err := os.ReadFile(policyPath)
+	v, err := newChangeValidator(repoRoot)
 	if err != nil {
-		return fmt.Errorf("failed to read policy file: %w", err)
+		return err
 	}
+	defer func() {
+		if err := v.Close(); err != nil {
+			fmt.Printf("Warning: failed to close validator: %v\n", err)
+		}
+	}()
 
-	var policy schema.CodePolicy
-	if err := json.Unmarshal(policyData, &policy); err != nil {
-		return fmt.Errorf("failed to parse policy: %w", err)
+	if validateWatch {
+		if validateStaged {
+			return fmt.Errorf("--watch cannot be combined with --staged")
+		}
+		return runValidateWatch(repoRoot, v)
 	}
 
 	// Create LLM provider
@@ -102,18 +134,11 @@ func runValidate(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Found %d changed file(s)\n", len(changes))
 
-	// Create unified validator that handles all engines + RBAC
-	v := validator.NewValidator(&policy, verbose)
 	v.SetLLMProvider(llmProvider)
-	defer func() {
-		if err := v.Close(); err != nil {
-			fmt.Printf("Warning: failed to close validator: %v\n", err)
-		}
-	}()
 
 	// Validate changes
 	ctx := context.Background()
-	result, err := v.ValidateChanges(ctx, changes)
+	result, err := v.ValidateChangesWithOptions(ctx, changes, validator.ValidateOptions{})
 	if err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
@@ -128,6 +153,37 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// newChangeValidator loads the policy given by --policy, or every .sym package of the
+// repository so that each change is validated with its nearest package's policy.
+func newChangeValidator(repoRoot string) (changeValidator, error) {
+	if validatePolicyFile != "" {
+		policyData, err := os.ReadFile(validatePolicyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy file: %w", err)
+		}
+
+		var policy schema.CodePolicy
+		if err := json.Unmarshal(policyData, &policy); err != nil {
+			return nil, fmt.Errorf("failed to parse policy: %w", err)
+		}
+		// Unified validator that handles all engines + RBAC
+		return validator.NewValidatorWithWorkDir(&policy, verbose, repoRoot), nil
+	}
+
+	ws, err := validator.NewWorkspace(repoRoot, verbose)
+	if err != nil {
+		return nil, err
+	}
+	if !ws.HasPolicies() {
+		_ = ws.Close()
+		return nil, fmt.Errorf("failed to read policy file: no code-policy.json found in %s\nRun 'sym convert' first", filepath.Join(repoRoot, ".sym"))
+	}
+	if verbose && len(ws.Packages()) > 1 {
+		fmt.Printf("Found %d .sym packages\n", len(ws.Packages()))
+	}
+	return ws, nil
+}
+
 func printValidationResult(result *validator.ValidationResult) {
 	fmt.Printf("\n=== Validation Results ===\n")
 	fmt.Printf("Checked: %d\n", result.Checked)
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/internal/util/watch"
+	"github.com/DevSymphony/sym-cli/internal/validator"
+)
+
+// maxWatchViolations caps the violations listed per redraw to keep the summary compact
+const maxWatchViolations = 30
+
+// watchSession holds the state of a `sym validate --watch` run.
+type watchSession struct {
+	validator   changeValidator
+	state       *validator.WatchState
+	llmEnabled  bool
+	llmPending  map[string]bool
+	lastRun     string
+	lastRunTime time.Duration
+}
+
+// runValidateWatch validates once, then re-validates changed files on every save.
+// Linter engines run after each debounced batch of saves; LLM engines run after
+// the longer LLM debounce or when the user presses "l" + Enter.
+func runValidateWatch(repoRoot string, v changeValidator) error {
+	session := &watchSession{
+		validator:  v,
+		state:      validator.NewWatchState(repoRoot),
+		llmEnabled: true,
+		llmPending: make(map[string]bool),
+	}
+
+	llmProvider, err := llm.New(llm.LoadConfig())
+	if err != nil {
+		printWarn(fmt.Sprintf("No available LLM backend, llm-validator rules are disabled: %v", err))
+		session.llmEnabled = false
+	} else {
+		v.SetLLMProvider(llmProvider)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Initial pass over all uncommitted changes
+	changes, err := git.GetChanges()
+	if err != nil {
+		return fmt.Errorf("failed to get git changes: %w", err)
+	}
+	session.runLinters(ctx, changes, nil)
+	session.redraw()
+
+	w := watch.New(repoRoot, git.ListWorkingFiles)
+	w.Debounce = time.Duration(validateDebounce) * time.Millisecond
+	if index, err := git.IndexPath(); err == nil {
+		w.IndexFile = index
+	}
+
+	batches := make(chan []string)
+	watchErr := make(chan error, 1)
+	go func() { watchErr <- w.Run(ctx, batches) }()
+
+	keys := make(chan string)
+	go readWatchKeys(ctx, keys)
+
+	llmDebounce := time.Duration(validateLLMDebounce) * time.Second
+	llmTimer := time.NewTimer(llmDebounce)
+	if llmDebounce <= 0 || len(session.llmPending) == 0 {
+		llmTimer.Stop()
+	}
+	defer llmTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return nil
+
+		case err := <-watchErr:
+			if err != nil && ctx.Err() == nil {
+				return fmt.Errorf("file watcher stopped: %w", err)
+			}
+			return nil
+
+		case files := <-batches:
+			changes, err := git.GetChangesForFiles(files)
+			if err != nil {
+				printWarn(fmt.Sprintf("Failed to get changes: %v", err))
+				continue
+			}
+			session.runLinters(ctx, changes, files)
+			if llmDebounce > 0 && len(session.llmPending) > 0 {
+				llmTimer.Reset(llmDebounce)
+			}
+			session.redraw()
+
+		case <-llmTimer.C:
+			session.runLLM(ctx)
+			session.redraw()
+
+		case key := <-keys:
+			switch key {
+			case "q":
+				return nil
+			case "l":
+				llmTimer.Stop()
+				session.runLLM(ctx)
+			}
+			session.redraw()
+		}
+	}
+}
+
+// runLinters re-validates changes with linter engines only.
+// requested lists the paths the watcher reported; those without a change
+// (reverted or deleted) are dropped from the state.
+func (s *watchSession) runLinters(ctx context.Context, changes []git.Change, requested []string) {
+	var active []git.Change
+	changed := make(map[string]bool)
+	for _, change := range changes {
+		if change.Status == "D" {
+			continue
+		}
+		active = append(active, change)
+		changed[change.FilePath] = true
+	}
+
+	var clean []string
+	for _, f := range requested {
+		if !changed[f] {
+			clean = append(clean, f)
+			delete(s.llmPending, f)
+		}
+	}
+	s.state.Forget(clean)
+
+	if len(active) == 0 {
+		return
+	}
+
+	start := time.Now()
+	result, err := s.validator.ValidateChangesWithOptions(ctx, active, validator.ValidateOptions{
+		EngineFilter: validator.LinterEnginesOnly,
+	})
+	if err != nil {
+		printWarn(fmt.Sprintf("Validation failed: %v", err))
+		return
+	}
+
+	s.state.Update(validator.WatchScopeLinter, changedPaths(active), result)
+	s.lastRun = fmt.Sprintf("linters on %d file(s)", len(active))
+	s.lastRunTime = time.Since(start)
+
+	if s.llmEnabled {
+		for f := range changed {
+			s.llmPending[f] = true
+		}
+	}
+}
+
+// runLLM validates the files saved since the last LLM pass with llm-validator rules.
+func (s *watchSession) runLLM(ctx context.Context) {
+	if !s.llmEnabled || len(s.llmPending) == 0 {
+		return
+	}
+
+	files := make([]string, 0, len(s.llmPending))
+	for f := range s.llmPending {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	s.llmPending = make(map[string]bool)
+
+	changes, err := git.GetChangesForFiles(files)
+	if err != nil {
+		printWarn(fmt.Sprintf("Failed to get changes: %v", err))
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	start := time.Now()
+	result, err := s.validator.ValidateChangesWithOptions(ctx, changes, validator.ValidateOptions{
+		EngineFilter: validator.LLMEngineOnly,
+		SkipRBAC:     true,
+	})
+	if err != nil {
+		printWarn(fmt.Sprintf("LLM validation failed: %v", err))
+		return
+	}
+
+	s.state.Update(validator.WatchScopeLLM, changedPaths(changes), result)
+	s.lastRun = fmt.Sprintf("LLM rules on %d file(s)", len(changes))
+	s.lastRunTime = time.Since(start)
+}
+
+// redraw prints a compact summary of the current state, clearing the screen on a TTY.
+func (s *watchSession) redraw() {
+	if isTTY() {
+		fmt.Print("\033[H\033[2J")
+	}
+
+	summary := s.state.Summary()
+	printTitle("Watch", fmt.Sprintf("%d file(s) checked, %d passed, %d failed",
+		summary.Checked, summary.Passed, summary.Failed))
+	fmt.Println()
+
+	if len(summary.Violations) == 0 {
+		printOK("No violations")
+	}
+	for i, v := range summary.Violations {
+		if i == maxWatchViolations {
+			fmt.Printf("  ... and %d more\n", len(summary.Violations)-maxWatchViolations)
+			break
+		}
+		message := strings.SplitN(strings.TrimSpace(v.Message), "\n", 2)[0]
+		fmt.Printf("  %s:%d  %s %s  %s\n", v.File, v.Line, colorize(severityColor(v.Severity), v.Severity), v.RuleID, message)
+	}
+	for _, e := range summary.Errors {
+		printWarn(fmt.Sprintf("%s (%s): %s", e.Engine, e.RuleID, e.Message))
+	}
+
+	fmt.Println()
+	if s.lastRun != "" {
+		fmt.Printf("Last run: %s (%dms)\n", s.lastRun, s.lastRunTime.Milliseconds())
+	}
+	if s.llmEnabled && len(s.llmPending) > 0 {
+		if validateLLMDebounce > 0 {
+			fmt.Printf("LLM rules pending for %d file(s): running after %ds idle, or press l+Enter\n",
+				len(s.llmPending), validateLLMDebounce)
+		} else {
+			fmt.Printf("LLM rules pending for %d file(s): press l+Enter to run\n", len(s.llmPending))
+		}
+	}
+	fmt.Println("Watching for changes... (q+Enter or Ctrl+C to quit)")
+}
+
+// readWatchKeys forwards each trimmed stdin line to keys until stdin closes or ctx is done.
+// A read blocked on stdin cannot be interrupted; the goroutine then ends with
+// the process, which exits right after the watch returns.
+func readWatchKeys(ctx context.Context, keys chan<- string) {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		select {
+		case keys <- strings.ToLower(strings.TrimSpace(scanner.Text())):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// changedPaths returns the file paths of changes.
+func changedPaths(changes []git.Change) []string {
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.FilePath)
+	}
+	return paths
+}
+
+// severityColor maps a violation severity to its display color.
+func severityColor(severity string) string {
+	switch severity {
+	case "error":
+		return red
+	case "warning":
+		return yellow
+	default:
+		return cyan
+	}
+}
@@ -3,15 +3,19 @@ package converter
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/plugin"
 	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/validator"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
 )
 
@@ -22,6 +26,9 @@ const llmValidatorEngine = "llm-validator"
 type Converter struct {
 	llmProvider llm.Provider
 	outputDir   string
+	full        bool // Ignore the conversion manifest and reconvert every rule
+	verify      bool // Run rule examples against fresh linter conversions
+	dryRun      bool // Compute outputs in memory without writing them
 }
 
 // NewConverter creates a new converter instance
@@ -35,12 +42,45 @@ func NewConverter(provider llm.Provider, outputDir string) *Converter {
 	}
 }
 
+// SetFullConversion makes Convert ignore the conversion manifest and
+// reconvert every rule. The manifest is still rewritten afterwards.
+func (c *Converter) SetFullConversion(full bool) {
+	c.full = full
+}
+
+// SetVerifyExamples makes Convert run each rule's good/bad examples against
+// its fresh linter conversions. A conversion whose examples fail falls back
+// to llm-validator. Linters that are not installed are not verified.
+func (c *Converter) SetVerifyExamples(verify bool) {
+	c.verify = verify
+}
+
+// SetDryRun makes Convert compute every output in memory without writing
+// anything to the output directory, including the conversion manifest.
+// The contents are returned in ConvertResult.Files.
+func (c *Converter) SetDryRun(dryRun bool) {
+	c.dryRun = dryRun
+}
+
 // ConvertResult represents the result of conversion
 type ConvertResult struct {
 	GeneratedFiles []string           // List of generated file paths (including code-policy.json)
+	Files          map[string][]byte  // Generated file contents by path
+	RemovedFiles   []string           // Configs of the previous conversion that are no longer generated (deleted unless dry run)
 	CodePolicy     *schema.CodePolicy // Generated code policy
 	Errors         map[string]error   // Errors per linter
 	Warnings       []string           // Conversion warnings
+	ReusedRules    []string           // Unchanged rule IDs reused from the conversion manifest
+	Routing        []RoutingDecision  // Routing of each rule, in policy order
+}
+
+// RoutingDecision explains which engines enforce a rule and why.
+type RoutingDecision struct {
+	RuleID    string            `json:"ruleId"`
+	Linters   []string          `json:"linters"`             // Final engines, including llm-validator fallbacks
+	Reason    string            `json:"reason,omitempty"`    // LLM's routing rationale, or why the LLM was not asked
+	Fallbacks map[string]string `json:"fallbacks,omitempty"` // Linter -> why it could not enforce the rule (falls back to llm-validator unless noLlm)
+	Cached    bool              `json:"cached,omitempty"`    // Reused from the conversion manifest
 }
 
 // Convert is the main entry point for converting user policy to linter configs
@@ -49,12 +89,39 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 		return nil, fmt.Errorf("user policy is nil")
 	}
 
-	// Step 1: Route rules by asking LLM which linters are appropriate
-	linterRules := c.routeRulesWithLLM(ctx, userPolicy)
+	// Step 0: Load declarative linter plugins from <outputDir>/linters
+	_, _, pluginErr := plugin.NewRegistry(linter.Global(), c.outputDir)
+
+	// Step 1: Reuse unchanged rules from the conversion manifest
+	previousManifest := loadManifest(c.outputDir)
+	manifest := &conversionManifest{Version: manifestVersion, Rules: make(map[string]*manifestEntry)}
+	if !c.full {
+		manifest = previousManifest
+	}
+	categoryMap := categoryDescriptions(userPolicy)
+	ruleHashes := make(map[string]string)
+	cachedRules := make(map[string]*restoredRule)
+	var pendingRules []schema.UserRule
+	for _, rule := range userPolicy.Rules {
+		hash := c.ruleHash(userPolicy, rule, categoryMap)
+		ruleHashes[rule.ID] = hash
+		if entry, ok := manifest.Rules[rule.ID]; ok && entry.Hash == hash {
+			if restored, ok := entry.restore(c.registry(), rule.ID); ok {
+				cachedRules[rule.ID] = restored
+				continue
+			}
+		}
+		pendingRules = append(pendingRules, rule)
+	}
+
+	// Step 1.1: Route new and changed rules by asking LLM which linters are appropriate
+	linterRules, routes := c.routeRulesWithLLM(ctx, userPolicy, pendingRules)
 
 	// Step 2: Create output directory
-	if err := os.MkdirAll(c.outputDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	if !c.dryRun {
+		if err := os.MkdirAll(c.outputDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create output directory: %w", err)
+		}
 	}
 
 	// Step 3: Build CodePolicy with linter mappings
@@ -67,6 +134,13 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 		},
 	}
 
+	if userPolicy.Defaults != nil && userPolicy.Defaults.MergeProjectConfig {
+		codePolicy.Enforce.MergeProjectConfig = true
+	}
+	if userPolicy.Defaults != nil {
+		codePolicy.Enforce.Execution = userPolicy.Defaults.Execution
+	}
+
 	// Step 3.1: Convert RBAC if present
 	if userPolicy.RBAC != nil {
 		codePolicy.RBAC = c.convertRBAC(userPolicy.RBAC)
@@ -87,15 +161,26 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 			ruleToLinters[rule.ID] = append(ruleToLinters[rule.ID], linterName)
 		}
 	}
+	routedLinters := make(map[string][]string, len(ruleToLinters))
+	for ruleID, linters := range ruleToLinters {
+		routedLinters[ruleID] = append([]string(nil), linters...)
+	}
+	for ruleID, cached := range cachedRules {
+		ruleToLinters[ruleID] = append([]string(nil), cached.linters...)
+	}
 
 	// Step 4: Convert all (linter, rule) pairs in parallel with single semaphore
 	// This is a flat parallelization - no nested goroutines
 	result := &ConvertResult{
 		GeneratedFiles: []string{},
+		Files:          make(map[string][]byte),
 		CodePolicy:     codePolicy,
 		Errors:         make(map[string]error),
 		Warnings:       []string{},
 	}
+	if pluginErr != nil {
+		result.Warnings = append(result.Warnings, pluginErr.Error())
+	}
 
 	// Track failed rules per linter for fallback to llm-validator
 	failedRulesPerLinter := make(map[string][]string)
@@ -107,18 +192,74 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 			continue // Skip llm-validator - handled in CodePolicy only
 		}
 		for _, rule := range rules {
-			tasks = append(tasks, conversionTask{linterName: linterName, rule: rule})
+			languages := rule.Languages
+			if len(languages) == 0 && userPolicy.Defaults != nil {
+				languages = userPolicy.Defaults.Languages
+			}
+			tasks = append(tasks, conversionTask{linterName: linterName, rule: rule, languages: languages})
 		}
 	}
 
 	// Convert all tasks in parallel with single semaphore
-	successResults, failedResults := c.convertAllTasks(ctx, tasks)
+	successResults, failedResults, conversionErrors := c.convertAllTasks(ctx, tasks)
+
+	// Record fresh conversions in the manifest; rules whose routing or
+	// conversion failed are left out so the next run retries them
+	freshResults := make(map[string]map[string]*linter.SingleRuleResult) // rule ID -> linter -> result
+	for linterName, linterResults := range successResults {
+		for _, r := range linterResults {
+			if freshResults[r.RuleID] == nil {
+				freshResults[r.RuleID] = make(map[string]*linter.SingleRuleResult)
+			}
+			freshResults[r.RuleID][linterName] = r
+		}
+	}
+	nextManifest := &conversionManifest{Version: manifestVersion, Rules: make(map[string]*manifestEntry)}
+	for ruleID := range cachedRules {
+		nextManifest.Rules[ruleID] = manifest.Rules[ruleID]
+	}
+	for ruleID, linters := range routedLinters {
+		if routes[ruleID].failed || len(conversionErrors[ruleID]) > 0 {
+			continue
+		}
+		if entry := newManifestEntry(c.registry(), ruleHashes[ruleID], linters, routes[ruleID].reason, freshResults[ruleID]); entry != nil {
+			nextManifest.Rules[ruleID] = entry
+		}
+	}
+
+	// Merge cached conversions of unchanged rules
+	for ruleID, cached := range cachedRules {
+		for linterName, r := range cached.results {
+			successResults[linterName] = append(successResults[linterName], r)
+		}
+		for _, linterName := range cached.skipped {
+			failedResults[linterName] = append(failedResults[linterName], ruleID)
+		}
+	}
+	if len(cachedRules) > 0 {
+		for _, rule := range userPolicy.Rules {
+			if _, ok := cachedRules[rule.ID]; ok {
+				result.ReusedRules = append(result.ReusedRules, rule.ID)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "ℹ️  Reused %d unchanged rule(s) from %s\n", len(cachedRules), manifestFilename)
+	}
 
 	// Update failedRulesPerLinter from conversion results
 	for linterName, ruleIDs := range failedResults {
 		failedRulesPerLinter[linterName] = append(failedRulesPerLinter[linterName], ruleIDs...)
 	}
 
+	// Native rule IDs per (rule, linter), stored in the policy rules for violation mapping
+	nativeRuleIDs := make(map[string][]string)
+	for linterName, linterResults := range successResults {
+		for _, r := range linterResults {
+			if len(r.NativeRuleIDs) > 0 {
+				nativeRuleIDs[r.RuleID+"/"+linterName] = r.NativeRuleIDs
+			}
+		}
+	}
+
 	// Build configs and write files for each linter (sequential - no LLM calls)
 	for linterName, linterResults := range successResults {
 		converter := c.getLinterConverter(linterName)
@@ -138,26 +279,46 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 		}
 
 		outputPath := filepath.Join(c.outputDir, config.Filename)
-		if err := os.WriteFile(outputPath, config.Content, 0644); err != nil {
-			result.Errors[linterName] = fmt.Errorf("failed to write file: %w", err)
-			continue
+		if !c.dryRun {
+			if err := os.WriteFile(outputPath, config.Content, 0644); err != nil {
+				result.Errors[linterName] = fmt.Errorf("failed to write file: %w", err)
+				continue
+			}
+			fmt.Fprintf(os.Stderr, "✓ Generated %s configuration: %s\n", linterName, outputPath)
 		}
 
 		result.GeneratedFiles = append(result.GeneratedFiles, outputPath)
-		fmt.Fprintf(os.Stderr, "✓ Generated %s configuration: %s\n", linterName, outputPath)
+		result.Files[outputPath] = config.Content
 	}
 
 	// Step 4.1: Update ruleToLinters mapping - remove failed linters and add llm-validator fallback
+	// unless the rule forbids LLM validation
+	noLLM := make(map[string]bool)
+	for _, userRule := range userPolicy.Rules {
+		if userRule.NoLLM {
+			noLLM[userRule.ID] = true
+		}
+	}
+	fallbacks := make(map[string]map[string]string) // rule ID -> linter -> reason
+	totalFallbacks := 0
 	for linter, failedRuleIDs := range failedRulesPerLinter {
 		for _, ruleID := range failedRuleIDs {
+			if fallbacks[ruleID] == nil {
+				fallbacks[ruleID] = make(map[string]string)
+			}
+			if err, ok := conversionErrors[ruleID][linter]; ok {
+				fallbacks[ruleID][linter] = err.Error()
+			} else {
+				fallbacks[ruleID][linter] = "cannot be enforced by " + linter
+			}
+
 			// Remove failed linter from this rule's linters
-			currentLinters := ruleToLinters[ruleID]
-			updatedLinters := []string{}
-			for _, l := range currentLinters {
-				if l != linter {
-					updatedLinters = append(updatedLinters, l)
-				}
+			updatedLinters := removeLinter(ruleToLinters[ruleID], linter)
+			if noLLM[ruleID] {
+				ruleToLinters[ruleID] = updatedLinters
+				continue
 			}
+			totalFallbacks++
 
 			// Add llm-validator as fallback if not already present
 			hasLLMValidator := false
@@ -175,11 +336,28 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 		}
 	}
 
-	// Log fallback info
-	totalFallbacks := 0
-	for _, failedRuleIDs := range failedRulesPerLinter {
-		totalFallbacks += len(failedRuleIDs)
+	// Rules that forbid LLM validation are only enforced by linters
+	for _, userRule := range userPolicy.Rules {
+		if !userRule.NoLLM {
+			continue
+		}
+		ruleToLinters[userRule.ID] = removeLinter(ruleToLinters[userRule.ID], llmValidatorEngine)
+		if len(ruleToLinters[userRule.ID]) == 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("rule %s is not enforced: no linter could enforce it and LLM validation is disabled (noLlm)", userRule.ID))
+		}
+	}
+	for _, userRule := range userPolicy.Rules {
+		var messages []string
+		for linterName, err := range conversionErrors[userRule.ID] {
+			if _, native := userRule.Native[linterName]; native || errors.Is(err, errInvalidParams) || errors.Is(err, errUnsupportedLinter) {
+				messages = append(messages, fmt.Sprintf("rule %s: %v", userRule.ID, err))
+			}
+		}
+		sort.Strings(messages)
+		result.Warnings = append(result.Warnings, messages...)
 	}
+
+	// Log fallback info
 	if totalFallbacks > 0 {
 		fmt.Fprintf(os.Stderr, "ℹ️  %d rule(s) fell back to llm-validator due to conversion failures\n", totalFallbacks)
 	}
@@ -190,6 +368,21 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 		return result, fmt.Errorf("all conversions failed")
 	}
 
+	// Record routing decisions for review
+	for _, userRule := range userPolicy.Rules {
+		decision := RoutingDecision{
+			RuleID:    userRule.ID,
+			Linters:   append([]string(nil), ruleToLinters[userRule.ID]...),
+			Reason:    routes[userRule.ID].reason,
+			Fallbacks: fallbacks[userRule.ID],
+		}
+		if cached, ok := cachedRules[userRule.ID]; ok {
+			decision.Reason = cached.reason
+			decision.Cached = true
+		}
+		result.Routing = append(result.Routing, decision)
+	}
+
 	// Step 5: Generate CodePolicy rules from UserPolicy
 	for _, userRule := range userPolicy.Rules {
 		linters := ruleToLinters[userRule.ID]
@@ -211,6 +404,9 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 					"desc":   userRule.Say,
 				},
 			}
+			if ids, ok := nativeRuleIDs[userRule.ID+"/"+linterName]; ok {
+				policyRule.Check["ruleIds"] = ids
+			}
 
 			// Special handling for LLM validator - ensure required fields
 			if linterName == llmValidatorEngine {
@@ -231,6 +427,11 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 					}
 				}
 
+				// Params are passed to the LLM prompt with their exact values
+				if len(userRule.Params) > 0 {
+					policyRule.Check["params"] = userRule.Params
+				}
+
 				// Ensure desc is not empty (required for LLM prompt)
 				if policyRule.Desc == "" {
 					policyRule.Desc = "Code quality check"
@@ -241,7 +442,7 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 			if linterName != llmValidatorEngine && (len(userRule.Languages) > 0 || len(userRule.Include) > 0 || len(userRule.Exclude) > 0) {
 				// Filter languages to only those supported by this linter
 				filteredLanguages := userRule.Languages
-				if conv, ok := linter.Global().GetConverter(linterName); ok {
+				if conv, ok := c.registry().GetConverter(linterName); ok {
 					supportedLangs := conv.SupportedLanguages()
 					if len(supportedLangs) > 0 && len(userRule.Languages) > 0 {
 						filteredLanguages = intersectLanguages(userRule.Languages, supportedLangs)
@@ -281,25 +482,52 @@ func (c *Converter) Convert(ctx context.Context, userPolicy *schema.UserPolicy)
 		return result, fmt.Errorf("failed to marshal code policy: %w", err)
 	}
 
+	result.GeneratedFiles = append(result.GeneratedFiles, codePolicyPath)
+	result.Files[codePolicyPath] = codePolicyJSON
+	result.RemovedFiles = c.staleConfigs(previousManifest, result.Files)
+	if c.dryRun {
+		return result, nil
+	}
+
 	if err := os.WriteFile(codePolicyPath, codePolicyJSON, 0644); err != nil {
 		return result, fmt.Errorf("failed to write code policy: %w", err)
 	}
-
-	result.GeneratedFiles = append(result.GeneratedFiles, codePolicyPath)
 	fmt.Fprintf(os.Stderr, "✓ Generated code policy: %s\n", codePolicyPath)
 
+	// Step 6.1: Remove configs no rule is routed to anymore
+	for _, path := range result.RemovedFiles {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to remove stale config %s: %v", path, err))
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "✓ Removed stale configuration: %s\n", path)
+	}
+
+	// Step 7: Write the conversion manifest for the next incremental run
+	if err := nextManifest.save(c.outputDir); err != nil {
+		result.Warnings = append(result.Warnings, err.Error())
+	}
+
 	return result, nil
 }
 
+// routeInfo records why a rule was routed the way it was.
+type routeInfo struct {
+	reason string // LLM's rationale, or why the LLM was not asked
+	failed bool   // Fell back to llm-validator because the LLM call failed, not because of its answer
+}
+
 // routeRulesWithLLM uses LLM to determine which linters are appropriate for each rule
-// Rules are processed in parallel with concurrency limited to CPU count
-func (c *Converter) routeRulesWithLLM(ctx context.Context, userPolicy *schema.UserPolicy) map[string][]schema.UserRule {
+// Rules are processed in parallel with concurrency limited to CPU count.
+// The returned routes explain each rule's routing by rule ID.
+func (c *Converter) routeRulesWithLLM(ctx context.Context, userPolicy *schema.UserPolicy, rules []schema.UserRule) (map[string][]schema.UserRule, map[string]routeInfo) {
 	type routeResult struct {
 		rule    schema.UserRule
 		linters []string
+		info    routeInfo
 	}
 
-	results := make(chan routeResult, len(userPolicy.Rules))
+	results := make(chan routeResult, len(rules))
 	var wg sync.WaitGroup
 
 	// Limit concurrent LLM calls: CPU/2 (minimum 1)
@@ -310,13 +538,19 @@ func (c *Converter) routeRulesWithLLM(ctx context.Context, userPolicy *schema.Us
 	sem := make(chan struct{}, maxConcurrent)
 
 	// Build category name -> description map
-	categoryMap := make(map[string]string)
-	for _, cat := range userPolicy.Category {
-		categoryMap[cat.Name] = cat.Description
-	}
+	categoryMap := categoryDescriptions(userPolicy)
 
 	// Process rules in parallel with concurrency limit
-	for _, rule := range userPolicy.Rules {
+	for _, rule := range rules {
+		// Rules that pin their engines are not routed by the LLM
+		if engines := pinnedEngines(rule); len(engines) > 0 {
+			select {
+			case results <- routeResult{rule: rule, linters: engines, info: routeInfo{reason: "pinned by rule"}}:
+			case <-ctx.Done():
+			}
+			continue
+		}
+
 		// Get languages for this rule
 		languages := rule.Languages
 		if len(languages) == 0 && userPolicy.Defaults != nil {
@@ -327,8 +561,9 @@ func (c *Converter) routeRulesWithLLM(ctx context.Context, userPolicy *schema.Us
 		availableLinters := c.getAvailableLinters(languages)
 		if len(availableLinters) == 0 {
 			// No language-specific linters, use llm-validator
+			info := routeInfo{reason: fmt.Sprintf("no linters available for languages %s", strings.Join(languages, ", "))}
 			select {
-			case results <- routeResult{rule: rule, linters: []string{llmValidatorEngine}}:
+			case results <- routeResult{rule: rule, linters: []string{llmValidatorEngine}, info: info}:
 			case <-ctx.Done():
 				continue
 			}
@@ -348,19 +583,24 @@ func (c *Converter) routeRulesWithLLM(ctx context.Context, userPolicy *schema.Us
 			defer func() { <-sem }()
 
 			// Ask LLM which linters are appropriate for this rule
-			selectedLinters := c.selectLintersForRule(ctx, r, linters, catMap)
+			selectedLinters, reason, err := c.selectLintersForRule(ctx, r, linters, catMap)
+			info := routeInfo{reason: reason}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				info = routeInfo{reason: err.Error(), failed: true}
+			}
 
 			// Send result with context check to prevent deadlock
 			if len(selectedLinters) == 0 {
 				// LLM couldn't map to any linter, use llm-validator
 				select {
-				case results <- routeResult{rule: r, linters: []string{llmValidatorEngine}}:
+				case results <- routeResult{rule: r, linters: []string{llmValidatorEngine}, info: info}:
 				case <-ctx.Done():
 					return
 				}
 			} else {
 				select {
-				case results <- routeResult{rule: r, linters: selectedLinters}:
+				case results <- routeResult{rule: r, linters: selectedLinters, info: info}:
 				case <-ctx.Done():
 					return
 				}
@@ -376,23 +616,25 @@ func (c *Converter) routeRulesWithLLM(ctx context.Context, userPolicy *schema.Us
 
 	// Collect results
 	linterRules := make(map[string][]schema.UserRule)
+	routes := make(map[string]routeInfo)
 	for result := range results {
 		for _, linter := range result.linters {
 			linterRules[linter] = append(linterRules[linter], result.rule)
 		}
+		routes[result.rule.ID] = result.info
 	}
 
-	return linterRules
+	return linterRules, routes
 }
 
 // getAvailableLinters returns available linters for given languages
 func (c *Converter) getAvailableLinters(languages []string) []string {
 	// Build language mapping dynamically from registry
-	languageLinterMapping := linter.Global().BuildLanguageMapping()
+	languageLinterMapping := c.registry().BuildLanguageMapping()
 
 	if len(languages) == 0 {
 		// If no languages specified, return all registered tools
-		return linter.Global().GetAllToolNames()
+		return c.registry().GetAllToolNames()
 	}
 
 	linterSet := make(map[string]bool)
@@ -411,8 +653,52 @@ func (c *Converter) getAvailableLinters(languages []string) []string {
 	return result
 }
 
-// selectLintersForRule uses LLM to determine which linters are appropriate for a rule
-func (c *Converter) selectLintersForRule(ctx context.Context, rule schema.UserRule, availableLinters []string, categoryMap map[string]string) []string {
+// pinnedEngines returns the engines a rule pins with "engines" and "native",
+// without duplicates. Native engines follow the listed ones in name order.
+func pinnedEngines(rule schema.UserRule) []string {
+	var engines []string
+	seen := make(map[string]bool)
+	for _, engine := range rule.Engines {
+		if !seen[engine] {
+			seen[engine] = true
+			engines = append(engines, engine)
+		}
+	}
+	var native []string
+	for engine := range rule.Native {
+		if !seen[engine] {
+			native = append(native, engine)
+		}
+	}
+	sort.Strings(native)
+	return append(engines, native...)
+}
+
+// CheckEngines reports engines a rule pins ("engines", "native") that are
+// neither llm-validator nor registered linters, e.g. a misspelled name.
+// Declarative plugins of the output directory count as registered.
+func (c *Converter) CheckEngines(rule schema.UserRule) error {
+	var unknown []string
+	for _, engine := range pinnedEngines(rule) {
+		if engine != llmValidatorEngine && c.getLinterConverter(engine) == nil {
+			unknown = append(unknown, engine)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	available := []string{llmValidatorEngine}
+	for _, conv := range c.registry().GetAllConverters() {
+		available = append(available, conv.Name())
+	}
+	sort.Strings(available)
+	return fmt.Errorf("%w: %s (available: %s)", errUnsupportedLinter, strings.Join(unknown, ", "), strings.Join(available, ", "))
+}
+
+// selectLintersForRule uses LLM to determine which linters are appropriate for a rule,
+// with the LLM's one-sentence rationale.
+// An error means the LLM call or its response failed; the rule then falls back to llm-validator.
+func (c *Converter) selectLintersForRule(ctx context.Context, rule schema.UserRule, availableLinters []string, categoryMap map[string]string) ([]string, string, error) {
 	// Build linter descriptions dynamically from registry
 	linterDescriptions := c.buildLinterDescriptions(availableLinters)
 
@@ -435,49 +721,44 @@ STRICT Rules for selection:
 
 Available linters for this rule: %s
 
-Return ONLY a JSON array of linter names (no markdown):
-["linter1", "linter2"] or []
+Return ONLY a JSON object (no markdown) with the selected linter names and a
+one-sentence reason for the selection:
+{"linters": ["linter1", "linter2"], "reason": "..."} or {"linters": [], "reason": "..."}
 
 Examples:
 
 Input: "Use single quotes for strings"
-Output: ["prettier"]
+Output: {"linters": ["prettier"], "reason": "Prettier's singleQuote option formats string quotes"}
 
 Input: "No console.log allowed"
-Output: ["eslint"]
+Output: {"linters": ["eslint"], "reason": "ESLint's no-console rule reports console calls"}
 
 Input: "Classes start with capital letter"
-Output: ["eslint"]
+Output: {"linters": ["eslint"], "reason": "ESLint's new-cap and naming rules check class names"}
 
 Input: "Maximum line length is 120"
-Output: ["prettier"]
+Output: {"linters": ["prettier"], "reason": "Prettier's printWidth option wraps long lines"}
 
 Input: "No implicit any types"
-Output: ["tsc"]
+Output: {"linters": ["tsc"], "reason": "The noImplicitAny compiler option enforces this"}
 
 Input: "All async functions must have try-catch"
-Output: []
-Reason: Requires semantic understanding of error handling
+Output: {"linters": [], "reason": "Requires semantic understanding of error handling"}
 
 Input: "File names must be kebab-case"
-Output: []
-Reason: File naming requires plugin
+Output: {"linters": [], "reason": "File naming requires plugin"}
 
 Input: "API handlers must return proper status codes"
-Output: []
-Reason: Requires business logic understanding
+Output: {"linters": [], "reason": "Requires business logic understanding"}
 
 Input: "Database queries must use parameterized queries"
-Output: []
-Reason: Requires understanding SQL injection context
+Output: {"linters": [], "reason": "Requires understanding SQL injection context"}
 
 Input: "No hardcoded API keys or passwords"
-Output: []
-Reason: Requires semantic analysis of what constitutes secrets
+Output: {"linters": [], "reason": "Requires semantic analysis of what constitutes secrets"}
 
 Input: "Imports from large packages must be specific"
-Output: []
-Reason: Requires knowing which packages are "large"`, linterDescriptions, routingHints, availableLinters)
+Output: {"linters": [], "reason": "Requires knowing which packages are \"large\""}`, linterDescriptions, routingHints, availableLinters)
 
 	categoryInfo := rule.Category
 	if desc, ok := categoryMap[rule.Category]; ok && desc != "" {
@@ -489,30 +770,53 @@ Reason: Requires knowing which packages are "large"`, linterDescriptions, routin
 	prompt := systemPrompt + "\n\n" + userPrompt
 	response, err := c.llmProvider.Execute(ctx, prompt, llm.JSON)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: LLM routing failed for rule %s: %v\n", rule.ID, err)
-		return []string{} // Will fall back to llm-validator
+		return []string{}, "", fmt.Errorf("LLM routing failed for rule %s: %w", rule.ID, err) // Will fall back to llm-validator
+	}
+
+	selectedLinters, reason, err := parseRoutingResponse(response)
+	if err != nil {
+		return []string{}, "", fmt.Errorf("failed to parse LLM response for rule %s: %w", rule.ID, err) // Will fall back to llm-validator
 	}
 
-	// Parse response
+	return selectedLinters, reason, nil
+}
+
+// parseRoutingResponse parses a routing answer: {"linters": [...], "reason": "..."},
+// or a bare array of linter names without a reason.
+func parseRoutingResponse(response string) ([]string, string, error) {
 	response = strings.TrimSpace(response)
 	response = strings.TrimPrefix(response, "```json")
 	response = strings.TrimPrefix(response, "```")
 	response = strings.TrimSuffix(response, "```")
 	response = strings.TrimSpace(response)
 
+	var answer struct {
+		Linters []string `json:"linters"`
+		Reason  string   `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(response), &answer); err == nil {
+		return answer.Linters, answer.Reason, nil
+	}
+
 	var selectedLinters []string
 	if err := json.Unmarshal([]byte(response), &selectedLinters); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: Failed to parse LLM response for rule %s: %v\n", rule.ID, err)
-		return []string{} // Will fall back to llm-validator
+		return nil, "", err
 	}
+	return selectedLinters, "", nil
+}
 
-	return selectedLinters
+// registry returns the built-in linters plus the declarative plugins in the
+// output directory. Plugins are scoped to the output directory, so converting
+// one package never sees another package's plugins.
+func (c *Converter) registry() *linter.Registry {
+	registry, _, _ := plugin.NewRegistry(linter.Global(), c.outputDir)
+	return registry
 }
 
 // getLinterConverter returns the appropriate converter for a linter
 func (c *Converter) getLinterConverter(linterName string) linter.Converter {
 	// Use registry to get converter (no hardcoding)
-	converter, ok := linter.Global().GetConverter(linterName)
+	converter, ok := c.registry().GetConverter(linterName)
 	if !ok {
 		return nil
 	}
@@ -524,7 +828,7 @@ func (c *Converter) buildLinterDescriptions(availableLinters []string) string {
 	var descriptions []string
 
 	for _, linterName := range availableLinters {
-		converter, ok := linter.Global().GetConverter(linterName)
+		converter, ok := c.registry().GetConverter(linterName)
 		if !ok || converter == nil {
 			continue
 		}
@@ -548,7 +852,7 @@ func (c *Converter) buildRoutingHints(availableLinters []string) string {
 	hintNumber := 7 // Start after the base rules (1-6)
 
 	for _, linterName := range availableLinters {
-		converter, ok := linter.Global().GetConverter(linterName)
+		converter, ok := c.registry().GetConverter(linterName)
 		if !ok || converter == nil {
 			continue
 		}
@@ -571,13 +875,15 @@ func (c *Converter) buildRoutingHints(availableLinters []string) string {
 type conversionTask struct {
 	linterName string
 	rule       schema.UserRule
+	languages  []string // Effective languages of the rule
 }
 
 // convertAllTasks converts all (linter, rule) pairs in parallel with a single semaphore.
-// Returns results grouped by linter name, and failed rule IDs grouped by linter name.
-func (c *Converter) convertAllTasks(ctx context.Context, tasks []conversionTask) (map[string][]*linter.SingleRuleResult, map[string][]string) {
+// Returns results grouped by linter name, failed rule IDs grouped by linter name,
+// and the errors of conversions that failed (rather than skipped) by rule ID and linter.
+func (c *Converter) convertAllTasks(ctx context.Context, tasks []conversionTask) (map[string][]*linter.SingleRuleResult, map[string][]string, map[string]map[string]error) {
 	if len(tasks) == 0 {
-		return make(map[string][]*linter.SingleRuleResult), make(map[string][]string)
+		return make(map[string][]*linter.SingleRuleResult), make(map[string][]string), make(map[string]map[string]error)
 	}
 
 	type taskResult struct {
@@ -615,11 +921,20 @@ func (c *Converter) convertAllTasks(ctx context.Context, tasks []conversionTask)
 			// Get converter and convert single rule
 			converter := c.getLinterConverter(t.linterName)
 			if converter == nil {
-				results <- taskResult{linterName: t.linterName, ruleID: t.rule.ID, err: fmt.Errorf("unsupported linter: %s", t.linterName)}
+				results <- taskResult{linterName: t.linterName, ruleID: t.rule.ID, err: fmt.Errorf("%w: %s", errUnsupportedLinter, t.linterName)}
 				return
 			}
 
-			res, err := converter.ConvertSingleRule(ctx, t.rule, c.llmProvider)
+			var res *linter.SingleRuleResult
+			var err error
+			if native, ok := t.rule.Native[t.linterName]; ok {
+				res, err = convertNative(ctx, converter, t.rule, native)
+			} else if err = validateParams(converter, t.rule); err == nil {
+				res, err = converter.ConvertSingleRule(ctx, t.rule, c.llmProvider)
+			}
+			if err == nil && res != nil && c.verify {
+				err = c.verifyExamples(ctx, converter, t, res)
+			}
 			results <- taskResult{linterName: t.linterName, result: res, ruleID: t.rule.ID, err: err}
 		}(task)
 	}
@@ -633,10 +948,15 @@ func (c *Converter) convertAllTasks(ctx context.Context, tasks []conversionTask)
 	// Collect and group results by linter
 	successByLinter := make(map[string][]*linter.SingleRuleResult)
 	failedByLinter := make(map[string][]string)
+	erroredRules := make(map[string]map[string]error)
 
 	for res := range results {
 		if res.err != nil {
 			failedByLinter[res.linterName] = append(failedByLinter[res.linterName], res.ruleID)
+			if erroredRules[res.ruleID] == nil {
+				erroredRules[res.ruleID] = make(map[string]error)
+			}
+			erroredRules[res.ruleID][res.linterName] = res.err
 			continue
 		}
 		if res.result == nil {
@@ -647,7 +967,88 @@ func (c *Converter) convertAllTasks(ctx context.Context, tasks []conversionTask)
 		successByLinter[res.linterName] = append(successByLinter[res.linterName], res.result)
 	}
 
-	return successByLinter, failedByLinter
+	return successByLinter, failedByLinter, erroredRules
+}
+
+// convertNative converts a rule's native config for a linter without the LLM.
+func convertNative(ctx context.Context, converter linter.Converter, rule schema.UserRule, native any) (*linter.SingleRuleResult, error) {
+	nc, ok := converter.(linter.NativeConverter)
+	if !ok {
+		return nil, fmt.Errorf("%s does not accept native config", converter.Name())
+	}
+	data, err := json.Marshal(native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode native config: %w", err)
+	}
+	res, err := nc.ConvertNative(ctx, rule, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid native %s config: %w", converter.Name(), err)
+	}
+	return res, nil
+}
+
+// errUnsupportedLinter marks engines that are not registered.
+var errUnsupportedLinter = errors.New("unsupported linter")
+
+// errInvalidParams marks rules whose params the linter's parameter schema rejects.
+var errInvalidParams = errors.New("invalid params")
+
+// validateParams checks a rule's params against the linter's parameter schema.
+// Linters without a schema get the params in their LLM prompt only.
+func validateParams(converter linter.Converter, rule schema.UserRule) error {
+	ps, ok := converter.(linter.ParamSchema)
+	if !ok || len(rule.Params) == 0 {
+		return nil
+	}
+	if err := linter.ValidateParams(rule.Params, ps.RuleParams()); err != nil {
+		return fmt.Errorf("%w for %s: %v", errInvalidParams, converter.Name(), err)
+	}
+	return nil
+}
+
+// verifyExamples runs a rule's examples against its fresh conversion.
+// Rules without examples, linters that are not installed and examples that
+// cannot be run pass; only examples the conversion gets wrong fail it.
+func (c *Converter) verifyExamples(ctx context.Context, converter linter.Converter, t conversionTask, res *linter.SingleRuleResult) error {
+	examples := linter.Examples(t.rule)
+	if len(examples.Good) == 0 && len(examples.Bad) == 0 {
+		return nil
+	}
+
+	config, err := converter.BuildConfig([]*linter.SingleRuleResult{res})
+	if err != nil {
+		return fmt.Errorf("failed to build config for examples: %w", err)
+	}
+	var content []byte
+	if config != nil {
+		content = config.Content
+	}
+
+	outcome := validator.TestExamples(ctx, validator.ExampleTest{
+		Rule: schema.PolicyRule{
+			ID:    t.rule.ID + "-" + t.linterName,
+			Desc:  t.rule.Say,
+			Check: map[string]interface{}{"engine": t.linterName, "ruleIds": res.NativeRuleIDs},
+		},
+		Config:   content,
+		Examples: examples,
+		Language: linter.ExampleLanguage(t.rule, intersectLanguages(t.languages, converter.SupportedLanguages())),
+	}, validator.ExampleOptions{SymDir: c.outputDir})
+
+	switch {
+	case outcome.Skipped != "":
+		return nil
+	case outcome.Error != "":
+		// The examples could not be run, which says nothing about the conversion
+		fmt.Fprintf(os.Stderr, "Warning: could not check examples of rule %s with %s: %s\n",
+			t.rule.ID, t.linterName, outcome.Error)
+		return nil
+	case !outcome.Passed():
+		fmt.Fprintf(os.Stderr, "Warning: %s conversion of rule %s failed its examples (%s)\n",
+			t.linterName, t.rule.ID, strings.Join(outcome.Failures(), ", "))
+		return fmt.Errorf("examples failed: %s", strings.Join(outcome.Failures(), ", "))
+	}
+	return nil
 }
 
 // convertRBAC converts UserRBAC to PolicyRBAC
@@ -720,6 +1121,17 @@ func (c *Converter) convertRBAC(userRBAC *schema.UserRBAC) *schema.PolicyRBAC {
 	return policyRBAC
 }
 
+// removeLinter returns linters without name.
+func removeLinter(linters []string, name string) []string {
+	result := []string{}
+	for _, l := range linters {
+		if l != name {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
 // intersectLanguages returns the intersection of two language slices.
 // It normalizes language names (e.g., "ts" -> "typescript") for comparison.
 func intersectLanguages(langs1, langs2 []string) []string {
@@ -0,0 +1,264 @@
+package converter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reasonProvider routes every rule to the fake linter with a reason.
+type reasonProvider struct{}
+
+func (reasonProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	return `{"linters": ["` + fakeLinterName + `"], "reason": "fake linter enforces it"}`, nil
+}
+
+func (reasonProvider) Name() string { return "reason" }
+func (reasonProvider) Close() error { return nil }
+
+func TestParseRoutingResponse(t *testing.T) {
+	linters, reason, err := parseRoutingResponse("```json\n{\"linters\": [\"eslint\"], \"reason\": \"no-console\"}\n```")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eslint"}, linters)
+	assert.Equal(t, "no-console", reason)
+
+	linters, reason, err = parseRoutingResponse(`["prettier"]`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prettier"}, linters)
+	assert.Empty(t, reason)
+
+	_, _, err = parseRoutingResponse("eslint")
+	assert.Error(t, err)
+}
+
+func TestConvert_DryRun(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(reasonProvider{}, dir)
+	conv.SetDryRun(true)
+
+	result, err := conv.Convert(context.Background(), fakePolicy("one", "skip"))
+	require.NoError(t, err)
+
+	// Nothing is written, not even the manifest
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	assert.Equal(t, "one", string(result.Files[filepath.Join(dir, "fake.txt")]))
+	assert.Contains(t, string(result.Files[filepath.Join(dir, "code-policy.json")]), "A-"+fakeLinterName)
+
+	require.Len(t, result.Routing, 2)
+	assert.Equal(t, RoutingDecision{
+		RuleID:  "A",
+		Linters: []string{fakeLinterName},
+		Reason:  "fake linter enforces it",
+	}, result.Routing[0])
+	assert.Equal(t, RoutingDecision{
+		RuleID:    "B",
+		Linters:   []string{llmValidatorEngine},
+		Reason:    "fake linter enforces it",
+		Fallbacks: map[string]string{fakeLinterName: "cannot be enforced by " + fakeLinterName},
+	}, result.Routing[1])
+}
+
+func TestConvert_RoutingReasonIsCached(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(reasonProvider{}, dir)
+
+	_, err := conv.Convert(context.Background(), fakePolicy("one"))
+	require.NoError(t, err)
+
+	conv.SetDryRun(true)
+	result, err := conv.Convert(context.Background(), fakePolicy("one"))
+	require.NoError(t, err)
+	require.Len(t, result.Routing, 1)
+	assert.True(t, result.Routing[0].Cached)
+	assert.Equal(t, "fake linter enforces it", result.Routing[0].Reason)
+}
+
+func TestDiffFiles(t *testing.T) {
+	dir := t.TempDir()
+	same := filepath.Join(dir, "same.json")
+	changed := filepath.Join(dir, "changed.json")
+	added := filepath.Join(dir, "added.json")
+	removed := filepath.Join(dir, "removed.json")
+	require.NoError(t, os.WriteFile(same, []byte("{}\n"), 0644))
+	require.NoError(t, os.WriteFile(changed, []byte("a\nb\n"), 0644))
+	require.NoError(t, os.WriteFile(removed, []byte("old\n"), 0644))
+
+	changes, err := DiffFiles(map[string][]byte{
+		same:    []byte("{}\n"),
+		changed: []byte("a\nc\n"),
+		added:   []byte("new\n"),
+	}, []string{removed, filepath.Join(dir, "missing.json")})
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+
+	assert.Equal(t, FileChange{Path: added, Status: FileAdded, Diff: changes[0].Diff}, changes[0])
+	assert.Contains(t, changes[0].Diff, "--- /dev/null")
+	assert.Contains(t, changes[0].Diff, "+new")
+
+	assert.Equal(t, FileModified, changes[1].Status)
+	assert.Contains(t, changes[1].Diff, "-b\n+c\n")
+
+	assert.Equal(t, removed, changes[2].Path)
+	assert.Equal(t, FileRemoved, changes[2].Status)
+	assert.Contains(t, changes[2].Diff, "+++ /dev/null")
+	assert.Contains(t, changes[2].Diff, "-old")
+
+	assert.Equal(t, FileChange{Path: same, Status: FileUnchanged}, changes[3])
+}
+
+func TestConvert_RemovesStaleConfigs(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(reasonProvider{}, dir)
+	fakeConfig := filepath.Join(dir, "fake.txt")
+	userConfig := filepath.Join(dir, ".eslintrc.json")
+	require.NoError(t, os.WriteFile(userConfig, []byte("{}"), 0644))
+
+	_, err := conv.Convert(context.Background(), fakePolicy("one"))
+	require.NoError(t, err)
+	require.FileExists(t, fakeConfig)
+
+	// The fake linter can no longer enforce the rule, so its config goes away
+	conv.SetDryRun(true)
+	result, err := conv.Convert(context.Background(), fakePolicy("skip"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{fakeConfig}, result.RemovedFiles)
+	assert.FileExists(t, fakeConfig, "dry run removes nothing")
+
+	conv.SetDryRun(false)
+	result, err = conv.Convert(context.Background(), fakePolicy("skip"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{fakeConfig}, result.RemovedFiles)
+	assert.NoFileExists(t, fakeConfig)
+	assert.FileExists(t, userConfig, "configs of linters never routed to are kept")
+}
+
+func TestPinnedEngines(t *testing.T) {
+	assert.Empty(t, pinnedEngines(schema.UserRule{ID: "A"}))
+	assert.Equal(t, []string{"eslint", "llm-validator", "prettier", "stylelint"}, pinnedEngines(schema.UserRule{
+		Engines: []string{"eslint", "llm-validator", "eslint"},
+		Native:  map[string]any{"stylelint": nil, "prettier": nil, "eslint": nil},
+	}))
+}
+
+func TestCheckEngines(t *testing.T) {
+	setupFakeLinter(t)
+	conv := NewConverter(nil, t.TempDir())
+
+	assert.NoError(t, conv.CheckEngines(schema.UserRule{ID: "A", Engines: []string{fakeLinterName, llmValidatorEngine}}))
+
+	err := conv.CheckEngines(schema.UserRule{ID: "A", Engines: []string{fakeLinterName, "eslnt"}, Native: map[string]any{"pretier": "{}"}})
+	assert.ErrorIs(t, err, errUnsupportedLinter)
+	assert.Contains(t, err.Error(), "unsupported linter: eslnt, pretier (available: ")
+	assert.Contains(t, err.Error(), fakeLinterName)
+}
+
+func TestConvert_UnknownPinnedEngineWarns(t *testing.T) {
+	setupFakeLinter(t)
+	conv := NewConverter(&routingProvider{}, t.TempDir())
+
+	policy := fakePolicy("typo")
+	policy.Rules[0].Engines = []string{"eslnt"}
+
+	result, err := conv.Convert(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, []string{llmValidatorEngine}, result.Routing[0].Linters)
+	assert.Equal(t, []string{"rule A: unsupported linter: eslnt"}, result.Warnings)
+}
+
+func TestConvert_PinnedAndNativeRules(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	provider := &routingProvider{}
+	conv := NewConverter(provider, dir)
+
+	policy := fakePolicy("pinned", "native", "bad native")
+	policy.Rules[0].Engines = []string{fakeLinterName}
+	policy.Rules[1].Native = map[string]any{fakeLinterName: "as is"}
+	policy.Rules[2].Native = map[string]any{fakeLinterName: 42}
+
+	result, err := conv.Convert(context.Background(), policy)
+	require.NoError(t, err)
+
+	// No routing calls; only the pinned rule without native config is converted
+	assert.Equal(t, 0, provider.calls)
+	assert.Equal(t, map[string]int{"A": 1}, fakeConv.reset())
+
+	content, err := os.ReadFile(filepath.Join(dir, "fake.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "as is\npinned", string(content))
+
+	require.Len(t, result.Routing, 3)
+	assert.Equal(t, "pinned by rule", result.Routing[0].Reason)
+	assert.Equal(t, []string{fakeLinterName}, result.Routing[1].Linters)
+
+	// Invalid native config falls back with a warning
+	assert.Equal(t, []string{llmValidatorEngine}, result.Routing[2].Linters)
+	assert.Contains(t, result.Routing[2].Fallbacks[fakeLinterName], "invalid native "+fakeLinterName+" config")
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "rule C: invalid native")
+}
+
+func TestConvert_NoLLM(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(&routingProvider{}, dir)
+
+	policy := fakePolicy("skip", "no linter", "one")
+	policy.Rules[0].NoLLM = true
+	policy.Rules[1].NoLLM = true
+	policy.Rules[1].Languages = []string{"otherlang"}
+	policy.Rules[2].NoLLM = true
+
+	result, err := conv.Convert(context.Background(), policy)
+	require.NoError(t, err)
+
+	require.Len(t, result.CodePolicy.Rules, 1)
+	assert.Equal(t, "C-"+fakeLinterName, result.CodePolicy.Rules[0].ID)
+	assert.Empty(t, result.Routing[0].Linters)
+	assert.Equal(t, "cannot be enforced by "+fakeLinterName, result.Routing[0].Fallbacks[fakeLinterName])
+	assert.Empty(t, result.Routing[1].Linters)
+	assert.Len(t, result.Warnings, 2)
+	assert.Contains(t, result.Warnings[0], "rule A is not enforced")
+}
+
+func TestConvert_Params(t *testing.T) {
+	setupFakeLinter(t)
+	fakeConv.reset()
+	dir := t.TempDir()
+	conv := NewConverter(&routingProvider{}, dir)
+
+	policy := fakePolicy("invalid", "valid")
+	policy.Rules[0].Params = map[string]any{"max": "many"}
+	policy.Rules[1].Params = map[string]any{"max": float64(3)}
+
+	result, err := conv.Convert(context.Background(), policy)
+	require.NoError(t, err)
+
+	calls := fakeConv.reset()
+	assert.Zero(t, calls["A"], "invalid params must not reach ConvertSingleRule")
+	assert.Equal(t, 1, calls["B"])
+
+	assert.Equal(t, []string{llmValidatorEngine}, result.Routing[0].Linters)
+	assert.Contains(t, result.Routing[0].Fallbacks[fakeLinterName], "invalid params for "+fakeLinterName)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "rule A: invalid params")
+
+	rules := make(map[string]schema.PolicyRule)
+	for _, rule := range result.CodePolicy.Rules {
+		rules[rule.ID] = rule
+	}
+	assert.Equal(t, map[string]any{"max": "many"}, rules["A-"+llmValidatorEngine].Check["params"])
+	assert.Nil(t, rules["B-"+fakeLinterName].Check["params"])
+}
@@ -0,0 +1,270 @@
+package converter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// manifestFilename is the conversion manifest written to the output directory.
+const manifestFilename = "conversion-manifest.json"
+
+// manifestVersion changes whenever cached entries become incompatible.
+const manifestVersion = 1
+
+// conversionManifest records, per user rule, the content hash the rule had
+// when it was converted together with its routing and conversion results.
+// Convert reuses the entries of unchanged rules instead of calling the LLM.
+type conversionManifest struct {
+	Version int                       `json:"version"`
+	Rules   map[string]*manifestEntry `json:"rules"`
+}
+
+// manifestEntry is the cached conversion of one user rule.
+type manifestEntry struct {
+	Hash    string                 `json:"hash"`
+	Linters []string               `json:"linters"`           // Routing result (may include llm-validator)
+	Reason  string                 `json:"reason,omitempty"`  // Routing rationale
+	Results map[string]*cachedRule `json:"results,omitempty"` // Conversion per routed linter
+}
+
+// cachedRule is one linter's SingleRuleResult, or a skip.
+type cachedRule struct {
+	Skipped       bool            `json:"skipped,omitempty"`
+	Data          json.RawMessage `json:"data,omitempty"`
+	NativeRuleIDs []string        `json:"nativeRuleIds,omitempty"`
+}
+
+// restoredRule is a manifest entry decoded for reuse.
+type restoredRule struct {
+	linters []string
+	reason  string
+	results map[string]*linter.SingleRuleResult // linter -> result
+	skipped []string                            // linters that could not enforce the rule
+}
+
+// loadManifest reads the manifest of outputDir. A missing, unreadable or
+// outdated manifest yields an empty one, so every rule is converted.
+func loadManifest(outputDir string) *conversionManifest {
+	empty := &conversionManifest{Version: manifestVersion, Rules: make(map[string]*manifestEntry)}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, manifestFilename))
+	if err != nil {
+		return empty
+	}
+	var m conversionManifest
+	if err := json.Unmarshal(data, &m); err != nil || m.Version != manifestVersion || m.Rules == nil {
+		return empty
+	}
+	return &m
+}
+
+// save writes the manifest to outputDir.
+func (m *conversionManifest) save(outputDir string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal conversion manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, manifestFilename), data, 0644); err != nil {
+		return fmt.Errorf("failed to write conversion manifest: %w", err)
+	}
+	return nil
+}
+
+// ruleHash hashes everything that routing and conversion of a rule depend on:
+// the rule itself, its effective languages, its category description and the
+// linters available for those languages (so new linters trigger re-routing).
+func (c *Converter) ruleHash(userPolicy *schema.UserPolicy, rule schema.UserRule, categoryMap map[string]string) string {
+	languages := rule.Languages
+	if len(languages) == 0 && userPolicy.Defaults != nil {
+		languages = userPolicy.Defaults.Languages
+	}
+	linters := c.getAvailableLinters(languages)
+	sort.Strings(linters)
+
+	input, _ := json.Marshal(struct {
+		Rule      schema.UserRule `json:"rule"`
+		Languages []string        `json:"languages"`
+		Category  string          `json:"category"`
+		Linters   []string        `json:"linters"`
+	}{rule, languages, categoryMap[rule.Category], linters})
+
+	sum := sha256.Sum256(input)
+	return hex.EncodeToString(sum[:])
+}
+
+// restore decodes a manifest entry. It fails when a routed linter is no
+// longer registered or its converter cannot decode cached data.
+func (e *manifestEntry) restore(registry *linter.Registry, ruleID string) (*restoredRule, bool) {
+	restored := &restoredRule{
+		linters: e.Linters,
+		reason:  e.Reason,
+		results: make(map[string]*linter.SingleRuleResult),
+	}
+	for _, linterName := range e.Linters {
+		if linterName == llmValidatorEngine {
+			continue
+		}
+		cached, ok := e.Results[linterName]
+		if !ok {
+			return nil, false
+		}
+		conv, ok := registry.GetConverter(linterName)
+		if !ok {
+			return nil, false
+		}
+		if cached.Skipped {
+			restored.skipped = append(restored.skipped, linterName)
+			continue
+		}
+		decoder, ok := conv.(linter.RuleDataDecoder)
+		if !ok {
+			return nil, false
+		}
+		data, err := decoder.DecodeRuleData(cached.Data)
+		if err != nil {
+			return nil, false
+		}
+		restored.results[linterName] = &linter.SingleRuleResult{
+			RuleID:        ruleID,
+			Data:          data,
+			NativeRuleIDs: cached.NativeRuleIDs,
+		}
+	}
+	return restored, true
+}
+
+// newManifestEntry records a fresh conversion. It returns nil when the
+// conversion cannot be cached: a result's converter cannot decode its data
+// again, or the data does not encode.
+func newManifestEntry(registry *linter.Registry, hash string, linters []string, reason string, results map[string]*linter.SingleRuleResult) *manifestEntry {
+	entry := &manifestEntry{
+		Hash:    hash,
+		Linters: linters,
+		Reason:  reason,
+		Results: make(map[string]*cachedRule),
+	}
+	for _, linterName := range linters {
+		if linterName == llmValidatorEngine {
+			continue
+		}
+		res, ok := results[linterName]
+		if !ok {
+			entry.Results[linterName] = &cachedRule{Skipped: true}
+			continue
+		}
+		conv, ok := registry.GetConverter(linterName)
+		if !ok {
+			return nil
+		}
+		if _, ok := conv.(linter.RuleDataDecoder); !ok {
+			return nil
+		}
+		data, err := json.Marshal(res.Data)
+		if err != nil {
+			return nil
+		}
+		entry.Results[linterName] = &cachedRule{Data: data, NativeRuleIDs: res.NativeRuleIDs}
+	}
+	return entry
+}
+
+// ChangedRules returns the IDs of user rules that were added, modified or
+// removed since the last conversion into the output directory.
+// ok is false when there is no conversion manifest to compare against.
+func (c *Converter) ChangedRules(userPolicy *schema.UserPolicy) (changed []string, ok bool) {
+	if _, err := os.Stat(filepath.Join(c.outputDir, manifestFilename)); err != nil {
+		return nil, false
+	}
+	manifest := loadManifest(c.outputDir)
+	categoryMap := categoryDescriptions(userPolicy)
+	seen := make(map[string]bool)
+	for _, rule := range userPolicy.Rules {
+		seen[rule.ID] = true
+		entry, exists := manifest.Rules[rule.ID]
+		if !exists || entry.Hash != c.ruleHash(userPolicy, rule, categoryMap) {
+			changed = append(changed, rule.ID)
+		}
+	}
+	var removed []string
+	for id := range manifest.Rules {
+		if !seen[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+	return append(changed, removed...), true
+}
+
+// staleConfigs returns the configs of linters the previous conversion routed
+// rules to that are on disk but not among the generated files, sorted by path.
+// Only linters recorded in the manifest are considered, so configs sym did not
+// generate are never reported.
+func (c *Converter) staleConfigs(previous *conversionManifest, generated map[string][]byte) []string {
+	seen := make(map[string]bool)
+	var stale []string
+	for _, entry := range previous.Rules {
+		for _, linterName := range entry.Linters {
+			configFile := c.registry().GetConfigFile(linterName)
+			if linterName == llmValidatorEngine || configFile == "" {
+				continue
+			}
+			path := filepath.Join(c.outputDir, configFile)
+			if seen[path] {
+				continue
+			}
+			seen[path] = true
+			if _, ok := generated[path]; ok {
+				continue
+			}
+			if _, err := os.Stat(path); err == nil {
+				stale = append(stale, path)
+			}
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// categoryDescriptions maps category names to descriptions.
+func categoryDescriptions(userPolicy *schema.UserPolicy) map[string]string {
+	categoryMap := make(map[string]string)
+	for _, cat := range userPolicy.Category {
+		categoryMap[cat.Name] = cat.Description
+	}
+	return categoryMap
+}
+
+// RuleConfig rebuilds the config one linter uses for a single rule from the
+// conversion manifest, with the native rule IDs it reports. ok is false when
+// the rule's last conversion for that linter is not in the manifest.
+func (c *Converter) RuleConfig(ruleID, linterName string) (config *linter.LinterConfig, nativeRuleIDs []string, ok bool) {
+	entry, exists := loadManifest(c.outputDir).Rules[ruleID]
+	if !exists {
+		return nil, nil, false
+	}
+	restored, restoredOK := entry.restore(c.registry(), ruleID)
+	if !restoredOK {
+		return nil, nil, false
+	}
+	res, exists := restored.results[linterName]
+	if !exists {
+		return nil, nil, false
+	}
+	conv, exists := c.registry().GetConverter(linterName)
+	if !exists {
+		return nil, nil, false
+	}
+	config, err := conv.BuildConfig([]*linter.SingleRuleResult{res})
+	if err != nil || config == nil {
+		return nil, nil, false
+	}
+	return config, res.NativeRuleIDs, true
+}
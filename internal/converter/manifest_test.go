@@ -0,0 +1,287 @@
+package converter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeLinterName = "fake-incremental"
+
+// fakeLinter is a registered tool for the fake language "fakelang".
+type fakeLinter struct{}
+
+func (fakeLinter) Name() string { return fakeLinterName }
+
+func (fakeLinter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{Name: fakeLinterName, SupportedLanguages: []string{"fakelang"}}
+}
+
+func (fakeLinter) CheckAvailability(ctx context.Context) error { return nil }
+
+func (fakeLinter) Install(ctx context.Context, config linter.InstallConfig) error { return nil }
+
+func (fakeLinter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	return &linter.ToolOutput{}, nil
+}
+
+func (fakeLinter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) { return nil, nil }
+
+type fakeRuleData struct {
+	Say string
+}
+
+// fakeConverter converts rules without the LLM and counts conversions per rule.
+// Rules saying "skip" are skipped, rules saying "fail" return an error.
+type fakeConverter struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *fakeConverter) Name() string                 { return fakeLinterName }
+func (c *fakeConverter) SupportedLanguages() []string { return []string{"fakelang"} }
+func (c *fakeConverter) GetLLMDescription() string    { return "fake linter" }
+func (c *fakeConverter) GetRoutingHints() []string    { return nil }
+
+func (c *fakeConverter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	c.mu.Lock()
+	c.calls[rule.ID]++
+	c.mu.Unlock()
+
+	switch rule.Say {
+	case "skip":
+		return nil, nil
+	case "fail":
+		return nil, errors.New("conversion failed")
+	}
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          fakeRuleData{Say: rule.Say},
+		NativeRuleIDs: []string{"native-" + rule.ID},
+	}, nil
+}
+
+// ConvertNative takes a JSON string to say, without counting a conversion.
+func (c *fakeConverter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var say string
+	if err := json.Unmarshal(native, &say); err != nil {
+		return nil, err
+	}
+	return &linter.SingleRuleResult{RuleID: rule.ID, Data: fakeRuleData{Say: say}}, nil
+}
+
+// RuleParams accepts the max param.
+func (c *fakeConverter) RuleParams() []linter.RuleParam {
+	return []linter.RuleParam{linter.MaxParam}
+}
+
+func (c *fakeConverter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[fakeRuleData](data)
+}
+
+func (c *fakeConverter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	var says []string
+	for _, r := range results {
+		says = append(says, r.Data.(fakeRuleData).Say)
+	}
+	sort.Strings(says)
+	return &linter.LinterConfig{Filename: "fake.txt", Content: []byte(strings.Join(says, "\n")), Format: "text"}, nil
+}
+
+func (c *fakeConverter) reset() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	calls := c.calls
+	c.calls = make(map[string]int)
+	return calls
+}
+
+// routingProvider answers every routing prompt with the fake linter.
+type routingProvider struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (p *routingProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	p.mu.Lock()
+	p.calls++
+	p.mu.Unlock()
+	return `["` + fakeLinterName + `"]`, nil
+}
+
+func (p *routingProvider) Name() string { return "routing" }
+func (p *routingProvider) Close() error { return nil }
+
+var (
+	fakeConv     = &fakeConverter{calls: make(map[string]int)}
+	registerOnce sync.Once
+)
+
+func setupFakeLinter(t *testing.T) {
+	t.Helper()
+	registerOnce.Do(func() {
+		require.NoError(t, linter.Global().RegisterTool(fakeLinter{}, fakeConv, "fake.txt"))
+	})
+	fakeConv.reset()
+}
+
+func fakePolicy(says ...string) *schema.UserPolicy {
+	policy := &schema.UserPolicy{Version: "1.0"}
+	for i, say := range says {
+		policy.Rules = append(policy.Rules, schema.UserRule{
+			ID:        string(rune('A' + i)),
+			Say:       say,
+			Languages: []string{"fakelang"},
+		})
+	}
+	return policy
+}
+
+func TestConvert_ReusesUnchangedRules(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	provider := &routingProvider{}
+	conv := NewConverter(provider, dir)
+
+	_, err := conv.Convert(context.Background(), fakePolicy("one", "two"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"A": 1, "B": 1}, fakeConv.reset())
+	assert.Equal(t, 2, provider.calls)
+
+	// Nothing changed: no routing and no conversion
+	result, err := conv.Convert(context.Background(), fakePolicy("one", "two"))
+	require.NoError(t, err)
+	assert.Empty(t, fakeConv.reset())
+	assert.Equal(t, 2, provider.calls)
+	assert.Equal(t, []string{"A", "B"}, result.ReusedRules)
+
+	content, err := os.ReadFile(filepath.Join(dir, "fake.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\ntwo", string(content))
+
+	require.Len(t, result.CodePolicy.Rules, 2)
+	assert.Equal(t, "A-"+fakeLinterName, result.CodePolicy.Rules[0].ID)
+	assert.Equal(t, []string{"native-A"}, result.CodePolicy.Rules[0].Check["ruleIds"])
+
+	// Only the edited rule is routed and converted again
+	result, err = conv.Convert(context.Background(), fakePolicy("one", "two (edited)"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"B": 1}, fakeConv.reset())
+	assert.Equal(t, 3, provider.calls)
+	assert.Equal(t, []string{"A"}, result.ReusedRules)
+
+	content, err = os.ReadFile(filepath.Join(dir, "fake.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\ntwo (edited)", string(content))
+}
+
+func TestConvert_CachesSkipsButRetriesErrors(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(&routingProvider{}, dir)
+
+	result, err := conv.Convert(context.Background(), fakePolicy("skip", "fail"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"A": 1, "B": 1}, fakeConv.reset())
+	for _, rule := range result.CodePolicy.Rules {
+		assert.Equal(t, llmValidatorEngine, rule.Check["engine"])
+	}
+
+	// The skip is cached with its llm-validator fallback; the error is retried
+	result, err = conv.Convert(context.Background(), fakePolicy("skip", "fail"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"B": 1}, fakeConv.reset())
+	assert.Equal(t, []string{"A"}, result.ReusedRules)
+	require.Len(t, result.CodePolicy.Rules, 2)
+	assert.Equal(t, "A-"+llmValidatorEngine, result.CodePolicy.Rules[0].ID)
+}
+
+func TestConvert_FullConversion(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(&routingProvider{}, dir)
+
+	_, err := conv.Convert(context.Background(), fakePolicy("one", "two"))
+	require.NoError(t, err)
+	fakeConv.reset()
+
+	conv.SetFullConversion(true)
+	result, err := conv.Convert(context.Background(), fakePolicy("one", "two"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"A": 1, "B": 1}, fakeConv.reset())
+	assert.Empty(t, result.ReusedRules)
+}
+
+func TestConverter_ChangedRules(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(&routingProvider{}, dir)
+
+	_, ok := conv.ChangedRules(fakePolicy("one"))
+	assert.False(t, ok, "no manifest before the first conversion")
+
+	_, err := conv.Convert(context.Background(), fakePolicy("one", "two", "three"))
+	require.NoError(t, err)
+
+	changed, ok := conv.ChangedRules(fakePolicy("one", "two", "three"))
+	assert.True(t, ok)
+	assert.Empty(t, changed)
+
+	// B edited, C removed
+	changed, ok = conv.ChangedRules(fakePolicy("one", "2"))
+	assert.True(t, ok)
+	assert.Equal(t, []string{"B", "C"}, changed)
+
+	// Category descriptions feed routing, so they count as changes
+	policy := fakePolicy("one", "two", "three")
+	policy.Rules[0].Category = "style"
+	policy.Category = []schema.CategoryDef{{Name: "style", Description: "Formatting"}}
+	changed, _ = conv.ChangedRules(policy)
+	assert.Equal(t, []string{"A"}, changed)
+}
+
+func TestLoadManifest_IgnoresInvalid(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, manifestFilename), []byte(`{"version": 0, "rules": {"A": {"hash": "x"}}}`), 0644))
+	assert.Empty(t, loadManifest(dir).Rules)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, manifestFilename), []byte(`not json`), 0644))
+	assert.Empty(t, loadManifest(dir).Rules)
+}
+
+func TestConvert_VerifyExamples(t *testing.T) {
+	setupFakeLinter(t)
+	dir := t.TempDir()
+	conv := NewConverter(&routingProvider{}, dir)
+	conv.SetVerifyExamples(true)
+
+	// The fake linter never reports anything: bad examples fail, good ones pass
+	policy := fakePolicy("bad", "good")
+	policy.Rules[0].Examples = &schema.RuleExamples{Language: "javascript", Bad: []string{"var a = 1;"}}
+	policy.Rules[1].Examples = &schema.RuleExamples{Language: "javascript", Good: []string{"const a = 1;"}}
+
+	result, err := conv.Convert(context.Background(), policy)
+	require.NoError(t, err)
+	require.Len(t, result.CodePolicy.Rules, 2)
+	assert.Equal(t, "A-"+llmValidatorEngine, result.CodePolicy.Rules[0].ID)
+	assert.Equal(t, "B-"+fakeLinterName, result.CodePolicy.Rules[1].ID)
+	fakeConv.reset()
+
+	// The failed conversion is not cached
+	result, err = conv.Convert(context.Background(), policy)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"A": 1}, fakeConv.reset())
+	assert.Equal(t, []string{"B"}, result.ReusedRules)
+}
@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// File change statuses
+const (
+	FileAdded     = "added"
+	FileModified  = "modified"
+	FileUnchanged = "unchanged"
+	FileRemoved   = "removed"
+)
+
+// FileChange compares a generated file with the file currently on disk.
+type FileChange struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`         // FileAdded, FileModified, FileUnchanged or FileRemoved
+	Diff   string `json:"diff,omitempty"` // Unified diff against the file on disk
+}
+
+// DiffFiles compares generated contents (ConvertResult.Files of a dry run)
+// with the files on disk, sorted by path. Removed files (ConvertResult.RemovedFiles)
+// are reported with a diff that deletes their whole content.
+func DiffFiles(files map[string][]byte, removed []string) ([]FileChange, error) {
+	paths := make([]string, 0, len(files)+len(removed))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	isRemoved := make(map[string]bool, len(removed))
+	for _, path := range removed {
+		if _, ok := files[path]; !ok {
+			isRemoved[path] = true
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := make([]FileChange, 0, len(paths))
+	for _, path := range paths {
+		change := FileChange{Path: path, Status: FileModified}
+		if isRemoved[path] {
+			change.Status = FileRemoved
+		}
+
+		current, err := os.ReadFile(path)
+		switch {
+		case os.IsNotExist(err) && change.Status == FileRemoved:
+			continue
+		case os.IsNotExist(err):
+			change.Status = FileAdded
+		case err != nil:
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		case change.Status != FileRemoved && bytes.Equal(current, files[path]):
+			change.Status = FileUnchanged
+			changes = append(changes, change)
+			continue
+		}
+
+		fromFile, before := "a/"+path, difflib.SplitLines(string(current))
+		toFile, after := "b/"+path, difflib.SplitLines(string(files[path]))
+		switch change.Status {
+		case FileAdded:
+			fromFile, before = "/dev/null", nil
+		case FileRemoved:
+			toFile, after = "/dev/null", nil
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        before,
+			B:        after,
+			FromFile: fromFile,
+			ToFile:   toFile,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+		}
+		change.Diff = diff
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
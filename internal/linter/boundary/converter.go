@@ -0,0 +1,185 @@
+package boundary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+// Converter converts layering conventions to dependency rules using LLM.
+type Converter struct{}
+
+// NewConverter creates a new boundary converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return Name
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return supportedLanguages
+}
+
+// GetLLMDescription returns a description of the boundary engine for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Built-in import-boundary engine (in-process import graph for Go, JS/TS, Python, Java)
+  - CAN: Layering/architecture rules between directories or packages ("handlers must not import internal/db",
+         "pkg/ must not depend on internal/", "domain may only import domain"), forbidden dependencies
+         between modules, import cycles between packages/modules
+  - CANNOT: Rules about code inside a file (calls, naming, style), runtime dependencies, dependency versions`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use the boundary engine
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For layering/architecture rules about which directories or packages may import which → use sym-boundary",
+		"For 'no circular dependencies/import cycles' rules → use sym-boundary",
+		"Prefer sym-boundary over llm-validator for dependency direction rules; it sees the whole import graph, not a diff",
+	}
+}
+
+// boundaryRuleData holds one generated dependency rule
+type boundaryRuleData struct {
+	Rule Rule
+}
+
+// ConvertSingleRule converts ONE user rule to a dependency rule.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule is not about import dependencies (skip),
+//	(nil, error) on actual conversion error.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var spec Rule
+	if err := json.Unmarshal([]byte(response), &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+	if spec.Kind == "" {
+		return nil, nil
+	}
+
+	return specResult(rule, spec)
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[boundaryRuleData](data)
+}
+
+// ConvertNative uses a boundary rule spec as is. The id, severity,
+// languages and message come from the user rule like for generated specs.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var spec Rule
+	if err := json.Unmarshal(native, &spec); err != nil {
+		return nil, fmt.Errorf("native config must be a boundary rule: %w", err)
+	}
+	return specResult(rule, spec)
+}
+
+// specResult sets the fields of a spec that come from the policy and validates it.
+func specResult(rule schema.UserRule, spec Rule) (*linter.SingleRuleResult, error) {
+	spec.ID = rule.ID
+	spec.Severity = rule.Severity
+	spec.Languages = rule.Languages
+	if rule.Message != "" {
+		spec.Message = rule.Message
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          boundaryRuleData{Rule: spec},
+		NativeRuleIDs: []string{rule.ID},
+	}, nil
+}
+
+// BuildConfig assembles sym-boundary.json from successful conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	cfg := Config{Rules: []Rule{}}
+	for _, r := range results {
+		data, ok := r.Data.(boundaryRuleData)
+		if !ok {
+			continue
+		}
+		cfg.Rules = append(cfg.Rules, data.Rule)
+	}
+	if len(cfg.Rules) == 0 {
+		return nil, nil
+	}
+
+	content, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: "sym-boundary.json",
+		Content:  content,
+		Format:   "json",
+	}, nil
+}
+
+// buildPrompt builds the dependency rule generation prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	var sb strings.Builder
+
+	sb.WriteString(`You are a software architecture expert. Convert the natural language coding rule to ONE import dependency rule.
+
+Available kinds:
+- "deny": files matching "from" must not import modules matching "to"
+- "allow": files matching "from" may import ONLY project modules matching "to" (external libraries are not restricted)
+- "no-cycle": modules matching "from" (all if empty) must not form an import cycle
+
+"from" and "to" are globs over project-relative paths ("**" matches any depth, "internal/db/**" includes internal/db itself).
+Modules are: Go package directories ("internal/db"), JS/TS file paths without extension ("src/db/index"),
+Python module paths ("app/db" or "app.db"), Java packages ("com.acme.db"). External imports use the name as written ("lodash", "net/http").
+
+Return ONLY a JSON object (no markdown fences), for example:
+{"kind": "deny", "from": ["internal/handlers/**"], "to": ["internal/db/**"], "message": "Handlers must go through the service layer"}
+{"kind": "deny", "from": ["pkg/**"], "to": ["internal/**"], "message": "pkg must not depend on internal"}
+{"kind": "no-cycle", "from": ["src/**"]}
+
+If the rule is not about which code may import which, return:
+{"kind": ""}`)
+
+	sb.WriteString(fmt.Sprintf("\n\nConvert this rule:\n\n%s", rule.Say))
+	sb.WriteString(linter.ParamsPrompt(rule.Params))
+	if len(rule.Languages) > 0 {
+		sb.WriteString(fmt.Sprintf("\nLanguages: %s", strings.Join(rule.Languages, ", ")))
+	}
+	if rule.Example != "" {
+		sb.WriteString(fmt.Sprintf("\nExample:\n%s", rule.Example))
+	}
+	return sb.String()
+}
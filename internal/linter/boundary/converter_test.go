@@ -0,0 +1,94 @@
+package boundary
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider is a mock LLM provider for testing
+type mockProvider struct {
+	response string
+}
+
+func (m *mockProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string {
+	return "mock"
+}
+
+func (m *mockProvider) Close() error {
+	return nil
+}
+
+func TestConverter_ConvertSingleRule(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{
+		ID:        "ARCH-1",
+		Say:       "Handlers must not import internal/db directly",
+		Languages: []string{"go"},
+		Severity:  "error",
+	}
+	provider := &mockProvider{response: `{"id": "x", "kind": "deny", "from": ["internal/handlers/**"], "to": ["internal/db/**"], "message": "Go through the service layer"}`}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, provider)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, []string{"ARCH-1"}, result.NativeRuleIDs)
+
+	data := result.Data.(boundaryRuleData)
+	assert.Equal(t, Rule{
+		ID:        "ARCH-1",
+		Kind:      KindDeny,
+		From:      []string{"internal/handlers/**"},
+		To:        []string{"internal/db/**"},
+		Languages: []string{"go"},
+		Message:   "Go through the service layer",
+		Severity:  "error",
+	}, data.Rule)
+
+	config, err := c.BuildConfig([]*linter.SingleRuleResult{result})
+	require.NoError(t, err)
+	assert.Equal(t, "sym-boundary.json", config.Filename)
+	parsed, err := ParseConfig(config.Content)
+	require.NoError(t, err)
+	assert.Equal(t, data.Rule, parsed.Rules[0])
+}
+
+func TestConverter_ConvertSingleRule_SkipAndInvalid(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "R1", Say: "Use camelCase for variables"}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `{"kind": ""}`})
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `{"kind": "allow", "from": ["a/**"]}`})
+	assert.ErrorContains(t, err, "to is required")
+}
+
+func TestConverter_ConvertNative(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "ARCH-1", Say: "Handlers must not import internal/db", Languages: []string{"go"}}
+
+	result, err := c.ConvertNative(context.Background(), rule, json.RawMessage(`{"kind": "deny", "from": ["internal/handlers/**"], "to": ["internal/db/**"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Rule{
+		ID:        "ARCH-1",
+		Kind:      KindDeny,
+		From:      []string{"internal/handlers/**"},
+		To:        []string{"internal/db/**"},
+		Languages: []string{"go"},
+	}, result.Data.(boundaryRuleData).Rule)
+
+	_, err = c.ConvertNative(context.Background(), rule, json.RawMessage(`{"kind": "deny"}`))
+	assert.Error(t, err)
+}
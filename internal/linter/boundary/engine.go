@@ -0,0 +1,222 @@
+package boundary
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Check evaluates the rules against the files, resolved relative to root.
+// Deny and allow rules look only at the given files; no-cycle rules build the
+// graph of the whole project and report cycles through the given files.
+func Check(cfg *Config, root string, files []string) []linter.Violation {
+	p := newProject(root)
+
+	var violations []linter.Violation
+	for i := range cfg.Rules {
+		rule := &cfg.Rules[i]
+		if rule.Kind == KindNoCycle {
+			violations = append(violations, checkCycles(p, rule, files)...)
+			continue
+		}
+
+		for _, file := range files {
+			f := p.load(file)
+			if f == nil || !rule.appliesTo(f) {
+				continue
+			}
+			for _, e := range f.Imports {
+				if msg, ok := rule.violates(f, e); ok {
+					violations = append(violations, rule.violation(file, e, msg))
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// violates checks one import against a deny or allow rule.
+func (r *Rule) violates(f *fileInfo, e edge) (string, bool) {
+	switch r.Kind {
+	case KindDeny:
+		if r.matchesTarget(e.Target) {
+			return fmt.Sprintf("%s must not import %s", f.Module, e.Path), true
+		}
+	case KindAllow:
+		// Only project imports are restricted; a module may always import itself
+		if e.Target.Internal && e.Target.Module != f.Module && !r.matchesTarget(e.Target) {
+			return fmt.Sprintf("%s may only import %s, not %s", f.Module, strings.Join(r.To, ", "), e.Path), true
+		}
+	}
+	return "", false
+}
+
+// checkCycles reports imports in files that close a cycle between modules.
+func checkCycles(p *project, rule *Rule, files []string) []linter.Violation {
+	graph := make(map[string]map[string]bool)
+	for _, f := range p.scan() {
+		if !rule.appliesTo(f) || strings.HasSuffix(f.Path, "_test.go") {
+			continue
+		}
+		if graph[f.Module] == nil {
+			graph[f.Module] = make(map[string]bool)
+		}
+		for _, e := range f.Imports {
+			if e.Target.Internal && e.Target.Module != f.Module {
+				graph[f.Module][e.Target.Module] = true
+			}
+		}
+	}
+
+	// Drop edges to modules outside the scanned graph
+	for _, deps := range graph {
+		for dep := range deps {
+			if _, ok := graph[dep]; !ok {
+				delete(deps, dep)
+			}
+		}
+	}
+
+	component := stronglyConnected(graph)
+
+	var violations []linter.Violation
+	for _, file := range files {
+		f := p.load(file)
+		if f == nil || !rule.appliesTo(f) || strings.HasSuffix(f.Path, "_test.go") {
+			continue
+		}
+		c, ok := component[f.Module]
+		if !ok {
+			continue
+		}
+		for _, e := range f.Imports {
+			if tc, inCycle := component[e.Target.Module]; !inCycle || tc != c || e.Target.Module == f.Module {
+				continue
+			}
+			cycle := append([]string{f.Module}, shortestPath(graph, e.Target.Module, f.Module)...)
+			msg := fmt.Sprintf("Import cycle: %s", strings.Join(cycle, " → "))
+			violations = append(violations, rule.violation(file, e, msg))
+		}
+	}
+	return violations
+}
+
+// stronglyConnected returns, for each module in a cycle, the index of its
+// strongly connected component (Tarjan's algorithm). Modules not in any
+// cycle are omitted.
+func stronglyConnected(graph map[string]map[string]bool) map[string]int {
+	nodes := make([]string, 0, len(graph))
+	for n := range graph {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+
+	index := make(map[string]int)
+	low := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	next := 0
+	components := 0
+	result := make(map[string]int)
+
+	var visit func(n string)
+	visit = func(n string) {
+		index[n], low[n] = next, next
+		next++
+		stack = append(stack, n)
+		onStack[n] = true
+
+		for _, m := range sortedKeys(graph[n]) {
+			if _, seen := index[m]; !seen {
+				visit(m)
+				low[n] = min(low[n], low[m])
+			} else if onStack[m] {
+				low[n] = min(low[n], index[m])
+			}
+		}
+
+		if low[n] != index[n] {
+			return
+		}
+		var members []string
+		for {
+			m := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[m] = false
+			members = append(members, m)
+			if m == n {
+				break
+			}
+		}
+		if len(members) > 1 {
+			for _, m := range members {
+				result[m] = components
+			}
+			components++
+		}
+	}
+
+	for _, n := range nodes {
+		if _, seen := index[n]; !seen {
+			visit(n)
+		}
+	}
+	return result
+}
+
+// shortestPath returns the modules on the shortest path from -> to, inclusive.
+func shortestPath(graph map[string]map[string]bool, from, to string) []string {
+	prev := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if n == to {
+			break
+		}
+		for _, m := range sortedKeys(graph[n]) {
+			if _, seen := prev[m]; !seen {
+				prev[m] = n
+				queue = append(queue, m)
+			}
+		}
+	}
+	if _, ok := prev[to]; !ok {
+		return []string{from, to}
+	}
+
+	var path []string
+	for n := to; n != ""; n = prev[n] {
+		path = append([]string{n}, path...)
+	}
+	return path
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (r *Rule) violation(file string, e edge, msg string) linter.Violation {
+	if r.Message != "" {
+		msg = fmt.Sprintf("%s (%s)", r.Message, msg)
+	}
+	severity := "error"
+	if r.Severity != "" {
+		severity = linter.MapSeverity(r.Severity)
+	}
+	return linter.Violation{
+		File:     file,
+		Line:     e.Line,
+		Column:   e.Column,
+		Message:  msg,
+		Severity: severity,
+		RuleID:   r.ID,
+	}
+}
@@ -0,0 +1,190 @@
+package boundary
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeProject creates files under a temp dir and returns the root.
+func writeProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return root
+}
+
+// summary is a violation reduced to the fields tests compare.
+type summary struct {
+	File string
+	Line int
+	Rule string
+}
+
+func summarize(violations []linter.Violation) []summary {
+	var out []summary
+	for _, v := range violations {
+		out = append(out, summary{File: v.File, Line: v.Line, Rule: v.RuleID})
+	}
+	return out
+}
+
+var goProject = map[string]string{
+	"go.mod":                      "module example.com/app\n\ngo 1.22\n",
+	"internal/db/db.go":           "package db\n",
+	"internal/service/service.go": "package service\n\nimport \"example.com/app/internal/db\"\n",
+	"internal/handlers/user.go":   "package handlers\n\nimport (\n\t\"net/http\"\n\n\t\"example.com/app/internal/db\"\n\t\"example.com/app/internal/service\"\n)\n",
+	"pkg/client/client.go":        "package client\n\nimport \"example.com/app/internal/service\"\n",
+}
+
+func TestCheck_GoDeny(t *testing.T) {
+	root := writeProject(t, goProject)
+	cfg := &Config{Rules: []Rule{
+		{ID: "ARCH-1", Kind: KindDeny, From: []string{"internal/handlers/**"}, To: []string{"internal/db/**"}},
+		{ID: "ARCH-2", Kind: KindDeny, From: []string{"pkg/**"}, To: []string{"internal/**"}},
+	}}
+
+	violations := Check(cfg, root, []string{"internal/handlers/user.go", "pkg/client/client.go", "internal/service/service.go"})
+	assert.Equal(t, []summary{
+		{File: "internal/handlers/user.go", Line: 6, Rule: "ARCH-1"},
+		{File: "pkg/client/client.go", Line: 3, Rule: "ARCH-2"},
+	}, summarize(violations))
+	assert.Contains(t, violations[0].Message, "internal/handlers must not import example.com/app/internal/db")
+	assert.Equal(t, 2, violations[0].Column)
+}
+
+func TestCheck_GoAllow(t *testing.T) {
+	root := writeProject(t, goProject)
+	cfg := &Config{Rules: []Rule{
+		{ID: "ARCH-3", Kind: KindAllow, From: []string{"internal/handlers"}, To: []string{"internal/service"}, Message: "Handlers use services only"},
+	}}
+
+	// net/http is external and the service import is allowed
+	violations := Check(cfg, root, []string{"internal/handlers/user.go"})
+	require.Len(t, violations, 1)
+	assert.Equal(t, 6, violations[0].Line)
+	assert.Contains(t, violations[0].Message, "Handlers use services only")
+}
+
+func TestCheck_GoCycle(t *testing.T) {
+	files := map[string]string{
+		"go.mod":        "module example.com/app\n",
+		"a/a.go":        "package a\n\nimport \"example.com/app/b\"\n",
+		"b/b.go":        "package b\n\nimport \"example.com/app/c\"\n",
+		"c/c.go":        "package c\n\nimport \"example.com/app/a\"\n",
+		"c/c_test.go":   "package c_test\n\nimport \"example.com/app/c\"\n",
+		"d/d.go":        "package d\n\nimport \"example.com/app/a\"\n",
+		"vendor/x/x.go": "package x\n\nimport \"example.com/app/x\"\n",
+	}
+	root := writeProject(t, files)
+	cfg := &Config{Rules: []Rule{{ID: "NO-CYCLE", Kind: KindNoCycle}}}
+
+	violations := Check(cfg, root, []string{"a/a.go", "d/d.go", "c/c_test.go"})
+	require.Len(t, violations, 1)
+	assert.Equal(t, "a/a.go", violations[0].File)
+	assert.Equal(t, 3, violations[0].Line)
+	assert.Equal(t, "Import cycle: a → b → c → a", violations[0].Message)
+
+	// Scoping the rule to a and b breaks the cycle
+	cfg.Rules[0].From = []string{"a", "b"}
+	assert.Empty(t, Check(cfg, root, []string{"a/a.go"}))
+}
+
+func TestCheck_TypeScript(t *testing.T) {
+	root := writeProject(t, map[string]string{
+		"src/ui/button.tsx":  "import { query } from '../db';\nimport React from 'react';\nimport { fmt } from '../util/fmt';\n",
+		"src/db/index.ts":    "import { render } from '../ui/button';\n",
+		"src/util/fmt.ts":    "export const fmt = 1;\n",
+		"node_modules/x.js":  "require('../src/ui/button');\n",
+		"src/legacy/old.js":  "const db = require('../db');\n",
+		"src/legacy/skip.md": "import '../db'\n",
+	})
+	cfg := &Config{Rules: []Rule{
+		{ID: "UI-DB", Kind: KindDeny, From: []string{"src/ui/**", "src/legacy/**"}, To: []string{"src/db/**"}},
+		{ID: "NO-REACT", Kind: KindDeny, From: []string{"src/db/**"}, To: []string{"react"}},
+		{ID: "CYCLE", Kind: KindNoCycle, From: []string{"src/**"}},
+	}}
+
+	violations := Check(cfg, root, []string{"src/ui/button.tsx", "src/legacy/old.js", "src/legacy/skip.md"})
+	assert.Equal(t, []summary{
+		{File: "src/ui/button.tsx", Line: 1, Rule: "UI-DB"},
+		{File: "src/legacy/old.js", Line: 1, Rule: "UI-DB"},
+		{File: "src/ui/button.tsx", Line: 1, Rule: "CYCLE"},
+	}, summarize(violations))
+	assert.Equal(t, "Import cycle: src/ui/button → src/db/index → src/ui/button", violations[2].Message)
+}
+
+func TestCheck_Python(t *testing.T) {
+	root := writeProject(t, map[string]string{
+		"app/__init__.py":        "",
+		"app/api/__init__.py":    "",
+		"app/api/views.py":       "from app.db import session\nfrom ..db import models\nimport requests\n",
+		"app/db/__init__.py":     "",
+		"app/db/models.py":       "",
+		"app/domain/order.py":    "from app.api import views\n",
+		"app/domain/__init__.py": "",
+	})
+	cfg := &Config{Rules: []Rule{
+		{ID: "API-DB", Kind: KindDeny, From: []string{"app/api/**"}, To: []string{"app.db.**"}},
+		{ID: "DOMAIN", Kind: KindAllow, From: []string{"app/domain/**"}, To: []string{"app/domain/**"}},
+	}}
+
+	violations := Check(cfg, root, []string{"app/api/views.py", "app/domain/order.py"})
+	assert.Equal(t, []summary{
+		{File: "app/api/views.py", Line: 1, Rule: "API-DB"},
+		{File: "app/api/views.py", Line: 2, Rule: "API-DB"},
+		{File: "app/domain/order.py", Line: 1, Rule: "DOMAIN"},
+	}, summarize(violations))
+}
+
+func TestCheck_Java(t *testing.T) {
+	root := writeProject(t, map[string]string{
+		"src/main/java/com/acme/web/UserController.java": "package com.acme.web;\n\nimport com.acme.db.UserRepository;\nimport com.acme.service.*;\nimport java.util.List;\n",
+	})
+	cfg := &Config{Rules: []Rule{
+		{ID: "WEB", Kind: KindAllow, From: []string{"com/acme/web/**"}, To: []string{"com.acme.service.**", "com.acme.web.**"}},
+	}}
+
+	violations := Check(cfg, root, []string{"src/main/java/com/acme/web/UserController.java"})
+	require.Len(t, violations, 1)
+	assert.Equal(t, 3, violations[0].Line)
+	assert.Contains(t, violations[0].Message, "com.acme.db.UserRepository")
+}
+
+func TestParseConfig_Validation(t *testing.T) {
+	_, err := ParseConfig([]byte(`{"rules":[{"id":"R1","kind":"deny","from":["a/**"]}]}`))
+	assert.ErrorContains(t, err, "to is required")
+
+	_, err = ParseConfig([]byte(`{"rules":[{"id":"R1","kind":"layers"}]}`))
+	assert.ErrorContains(t, err, `unknown kind "layers"`)
+
+	cfg, err := ParseConfig([]byte(`{"rules":[{"id":"R1","kind":"no-cycle"}]}`))
+	require.NoError(t, err)
+	assert.Len(t, cfg.Rules, 1)
+}
+
+func TestLinter_ExecuteAndParse(t *testing.T) {
+	root := writeProject(t, goProject)
+	t.Chdir(root)
+
+	l := New()
+	config := []byte(`{"rules":[{"id":"ARCH-2","kind":"deny","from":["pkg/**"],"to":["internal/**"],"severity":"warning"}]}`)
+	output, err := l.Execute(context.Background(), config, []string{"pkg/client/client.go"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.ExitCode)
+
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "warning", violations[0].Severity)
+	assert.Equal(t, "pkg/client/client.go", violations[0].File)
+}
@@ -0,0 +1,293 @@
+package boundary
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/DevSymphony/sym-cli/internal/util/source"
+)
+
+// skipDirs are directories never scanned when building the project graph.
+var skipDirs = map[string]bool{
+	"node_modules": true, "vendor": true, "dist": true, "build": true, "target": true,
+	"__pycache__": true, "venv": true, "testdata": true,
+}
+
+// jsExtensions are tried, in order, when resolving a relative JS/TS import.
+var jsExtensions = []string{".ts", ".tsx", ".js", ".jsx", ".mjs", ".cjs", ".mts", ".cts"}
+
+var javaPackagePattern = regexp.MustCompile(`^\s*package\s+([\w.]+)\s*;`)
+
+// target is an import resolved to a module.
+type target struct {
+	// Module is a project-relative path for project imports ("internal/db",
+	// "src/db/index"), or the import as written for external ones ("lodash").
+	// Java and Python modules use slashes ("com/acme/db", "app/db").
+	Module string
+	// Internal is true when the import refers to code in this project.
+	Internal bool
+	// Dotted is true for Java and Python, whose imports are written with dots.
+	Dotted bool
+}
+
+// edge is one import statement of a file.
+type edge struct {
+	source.Import
+	Target target
+}
+
+// fileInfo is a parsed source file.
+type fileInfo struct {
+	Path    string // project-relative, slash-separated
+	Lang    string
+	Module  string // module the file belongs to (package dir, file stem, Java package)
+	Imports []edge
+}
+
+// project resolves files and imports relative to a root directory.
+type project struct {
+	root   string
+	files  map[string]*fileInfo // by project-relative path
+	goMods map[string]goModule  // by directory
+}
+
+// goModule is the nearest go.mod of a directory.
+type goModule struct {
+	dir  string // project-relative directory of go.mod ("" for none)
+	path string // module path
+}
+
+func newProject(root string) *project {
+	return &project{
+		root:   root,
+		files:  make(map[string]*fileInfo),
+		goMods: make(map[string]goModule),
+	}
+}
+
+// rel returns the project-relative, slash-separated path of a file.
+func (p *project) rel(file string) string {
+	abs := file
+	if !filepath.IsAbs(abs) {
+		abs = filepath.Join(p.root, file)
+	}
+	if r, err := filepath.Rel(p.root, abs); err == nil && !strings.HasPrefix(r, "..") {
+		return filepath.ToSlash(r)
+	}
+	return filepath.ToSlash(file)
+}
+
+// load parses a file. Returns nil for unsupported or unreadable files.
+func (p *project) load(file string) *fileInfo {
+	relPath := p.rel(file)
+	if f, ok := p.files[relPath]; ok {
+		return f
+	}
+
+	lang := source.Language(relPath)
+	if !containsFold(supportedLanguages, lang) {
+		p.files[relPath] = nil
+		return nil
+	}
+	content, err := os.ReadFile(filepath.Join(p.root, filepath.FromSlash(relPath)))
+	if err != nil {
+		p.files[relPath] = nil
+		return nil
+	}
+
+	f := &fileInfo{Path: relPath, Lang: lang, Module: p.fileModule(relPath, lang, content)}
+	for _, imp := range source.Imports(relPath, content) {
+		f.Imports = append(f.Imports, edge{Import: imp, Target: p.resolve(f, imp.Path)})
+	}
+	p.files[relPath] = f
+	return f
+}
+
+// fileModule returns the module a file belongs to.
+func (p *project) fileModule(relPath, lang string, content []byte) string {
+	switch lang {
+	case "go":
+		return path.Dir(relPath)
+	case "java":
+		if pkg := javaPackage(content); pkg != "" {
+			return strings.ReplaceAll(pkg, ".", "/")
+		}
+		return path.Dir(relPath)
+	case "python":
+		return strings.TrimSuffix(strings.TrimSuffix(relPath, ".py"), "/__init__")
+	default:
+		return strings.TrimSuffix(relPath, path.Ext(relPath))
+	}
+}
+
+// resolve maps an import of f to a module.
+func (p *project) resolve(f *fileInfo, imp string) target {
+	switch f.Lang {
+	case "go":
+		return p.resolveGo(f, imp)
+	case "python":
+		return p.resolvePython(f, imp)
+	case "java":
+		return resolveJava(f, imp)
+	default:
+		return p.resolveJS(f, imp)
+	}
+}
+
+func (p *project) resolveGo(f *fileInfo, imp string) target {
+	mod := p.goModule(path.Dir(f.Path))
+	if mod.path == "" {
+		return target{Module: imp}
+	}
+	if imp == mod.path {
+		return target{Module: cleanRel(mod.dir), Internal: true}
+	}
+	if rest, ok := strings.CutPrefix(imp, mod.path+"/"); ok {
+		return target{Module: cleanRel(path.Join(mod.dir, rest)), Internal: true}
+	}
+	return target{Module: imp}
+}
+
+// goModule finds the nearest go.mod at or above dir.
+func (p *project) goModule(dir string) goModule {
+	if mod, ok := p.goMods[dir]; ok {
+		return mod
+	}
+
+	var mod goModule
+	if data, err := os.ReadFile(filepath.Join(p.root, filepath.FromSlash(dir), "go.mod")); err == nil {
+		mod = goModule{dir: dir, path: goModulePath(data)}
+	} else if dir != "." && dir != "" {
+		mod = p.goModule(path.Dir(dir))
+	}
+	p.goMods[dir] = mod
+	return mod
+}
+
+func (p *project) resolveJS(f *fileInfo, imp string) target {
+	if !strings.HasPrefix(imp, "./") && !strings.HasPrefix(imp, "../") && imp != "." && imp != ".." {
+		return target{Module: imp}
+	}
+
+	base := path.Join(path.Dir(f.Path), imp)
+	return target{Module: cleanRel(p.resolveJSFile(base)), Internal: true}
+}
+
+// resolveJSFile returns the stem of the file an import refers to: the path
+// itself, path + extension, or path/index + extension.
+func (p *project) resolveJSFile(base string) string {
+	if ext := path.Ext(base); ext != "" && p.exists(base) {
+		return strings.TrimSuffix(base, ext)
+	}
+	for _, stem := range []string{base, base + "/index"} {
+		for _, ext := range jsExtensions {
+			if p.exists(stem + ext) {
+				return stem
+			}
+		}
+	}
+	return base
+}
+
+func (p *project) resolvePython(f *fileInfo, imp string) target {
+	if strings.HasPrefix(imp, ".") {
+		level := len(imp) - len(strings.TrimLeft(imp, "."))
+		dir := path.Dir(f.Path)
+		for i := 1; i < level; i++ {
+			dir = path.Dir(dir)
+		}
+		rest := strings.ReplaceAll(strings.TrimLeft(imp, "."), ".", "/")
+		return target{Module: cleanRel(path.Join(dir, rest)), Internal: true, Dotted: true}
+	}
+
+	mod := strings.ReplaceAll(imp, ".", "/")
+	for _, candidate := range []string{mod, "src/" + mod} {
+		if p.exists(candidate+".py") || p.exists(candidate+"/__init__.py") || p.isDir(candidate) {
+			return target{Module: candidate, Internal: true, Dotted: true}
+		}
+	}
+	return target{Module: mod, Dotted: true}
+}
+
+// resolveJava maps a class import to its package. Imports sharing the first
+// two package segments with the importing file are treated as project imports.
+func resolveJava(f *fileInfo, imp string) target {
+	var pkg []string
+	for _, seg := range strings.Split(strings.TrimSuffix(imp, ".*"), ".") {
+		if seg == "" || unicode.IsUpper([]rune(seg)[0]) {
+			break
+		}
+		pkg = append(pkg, seg)
+	}
+	module := strings.Join(pkg, "/")
+
+	own := strings.Split(f.Module, "/")
+	internal := len(pkg) >= 2 && len(own) >= 2 && pkg[0] == own[0] && pkg[1] == own[1]
+	return target{Module: module, Internal: internal, Dotted: true}
+}
+
+func (p *project) exists(relPath string) bool {
+	info, err := os.Stat(filepath.Join(p.root, filepath.FromSlash(relPath)))
+	return err == nil && !info.IsDir()
+}
+
+func (p *project) isDir(relPath string) bool {
+	info, err := os.Stat(filepath.Join(p.root, filepath.FromSlash(relPath)))
+	return err == nil && info.IsDir()
+}
+
+// scan loads every supported file under the project root.
+func (p *project) scan() []*fileInfo {
+	var files []*fileInfo
+	_ = filepath.WalkDir(p.root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if file != p.root && (skipDirs[name] || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if f := p.load(file); f != nil {
+			files = append(files, f)
+		}
+		return nil
+	})
+	return files
+}
+
+func javaPackage(content []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if m := javaPackagePattern.FindStringSubmatch(scanner.Text()); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+func goModulePath(data []byte) string {
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module"); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`)
+		}
+	}
+	return ""
+}
+
+func cleanRel(p string) string {
+	p = path.Clean(p)
+	if p == "" {
+		return "."
+	}
+	return p
+}
@@ -0,0 +1,13 @@
+package boundary
+
+import (
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func init() {
+	_ = linter.Global().RegisterTool(
+		New(),
+		NewConverter(),
+		"sym-boundary.json",
+	)
+}
@@ -0,0 +1,116 @@
+package boundary
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/util/source"
+)
+
+// Rule kinds supported by the boundary engine.
+const (
+	KindDeny    = "deny"     // Files in From must not import modules in To
+	KindAllow   = "allow"    // Files in From may import only project modules in To
+	KindNoCycle = "no-cycle" // Project modules in From must not form an import cycle
+)
+
+// supportedLanguages lists the languages whose imports are resolved.
+var supportedLanguages = []string{"go", "javascript", "typescript", "jsx", "tsx", "python", "java"}
+
+// Config is the sym-boundary.json config file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is one dependency rule.
+//
+// From matches importing files by project-relative path or module; empty
+// matches every file. To matches imported modules (see README for how imports
+// are resolved to modules). For no-cycle, From limits the graph to those files.
+type Rule struct {
+	ID        string   `json:"id"`
+	Kind      string   `json:"kind"`
+	From      []string `json:"from,omitempty"`
+	To        []string `json:"to,omitempty"`
+	Languages []string `json:"languages,omitempty"`
+	Message   string   `json:"message,omitempty"`
+	Severity  string   `json:"severity,omitempty"`
+}
+
+// ParseConfig parses and validates a sym-boundary.json config.
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse boundary config: %w", err)
+	}
+	for i := range cfg.Rules {
+		if err := cfg.Rules[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &cfg, nil
+}
+
+// Validate checks that the rule has the fields its kind requires.
+func (r *Rule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("boundary rule: id is required")
+	}
+
+	switch r.Kind {
+	case KindDeny, KindAllow:
+		if len(r.To) == 0 {
+			return fmt.Errorf("boundary rule %s: to is required for %s", r.ID, r.Kind)
+		}
+	case KindNoCycle:
+	default:
+		return fmt.Errorf("boundary rule %s: unknown kind %q (expected one of: %s, %s, %s)", r.ID, r.Kind, KindDeny, KindAllow, KindNoCycle)
+	}
+
+	for _, glob := range append(append([]string{}, r.From...), r.To...) {
+		if _, err := source.GlobRegexp(glob); err != nil {
+			return fmt.Errorf("boundary rule %s: invalid glob %q: %w", r.ID, glob, err)
+		}
+	}
+	return nil
+}
+
+// appliesTo reports whether the rule selects the importing file.
+func (r *Rule) appliesTo(f *fileInfo) bool {
+	if len(r.Languages) > 0 && !containsFold(r.Languages, f.Lang) {
+		return false
+	}
+	if len(r.From) == 0 {
+		return true
+	}
+	for _, glob := range r.From {
+		if source.MatchGlob(glob, f.Path) || source.MatchGlob(glob, f.Module) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTarget reports whether an imported module matches To.
+// Java and Python modules also match dotted globs ("com.acme.db.**").
+func (r *Rule) matchesTarget(t target) bool {
+	for _, glob := range r.To {
+		if source.MatchGlob(glob, t.Module) {
+			return true
+		}
+		if t.Dotted && source.MatchGlob(strings.ReplaceAll(glob, ".", "/"), t.Module) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
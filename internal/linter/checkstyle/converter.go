@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -93,6 +95,49 @@ func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule,
 	}, nil
 }
 
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[*checkstyleModule](data)
+}
+
+// ConvertNative adds a Checkstyle module with its properties:
+// {"LineLength": {"max": 120}}. Properties are used as given, unlike LLM
+// output, and severity defaults to the rule's severity.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	name, properties, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+
+	module := &checkstyleModule{
+		Name:       name,
+		Properties: []checkstyleProperty{},
+	}
+	if _, ok := properties["severity"]; !ok {
+		module.Properties = append(module.Properties, checkstyleProperty{
+			Name:  "severity",
+			Value: mapCheckstyleSeverity(rule.Severity),
+		})
+	}
+
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		module.Properties = append(module.Properties, checkstyleProperty{
+			Name:  key,
+			Value: fmt.Sprint(properties[key]),
+		})
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data:   module,
+	}, nil
+}
+
 // BuildConfig assembles Checkstyle XML configuration from successful rule conversions.
 func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
 	if len(results) == 0 {
@@ -245,6 +290,7 @@ Output:
 }`
 
 	userPrompt := fmt.Sprintf("Convert this Java rule to Checkstyle module:\n\n%s", rule.Say)
+	userPrompt += linter.ParamsPrompt(rule.Params)
 
 	// Call LLM
 	prompt := systemPrompt + "\n\n" + userPrompt
@@ -277,6 +323,11 @@ Output:
 	// Filter properties to only include valid ones for this module
 	filteredProps := filterValidProperties(result.ModuleName, result.Properties)
 
+	// The max param replaces the bound the LLM chose
+	if n, ok := linter.IntParam(rule.Params, linter.MaxParam.Name); ok && validCheckstyleProperties[result.ModuleName]["max"] {
+		filteredProps["max"] = strconv.Itoa(n)
+	}
+
 	// Build module
 	module := &checkstyleModule{
 		Name:       result.ModuleName,
@@ -303,6 +354,11 @@ Output:
 	return module, nil
 }
 
+// RuleParams returns the max param, applied to modules with a "max" property.
+func (c *Converter) RuleParams() []linter.RuleParam {
+	return []linter.RuleParam{linter.MaxParam}
+}
+
 // mapCheckstyleSeverity maps severity to Checkstyle severity
 func mapCheckstyleSeverity(severity string) string {
 	switch strings.ToLower(severity) {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -29,7 +28,7 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 	defer func() { _ = os.Remove(configFile) }()
 
 	// Build command
-	jarPath := l.getJARPath()
+	jarPath := l.getJARPath(ctx)
 
 	args := []string{
 		"-jar", jarPath,
@@ -72,12 +71,17 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 
 // createTempConfig creates a temporary config file.
 func (l *Linter) createTempConfig(config []byte) (string, error) {
-	// Create temp file in tools directory
-	tempFile := filepath.Join(l.ToolsDir, "checkstyle-config-temp.xml")
+	// Create a unique temp file in tools directory so concurrent runs do not share it
+	tempFile, err := os.CreateTemp(l.ToolsDir, "checkstyle-config-*.xml")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tempFile.Close() }()
 
-	if err := os.WriteFile(tempFile, config, 0644); err != nil {
+	if _, err := tempFile.Write(config); err != nil {
+		_ = os.Remove(tempFile.Name())
 		return "", err
 	}
 
-	return tempFile, nil
+	return tempFile.Name(), nil
 }
@@ -3,8 +3,6 @@ package checkstyle
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -12,8 +10,13 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter           = (*Linter)(nil)
+	_ linter.ArtifactProvider = (*Linter)(nil)
+	_ linter.Locator          = (*Linter)(nil)
+	_ linter.Uninstaller      = (*Linter)(nil)
+)
 
 const (
 	// DefaultVersion is the default Checkstyle version.
@@ -91,7 +94,7 @@ func (l *Linter) CheckAvailability(ctx context.Context) error {
 	}
 
 	// Check Checkstyle JAR
-	jarPath := l.getJARPath()
+	jarPath := l.getJARPath(ctx)
 	if _, err := os.Stat(jarPath); os.IsNotExist(err) {
 		return fmt.Errorf("checkstyle JAR not found at %s: run Install first", jarPath)
 	}
@@ -107,17 +110,11 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Determine version
-	version := config.Version
-	if version == "" {
-		version = DefaultVersion
-	}
-
-	// Download URL - use GitHub Releases for -all.jar
-	jarName := fmt.Sprintf("checkstyle-%s-all.jar", version)
-	url := fmt.Sprintf("%s/checkstyle-%s/%s", GitHubReleaseURL, version, jarName)
+	version := config.ToolVersion(l.Name(), DefaultVersion)
+	art, _ := l.Artifact(version, "", "") // platform independent
 
 	// Destination path
-	jarPath := filepath.Join(l.ToolsDir, jarName)
+	jarPath := filepath.Join(l.ToolsDir, art.Name)
 
 	// Check if already exists and not forcing reinstall
 	if !config.Force {
@@ -126,14 +123,32 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 		}
 	}
 
-	// Download
-	if err := l.downloadFile(ctx, url, jarPath); err != nil {
+	// Download (or copy from the tools source) and verify against tools.lock
+	if err := linter.FetchArtifact(ctx, config, art, jarPath); err != nil {
 		return fmt.Errorf("failed to download checkstyle: %w", err)
 	}
 
 	return nil
 }
 
+// Locate reports the managed Checkstyle JAR.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	jarPath := l.getJARPath(ctx)
+	if _, err := os.Stat(jarPath); err != nil {
+		return linter.Installation{}, linter.ErrToolNotFound
+	}
+	return linter.Installation{
+		Path:    jarPath,
+		Version: linter.LockedVersion(ctx, l.Name(), DefaultVersion),
+		Source:  linter.ToolSourceManaged,
+	}, nil
+}
+
+// Uninstall removes all Checkstyle JARs from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, "checkstyle-*-all.jar")
+}
+
 // Execute runs Checkstyle with the given config and files.
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
 	return l.execute(ctx, config, files)
@@ -144,47 +159,22 @@ func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, err
 	return parseOutput(output)
 }
 
-// getJARPath returns the path to Checkstyle JAR.
-func (l *Linter) getJARPath() string {
-	return filepath.Join(l.ToolsDir, fmt.Sprintf("checkstyle-%s-all.jar", DefaultVersion))
-}
-
-// downloadFile downloads a file from URL to destPath.
-func (l *Linter) downloadFile(ctx context.Context, url, destPath string) error {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("download failed: HTTP %d", resp.StatusCode)
-	}
-
-	// Create temp file
-	tempFile := destPath + ".tmp"
-	out, err := os.Create(tempFile)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = out.Close() }()
-
-	// Copy content
-	if _, err := io.Copy(out, resp.Body); err != nil {
-		_ = os.Remove(tempFile)
-		return err
-	}
-
-	// Rename temp to final
-	if err := os.Rename(tempFile, destPath); err != nil {
-		_ = os.Remove(tempFile)
-		return err
+// Artifact returns the Checkstyle -all.jar from GitHub Releases.
+// It is platform independent.
+func (l *Linter) Artifact(version, goos, goarch string) (linter.Artifact, error) {
+	if version == "" {
+		version = DefaultVersion
 	}
+	jarName := fmt.Sprintf("checkstyle-%s-all.jar", version)
+	return linter.Artifact{
+		Tool:    l.Name(),
+		Version: version,
+		Name:    jarName,
+		URL:     fmt.Sprintf("%s/checkstyle-%s/%s", GitHubReleaseURL, version, jarName),
+	}, nil
+}
 
-	return nil
+// getJARPath returns the path to Checkstyle JAR.
+func (l *Linter) getJARPath(ctx context.Context) string {
+	return filepath.Join(l.ToolsDir, fmt.Sprintf("checkstyle-%s-all.jar", linter.LockedVersion(ctx, l.Name(), DefaultVersion)))
 }
@@ -0,0 +1,62 @@
+package linter
+
+import (
+	"context"
+	"runtime"
+)
+
+// MaxArgBytes returns the argument bytes one tool invocation may use.
+//
+// Windows limits the whole command line to 32767 characters. Linux limits a
+// single argument to 128 KiB (tools such as PMD join files into one argument)
+// and macOS limits argv plus environment to 1 MiB. The values leave room for
+// the tool's own arguments.
+func MaxArgBytes() int {
+	if runtime.GOOS == "windows" {
+		return 24 * 1024
+	}
+	return 96 * 1024
+}
+
+// ChunkFiles splits files into chunks of at most maxFiles files whose
+// arguments take at most maxBytes bytes (each file counts its length plus a
+// separator). A limit of 0 or less is disabled. A single file larger than
+// maxBytes gets a chunk of its own. The order of files is preserved.
+func ChunkFiles(files []string, maxFiles, maxBytes int) [][]string {
+	if len(files) == 0 {
+		return nil
+	}
+
+	var chunks [][]string
+	var current []string
+	size := 0
+	for _, f := range files {
+		n := len(f) + 1
+		full := maxFiles > 0 && len(current) >= maxFiles
+		tooLarge := maxBytes > 0 && len(current) > 0 && size+n > maxBytes
+		if full || tooLarge {
+			chunks = append(chunks, current)
+			current, size = nil, 0
+		}
+		current = append(current, f)
+		size += n
+	}
+	return append(chunks, current)
+}
+
+// ===== File List Input =====
+
+type fileListInputKey struct{}
+
+// WithFileListInput returns a context asking linters with Capabilities.FileList
+// to read the target files from a file list (e.g., PMD --file-list) instead of
+// command arguments. Other linters ignore it.
+func WithFileListInput(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fileListInputKey{}, true)
+}
+
+// FileListInput reports whether ctx requests file list input.
+func FileListInput(ctx context.Context) bool {
+	fileList, _ := ctx.Value(fileListInputKey{}).(bool)
+	return fileList
+}
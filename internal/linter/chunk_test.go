@@ -0,0 +1,47 @@
+package linter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestChunkFiles(t *testing.T) {
+	files := []string{"a.go", "bb.go", "ccc.go", "d.go"}
+
+	tests := []struct {
+		name     string
+		maxFiles int
+		maxBytes int
+		want     [][]string
+	}{
+		{"no limits", 0, 0, [][]string{files}},
+		{"file count", 3, 0, [][]string{{"a.go", "bb.go", "ccc.go"}, {"d.go"}}},
+		// a.go + bb.go take 5+6 bytes; ccc.go would exceed 12
+		{"arg bytes", 0, 12, [][]string{{"a.go", "bb.go"}, {"ccc.go", "d.go"}}},
+		{"both", 1, 100, [][]string{{"a.go"}, {"bb.go"}, {"ccc.go"}, {"d.go"}}},
+		// A file longer than the limit still gets a chunk of its own
+		{"oversized file", 0, 4, [][]string{{"a.go"}, {"bb.go"}, {"ccc.go"}, {"d.go"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChunkFiles(files, tt.maxFiles, tt.maxBytes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkFiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := ChunkFiles(nil, 10, 10); got != nil {
+		t.Errorf("ChunkFiles(nil) = %v, want nil", got)
+	}
+}
+
+func TestFileListInput(t *testing.T) {
+	ctx := context.Background()
+	if FileListInput(ctx) {
+		t.Error("FileListInput() = true without WithFileListInput")
+	}
+	if !FileListInput(WithFileListInput(ctx)) {
+		t.Error("FileListInput() = false after WithFileListInput")
+	}
+}
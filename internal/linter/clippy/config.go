@@ -0,0 +1,112 @@
+package clippy
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// lintsSection is the table holding lint levels, in Cargo.toml [lints] format.
+const lintsSection = "lints.clippy"
+
+// Config is the parsed content of the generated clippy.toml.
+//
+// The file has two parts: top-level Clippy configuration (valid clippy.toml)
+// and a [lints.clippy] table in the Cargo.toml [lints] format. Clippy rejects
+// unknown keys in clippy.toml, so the two parts are separated at execution:
+// settings go to a temporary clippy.toml and levels become -W/-D flags.
+type Config struct {
+	// Settings are the raw top-level clippy.toml lines
+	Settings []string
+
+	// Lints maps lint names (without "clippy::") to levels
+	Lints map[string]string
+}
+
+// ParseConfig parses a generated clippy.toml.
+func ParseConfig(content []byte) (*Config, error) {
+	cfg := &Config{Lints: make(map[string]string)}
+	section := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			section = strings.Trim(line, "[] ")
+			if section != lintsSection {
+				return nil, fmt.Errorf("line %d: unsupported table [%s]", lineNo, section)
+			}
+			continue
+		}
+
+		if section == "" {
+			cfg.Settings = append(cfg.Settings, line)
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected lint = \"level\"", lineNo)
+		}
+		name = strings.TrimSpace(name)
+		level := strings.Trim(strings.TrimSpace(value), `"`)
+		if !isValidLevel(level) {
+			return nil, fmt.Errorf("line %d: invalid lint level %q", lineNo, level)
+		}
+		cfg.Lints[name] = level
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// LintFlags returns rustc flags that allow all Clippy lints and then turn on
+// the configured ones. Later flags take precedence.
+//
+// Enabled lints are passed as warnings regardless of their level: a denied
+// lint would fail the build, which is indistinguishable from a compile error
+// in files outside the validated set. Policy severity is applied by the
+// validator instead.
+func (c *Config) LintFlags() []string {
+	flags := []string{"-A", "clippy::all"}
+	for _, name := range c.EnabledLints() {
+		flags = append(flags, "-W", "clippy::"+name)
+	}
+	return flags
+}
+
+// EnabledLints returns the sorted names of lints whose level is not "allow".
+func (c *Config) EnabledLints() []string {
+	names := make([]string, 0, len(c.Lints))
+	for name, level := range c.Lints {
+		if level != "allow" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ClippyTOML returns the settings part as clippy.toml content.
+func (c *Config) ClippyTOML() string {
+	if len(c.Settings) == 0 {
+		return ""
+	}
+	return strings.Join(c.Settings, "\n") + "\n"
+}
+
+// isValidLevel reports whether level is a rustc lint level.
+func isValidLevel(level string) bool {
+	switch level {
+	case "allow", "warn", "deny", "forbid":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package clippy
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleConfig = `# Generated by Symphony CLI
+too-many-arguments-threshold = 5
+disallowed-methods = [{"path" = "std::env::var", "reason" = "use config"}]
+
+[lints.clippy]
+too_many_arguments = "deny"
+unwrap_used = "warn"
+wildcard_imports = "allow"
+`
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(sampleConfig))
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+
+	if len(cfg.Settings) != 2 {
+		t.Errorf("Settings = %v, want 2 lines", cfg.Settings)
+	}
+	if cfg.Lints["too_many_arguments"] != "deny" || cfg.Lints["unwrap_used"] != "warn" {
+		t.Errorf("Lints = %v", cfg.Lints)
+	}
+
+	if got := strings.Join(cfg.EnabledLints(), ","); got != "too_many_arguments,unwrap_used" {
+		t.Errorf("EnabledLints() = %q", got)
+	}
+	wantFlags := "-A clippy::all -W clippy::too_many_arguments -W clippy::unwrap_used"
+	if got := strings.Join(cfg.LintFlags(), " "); got != wantFlags {
+		t.Errorf("LintFlags() = %q, want %q", got, wantFlags)
+	}
+	if toml := cfg.ClippyTOML(); !strings.HasPrefix(toml, "too-many-arguments-threshold = 5\n") || strings.Contains(toml, "lints") {
+		t.Errorf("ClippyTOML() = %q, want only top-level settings", toml)
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	for _, content := range []string{
+		"[lints.rust]\nunsafe_code = \"deny\"\n",
+		"[lints.clippy]\nunwrap_used = \"loud\"\n",
+		"[lints.clippy]\nunwrap_used\n",
+	} {
+		if _, err := ParseConfig([]byte(content)); err == nil {
+			t.Errorf("ParseConfig(%q) expected error", content)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package clippy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+var (
+	lintNamePattern   = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	settingKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+)
+
+// Converter converts rules to Clippy configuration using LLM
+type Converter struct{}
+
+// NewConverter creates a new Clippy converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return "clippy"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"rust", "rs"}
+}
+
+// GetLLMDescription returns a description of Clippy's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Rust linter via cargo clippy (lints in correctness, style, complexity, perf, pedantic, restriction groups)
+  - CAN: No unwrap/expect/panic (unwrap_used, expect_used, panic), no indexing panics (indexing_slicing),
+         function limits (too_many_arguments, too_many_lines, cognitive_complexity), banned methods/types (disallowed_methods, disallowed_types),
+         no println/dbg (print_stdout, dbg_macro), missing docs (missing_docs_in_private_items, missing_errors_doc),
+         naming (module_name_repetitions, wildcard_imports), integer casts (cast_possible_truncation), todo!/unimplemented!
+  - CANNOT: File naming, architecture/layering, business logic, formatting (use rustfmt)`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use Clippy
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For Rust code quality, error handling (unwrap, expect, panic) or banned APIs → use clippy",
+		"For Rust function size or complexity limits → use clippy",
+		"For Rust file naming or text patterns Clippy has no lint for → use sym-pattern, not clippy",
+	}
+}
+
+// clippyRuleData holds Clippy-specific conversion data
+type clippyRuleData struct {
+	Lint     string
+	Level    string
+	Settings map[string]interface{}
+}
+
+// ConvertSingleRule converts ONE user rule to a Clippy lint.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by Clippy (skip),
+//	(nil, error) on actual conversion error.
+//
+// Note: Concurrency is handled by the main converter.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		Lint     string                 `json:"lint"`
+		Settings map[string]interface{} `json:"settings"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	if strings.TrimPrefix(result.Lint, "clippy::") == "" {
+		return nil, nil
+	}
+	return lintResult(rule, result.Lint, result.Settings)
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[clippyRuleData](data)
+}
+
+// ConvertNative enables a Clippy lint with its clippy.toml settings:
+// {"too_many_lines": {"too-many-lines-threshold": 80}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	lint, settings, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+	return lintResult(rule, lint, settings)
+}
+
+// lintResult validates a lint and its settings and enables the lint at the
+// rule's severity.
+func lintResult(rule schema.UserRule, name string, settings map[string]interface{}) (*linter.SingleRuleResult, error) {
+	lint := strings.TrimPrefix(name, "clippy::")
+	if !lintNamePattern.MatchString(lint) {
+		return nil, fmt.Errorf("invalid Clippy lint name %q", name)
+	}
+	for key := range settings {
+		if !settingKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid clippy.toml setting %q", key)
+		}
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: clippyRuleData{
+			Lint:     lint,
+			Level:    severityToLevel(rule.Severity),
+			Settings: settings,
+		},
+		NativeRuleIDs: []string{"clippy::" + lint},
+	}, nil
+}
+
+// BuildConfig assembles clippy.toml from successful rule conversions.
+// Top-level keys are clippy.toml settings; [lints.clippy] holds lint levels
+// in the Cargo.toml [lints] format so it can be copied into Cargo.toml.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	lints := make(map[string]string)
+	settings := make(map[string]interface{})
+	for _, r := range results {
+		data, ok := r.Data.(clippyRuleData)
+		if !ok {
+			continue
+		}
+		if existing, ok := lints[data.Lint]; !ok || levelRank(data.Level) > levelRank(existing) {
+			lints[data.Lint] = data.Level
+		}
+		for key, value := range data.Settings {
+			settings[key] = value
+		}
+	}
+
+	if len(lints) == 0 {
+		return nil, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Generated by Symphony CLI\n")
+	for _, key := range sortedKeys(settings) {
+		sb.WriteString(fmt.Sprintf("%s = %s\n", key, tomlValue(settings[key])))
+	}
+
+	sb.WriteString("\n[" + lintsSection + "]\n")
+	names := make([]string, 0, len(lints))
+	for name := range lints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("%s = %q\n", name, lints[name]))
+	}
+
+	return &linter.LinterConfig{
+		Filename: "clippy.toml",
+		Content:  []byte(sb.String()),
+		Format:   "toml",
+	}, nil
+}
+
+// buildPrompt builds the Clippy rule conversion prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	prompt := `You are a Rust Clippy expert. Convert the natural language Rust coding rule to ONE Clippy lint.
+
+Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "lint": "clippy_lint_name",
+  "settings": {"clippy-toml-key": value, ...}
+}
+
+"lint" is the Clippy lint name in snake_case without the "clippy::" prefix.
+"settings" are clippy.toml configuration keys (kebab-case) the lint needs, or null.
+
+Common lints and settings:
+- Error handling: unwrap_used, expect_used, panic, todo, unimplemented, indexing_slicing
+- Limits: too_many_arguments ("too-many-arguments-threshold"), too_many_lines ("too-many-lines-threshold"),
+  cognitive_complexity ("cognitive-complexity-threshold"), type_complexity ("type-complexity-threshold")
+- Banned APIs: disallowed_methods ("disallowed-methods": [{"path": "std::env::var", "reason": "..."}]),
+  disallowed_types ("disallowed-types"), disallowed_names ("disallowed-names")
+- Output: print_stdout, print_stderr, dbg_macro
+- Docs: missing_docs_in_private_items, missing_errors_doc, missing_panics_doc
+- Style: wildcard_imports, module_name_repetitions, shadow_unrelated, cast_possible_truncation
+
+If the rule cannot be expressed with a Clippy lint, return:
+{
+  "lint": "",
+  "settings": null
+}
+
+Examples:
+
+Input: "Never call unwrap() in production code"
+Output:
+{
+  "lint": "unwrap_used",
+  "settings": null
+}
+
+Input: "Functions must have at most 5 parameters"
+Output:
+{
+  "lint": "too_many_arguments",
+  "settings": {"too-many-arguments-threshold": 5}
+}`
+
+	prompt += fmt.Sprintf("\n\nConvert this rule to a Clippy lint:\n\n%s", rule.Say)
+	prompt += linter.ParamsPrompt(rule.Params)
+	return prompt
+}
+
+// severityToLevel maps a policy severity to a lint level.
+func severityToLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "warning", "warn", "info":
+		return "warn"
+	default:
+		return "deny"
+	}
+}
+
+// levelRank orders lint levels so the strictest wins when rules share a lint.
+func levelRank(level string) int {
+	switch level {
+	case "forbid":
+		return 3
+	case "deny":
+		return 2
+	case "warn":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortedKeys returns map keys in sorted order.
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// tomlValue formats a JSON-decoded value as a TOML value.
+func tomlValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, tomlValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		items := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			items = append(items, fmt.Sprintf("%s = %s", strconv.Quote(key), tomlValue(v[key])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,131 @@
+package clippy
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(_ context.Context, prompt string, _ llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) Close() error { return nil }
+
+func TestConvertSingleRule(t *testing.T) {
+	provider := &mockProvider{response: `{"lint": "clippy::too_many_arguments", "settings": {"too-many-arguments-threshold": 5}}`}
+	rule := schema.UserRule{ID: "RS-ARGS", Say: "Functions must have at most 5 parameters", Severity: "warning"}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("ConvertSingleRule() returned nil result")
+	}
+	if len(result.NativeRuleIDs) != 1 || result.NativeRuleIDs[0] != "clippy::too_many_arguments" {
+		t.Errorf("NativeRuleIDs = %v", result.NativeRuleIDs)
+	}
+	data := result.Data.(clippyRuleData)
+	if data.Lint != "too_many_arguments" || data.Level != "warn" {
+		t.Errorf("Data = %+v", data)
+	}
+	if !strings.Contains(provider.prompt, rule.Say) {
+		t.Error("prompt should include the rule text")
+	}
+}
+
+func TestConvertSingleRule_SkipAndInvalid(t *testing.T) {
+	result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"},
+		&mockProvider{response: `{"lint": "", "settings": null}`})
+	if err != nil || result != nil {
+		t.Errorf("ConvertSingleRule() = %v, %v; want nil, nil", result, err)
+	}
+
+	for _, response := range []string{
+		`not json`,
+		`{"lint": "NoUnwrap"}`,
+		`{"lint": "too_many_lines", "settings": {"Too Many": 1}}`,
+	} {
+		if _, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response}); err == nil {
+			t.Errorf("ConvertSingleRule(%q) expected error", response)
+		}
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: clippyRuleData{Lint: "unwrap_used", Level: "warn"}},
+		{RuleID: "R2", Data: clippyRuleData{Lint: "unwrap_used", Level: "deny"}},
+		{RuleID: "R3", Data: clippyRuleData{Lint: "disallowed_methods", Level: "deny", Settings: map[string]interface{}{
+			"disallowed-methods": []interface{}{map[string]interface{}{"path": "std::env::var", "reason": "use config"}},
+		}}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil {
+		t.Fatalf("BuildConfig() error = %v", err)
+	}
+	if config.Filename != "clippy.toml" || config.Format != "toml" {
+		t.Errorf("config = %s (%s)", config.Filename, config.Format)
+	}
+
+	want := `# Generated by Symphony CLI
+disallowed-methods = [{"path" = "std::env::var", "reason" = "use config"}]
+
+[lints.clippy]
+disallowed_methods = "deny"
+unwrap_used = "deny"
+`
+	if got := string(config.Content); got != want {
+		t.Errorf("BuildConfig() content =\n%s\nwant:\n%s", got, want)
+	}
+
+	// The generated file must round-trip through the execution-time parser
+	cfg, err := ParseConfig(config.Content)
+	if err != nil {
+		t.Fatalf("ParseConfig() error = %v", err)
+	}
+	if len(cfg.EnabledLints()) != 2 || len(cfg.Settings) != 1 {
+		t.Errorf("parsed config = %+v", cfg)
+	}
+}
+
+func TestBuildConfig_Empty(t *testing.T) {
+	config, err := NewConverter().BuildConfig(nil)
+	if err != nil || config != nil {
+		t.Errorf("BuildConfig(nil) = %v, %v; want nil, nil", config, err)
+	}
+}
+
+func TestConvertNative(t *testing.T) {
+	rule := schema.UserRule{ID: "RS-LINES", Say: "Functions must have at most 80 lines", Severity: "warning"}
+
+	result, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"clippy::too_many_lines": {"too-many-lines-threshold": 80}}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	data := result.Data.(clippyRuleData)
+	if data.Lint != "too_many_lines" || data.Level != "warn" || data.Settings["too-many-lines-threshold"] != float64(80) {
+		t.Errorf("Data = %+v", data)
+	}
+
+	for _, native := range []string{`{"Too-Many": null}`, `{"too_many_lines": {"Bad_Key": 1}}`, `{"a": null, "b": null}`} {
+		if _, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(native)); err == nil {
+			t.Errorf("ConvertNative(%s) expected error", native)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package clippy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// execute runs cargo clippy once per crate containing the given files and
+// keeps only enabled Clippy lints reported for those files.
+func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{ExitCode: 0}, nil
+	}
+
+	cfg, err := ParseConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clippy config: %w", err)
+	}
+
+	confDir, err := l.writeConfDir(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write clippy.toml: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(confDir) }()
+
+	enabled := make(map[string]bool)
+	for _, name := range cfg.EnabledLints() {
+		enabled["clippy::"+name] = true
+	}
+
+	start := time.Now()
+	result := &linter.ToolOutput{}
+	var stdout, stderr []string
+
+	for _, group := range groupByManifest(files) {
+		executor := linter.NewSubprocessExecutor()
+		executor.Timeout = l.Timeout
+
+		output, err := executor.Run(ctx, "cargo", getExecutionArgs(group.manifest, cfg), linter.WithEnv("CLIPPY_CONF_DIR", confDir))
+		if err != nil {
+			return nil, err
+		}
+
+		stdout = append(stdout, filterMessages(output.Stdout, filepath.Dir(group.manifest), group.files, enabled)...)
+		if output.Stderr != "" {
+			stderr = append(stderr, output.Stderr)
+		}
+		if output.ExitCode > result.ExitCode {
+			result.ExitCode = output.ExitCode
+		}
+	}
+
+	result.Stdout = strings.Join(stdout, "\n")
+	result.Stderr = strings.Join(stderr, "\n")
+	result.Duration = time.Since(start).String()
+	return result, nil
+}
+
+// getExecutionArgs returns the cargo arguments for one crate.
+func getExecutionArgs(manifest string, cfg *Config) []string {
+	args := []string{
+		"clippy",
+		"--manifest-path", manifest,
+		"--message-format=json",
+		"--all-targets",
+		"--quiet",
+		"--",
+	}
+	return append(args, cfg.LintFlags()...)
+}
+
+// crateFiles is a set of files belonging to one Cargo manifest.
+type crateFiles struct {
+	manifest string
+	files    []string
+}
+
+// groupByManifest groups files by their nearest Cargo.toml.
+// Files outside any crate are skipped.
+func groupByManifest(files []string) []crateFiles {
+	byManifest := make(map[string][]string)
+	for _, file := range files {
+		if manifest := findManifest(filepath.Dir(file)); manifest != "" {
+			byManifest[manifest] = append(byManifest[manifest], file)
+		}
+	}
+
+	groups := make([]crateFiles, 0, len(byManifest))
+	for manifest, files := range byManifest {
+		groups = append(groups, crateFiles{manifest: manifest, files: files})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].manifest < groups[j].manifest })
+	return groups
+}
+
+// findManifest walks up from dir to find Cargo.toml.
+func findManifest(dir string) string {
+	for {
+		manifest := filepath.Join(dir, "Cargo.toml")
+		if _, err := os.Stat(manifest); err == nil {
+			return manifest
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// filterMessages keeps compiler messages for enabled lints in the target files
+// and rewrites their primary span to the target path as given by the caller.
+func filterMessages(stdout, crateDir string, targets []string, enabled map[string]bool) []string {
+	var kept []string
+	for _, line := range strings.Split(stdout, "\n") {
+		var msg CargoMessage
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			continue
+		}
+		if msg.Reason != "compiler-message" || msg.Message == nil || msg.Message.Code == nil {
+			continue
+		}
+		if !enabled[msg.Message.Code.Code] {
+			continue
+		}
+
+		span := msg.Message.primarySpan()
+		if span == nil {
+			continue
+		}
+		target := matchTarget(crateDir, span.FileName, targets)
+		if target == "" {
+			continue
+		}
+		span.FileName = target
+
+		data, err := json.Marshal(msg)
+		if err != nil {
+			continue
+		}
+		kept = append(kept, string(data))
+	}
+	return kept
+}
+
+// matchTarget returns the target file a reported path refers to, or "".
+// Cargo reports paths relative to the workspace root, which may be above the
+// crate directory, so a suffix match is accepted as well.
+func matchTarget(crateDir, reported string, targets []string) string {
+	reported = filepath.Clean(reported)
+	for _, target := range targets {
+		cleanTarget := filepath.Clean(target)
+		if filepath.Join(crateDir, reported) == cleanTarget ||
+			reported == cleanTarget ||
+			strings.HasSuffix(cleanTarget, string(filepath.Separator)+reported) ||
+			strings.HasSuffix(reported, string(filepath.Separator)+cleanTarget) {
+			return target
+		}
+	}
+	return ""
+}
+
+// writeConfDir writes clippy.toml into a fresh directory for CLIPPY_CONF_DIR.
+func (l *Linter) writeConfDir(cfg *Config) (string, error) {
+	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp(tmpDir, "clippy-*")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "clippy.toml"), []byte(cfg.ClippyTOML()), 0644); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
@@ -0,0 +1,110 @@
+package clippy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGroupByManifest(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"app/src", "crates/core/src/net", "scripts"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, manifest := range []string{"app/Cargo.toml", "crates/core/Cargo.toml"} {
+		if err := os.WriteFile(filepath.Join(root, manifest), []byte("[package]\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	groups := groupByManifest([]string{
+		filepath.Join(root, "app/src/main.rs"),
+		filepath.Join(root, "crates/core/src/net/tcp.rs"),
+		filepath.Join(root, "crates/core/src/lib.rs"),
+		filepath.Join(root, "scripts/gen.rs"),
+	})
+
+	if len(groups) != 2 {
+		t.Fatalf("groupByManifest() returned %d groups, want 2", len(groups))
+	}
+	if groups[0].manifest != filepath.Join(root, "app/Cargo.toml") || len(groups[0].files) != 1 {
+		t.Errorf("groups[0] = %+v", groups[0])
+	}
+	if groups[1].manifest != filepath.Join(root, "crates/core/Cargo.toml") || len(groups[1].files) != 2 {
+		t.Errorf("groups[1] = %+v", groups[1])
+	}
+}
+
+func TestScaffoldExamples(t *testing.T) {
+	dir := t.TempDir()
+	paths, err := New("").ScaffoldExamples(dir, []string{"bad_1", "good_1"}, ".rs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{filepath.Join(dir, "src", "bad_1.rs"), filepath.Join(dir, "src", "good_1.rs")}
+	if len(paths) != 2 || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("ScaffoldExamples() = %v, want %v", paths, want)
+	}
+	lib, err := os.ReadFile(filepath.Join(dir, "src", "lib.rs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(lib) != "pub mod bad_1;\npub mod good_1;\n" {
+		t.Errorf("lib.rs = %q", lib)
+	}
+
+	// The example files belong to the scaffolded crate
+	groups := groupByManifest(paths)
+	if len(groups) != 1 || groups[0].manifest != filepath.Join(dir, "Cargo.toml") || len(groups[0].files) != 2 {
+		t.Errorf("groupByManifest() = %+v", groups)
+	}
+}
+
+func TestGetExecutionArgs(t *testing.T) {
+	cfg := &Config{Lints: map[string]string{"unwrap_used": "deny"}}
+
+	got := strings.Join(getExecutionArgs("app/Cargo.toml", cfg), " ")
+	want := "clippy --manifest-path app/Cargo.toml --message-format=json --all-targets --quiet -- -A clippy::all -W clippy::unwrap_used"
+	if got != want {
+		t.Errorf("getExecutionArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestFilterMessages(t *testing.T) {
+	stdout := strings.Join([]string{
+		`{"reason":"compiler-message","message":{"message":"unwrap","code":{"code":"clippy::unwrap_used"},"level":"warning","spans":[{"file_name":"crates/core/src/lib.rs","line_start":3,"column_start":5,"is_primary":true}]}}`,
+		`{"reason":"compiler-message","message":{"message":"unwrap","code":{"code":"clippy::unwrap_used"},"level":"warning","spans":[{"file_name":"crates/core/src/other.rs","line_start":1,"column_start":1,"is_primary":true}]}}`,
+		`{"reason":"compiler-message","message":{"message":"needless return","code":{"code":"clippy::needless_return"},"level":"warning","spans":[{"file_name":"crates/core/src/lib.rs","line_start":9,"column_start":5,"is_primary":true}]}}`,
+		`{"reason":"build-finished","success":true}`,
+	}, "\n")
+
+	kept := filterMessages(stdout, "crates/core", []string{"crates/core/src/lib.rs"}, map[string]bool{"clippy::unwrap_used": true})
+	if len(kept) != 1 {
+		t.Fatalf("filterMessages() kept %d messages, want 1: %v", len(kept), kept)
+	}
+	if !strings.Contains(kept[0], `"file_name":"crates/core/src/lib.rs"`) {
+		t.Errorf("kept message = %s", kept[0])
+	}
+}
+
+func TestMatchTarget(t *testing.T) {
+	targets := []string{"crates/core/src/lib.rs", "app/src/main.rs"}
+
+	tests := []struct {
+		crateDir, reported, want string
+	}{
+		{"crates/core", "src/lib.rs", "crates/core/src/lib.rs"},             // relative to the crate
+		{"crates/core", "crates/core/src/lib.rs", "crates/core/src/lib.rs"}, // relative to the workspace
+		{"app", "src/main.rs", "app/src/main.rs"},
+		{"app", "src/other.rs", ""},
+	}
+	for _, tt := range tests {
+		if got := matchTarget(tt.crateDir, tt.reported, targets); got != tt.want {
+			t.Errorf("matchTarget(%q, %q) = %q, want %q", tt.crateDir, tt.reported, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package clippy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter            = (*Linter)(nil)
+	_ linter.Locator           = (*Linter)(nil)
+	_ linter.ExampleScaffolder = (*Linter)(nil)
+)
+
+// Linter wraps Clippy (cargo clippy) for Rust validation.
+//
+// Clippy checks whole crates, not single files:
+// - Lints are selected with lint levels (allow, warn, deny, forbid)
+// - Thresholds and lists come from clippy.toml (e.g., too-many-arguments-threshold)
+// - Results are narrowed to the requested files after execution
+//
+// Note: Linter is goroutine-safe and stateless. WorkDir is determined
+// by CWD at execution time, not stored in the linter.
+type Linter struct {
+	// ToolsDir holds temporary clippy.toml directories
+	// Default: ~/.sym/tools
+	ToolsDir string
+
+	// Timeout bounds one cargo clippy run; building a crate takes longer than
+	// linting a file, so this exceeds the default subprocess timeout.
+	Timeout time.Duration
+}
+
+// New creates a new Clippy linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+
+	return &Linter{
+		ToolsDir: toolsDir,
+		Timeout:  10 * time.Minute,
+	}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "clippy"
+}
+
+// GetCapabilities returns the Clippy linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:               "clippy",
+		SupportedLanguages: []string{"rust", "rs"},
+		SupportedCategories: []string{
+			"naming",
+			"error_handling",
+			"complexity",
+			"performance",
+			"style",
+			"pattern",
+		},
+		Version:   "stable",
+		FileInput: linter.FileInputProject, // cargo checks whole crates
+	}
+}
+
+// CheckAvailability checks if cargo and the clippy component are installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	if _, err := exec.LookPath("cargo"); err != nil {
+		return fmt.Errorf("cargo not found: please install the Rust toolchain first")
+	}
+
+	cmd := exec.CommandContext(ctx, "cargo", "clippy", "--version")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cargo clippy not available: %w", err)
+	}
+	return nil
+}
+
+// Install installs the clippy component with rustup.
+// Clippy ships with the Rust toolchain, so there is nothing to put in ToolsDir.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if _, err := exec.LookPath("rustup"); err != nil {
+		return fmt.Errorf("rustup not found: please install the Rust toolchain (https://rustup.rs) first")
+	}
+
+	args := []string{"component", "add", "clippy"}
+	if toolchain := config.ToolVersion(l.Name(), ""); toolchain != "" {
+		args = append(args, "--toolchain", toolchain)
+	}
+
+	output, err := linter.NewSubprocessExecutor().Execute(ctx, "rustup", args...)
+	if err != nil {
+		return fmt.Errorf("rustup failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("rustup component add clippy failed: %s", strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Locate reports the cargo clippy installation.
+// Clippy is part of the Rust toolchain, so it is never Symphony-managed.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	cargo, err := exec.LookPath("cargo")
+	if err != nil {
+		return linter.Installation{}, linter.ErrToolNotFound
+	}
+
+	output, err := linter.NewSubprocessExecutor().Execute(ctx, cargo, "clippy", "--version")
+	if err != nil || output.ExitCode != 0 {
+		return linter.Installation{}, linter.ErrToolNotFound
+	}
+	return linter.Installation{
+		Path:    cargo + " clippy",
+		Version: linter.ParseVersion(output.Stdout),
+		Source:  linter.ToolSourcePath,
+	}, nil
+}
+
+// Execute runs cargo clippy with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files)
+}
+
+// ParseOutput converts cargo JSON messages to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(output)
+}
+
+// ScaffoldExamples lays the examples out as a library crate, since cargo
+// clippy only checks files a crate compiles: each example is a module of
+// src/lib.rs.
+func (l *Linter) ScaffoldExamples(dir string, names []string, ext string) ([]string, error) {
+	manifest := "[package]\nname = \"sym-examples\"\nversion = \"0.0.0\"\nedition = \"2021\"\n\n[workspace]\n"
+	if err := os.WriteFile(filepath.Join(dir, "Cargo.toml"), []byte(manifest), 0644); err != nil {
+		return nil, err
+	}
+	srcDir := filepath.Join(dir, "src")
+	if err := os.MkdirAll(srcDir, 0755); err != nil {
+		return nil, err
+	}
+
+	var lib strings.Builder
+	paths := make([]string, len(names))
+	for i, name := range names {
+		fmt.Fprintf(&lib, "pub mod %s;\n", name)
+		paths[i] = filepath.Join(srcDir, name+ext)
+	}
+	if err := os.WriteFile(filepath.Join(srcDir, "lib.rs"), []byte(lib.String()), 0644); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
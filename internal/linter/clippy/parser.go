@@ -0,0 +1,96 @@
+package clippy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// CargoMessage is one line of `cargo clippy --message-format=json` output.
+type CargoMessage struct {
+	Reason  string           `json:"reason"` // compiler-message, compiler-artifact, build-finished
+	Message *CompilerMessage `json:"message,omitempty"`
+}
+
+// CompilerMessage is a rustc diagnostic.
+type CompilerMessage struct {
+	Message string           `json:"message"`
+	Code    *DiagnosticCode  `json:"code"`  // null for diagnostics without a lint name
+	Level   string           `json:"level"` // error, warning, note, help
+	Spans   []DiagnosticSpan `json:"spans"`
+}
+
+// DiagnosticCode identifies the lint (e.g., "clippy::unwrap_used").
+type DiagnosticCode struct {
+	Code string `json:"code"`
+}
+
+// DiagnosticSpan is a source location of a diagnostic.
+type DiagnosticSpan struct {
+	FileName    string `json:"file_name"` // relative to the workspace root
+	LineStart   int    `json:"line_start"`
+	ColumnStart int    `json:"column_start"`
+	IsPrimary   bool   `json:"is_primary"`
+}
+
+// primarySpan returns the primary span of a diagnostic, if any.
+func (m *CompilerMessage) primarySpan() *DiagnosticSpan {
+	for i := range m.Spans {
+		if m.Spans[i].IsPrimary {
+			return &m.Spans[i]
+		}
+	}
+	return nil
+}
+
+// parseOutput converts cargo JSON messages to violations.
+// Only Clippy lint diagnostics with a primary span are reported.
+func parseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	var violations []linter.Violation
+
+	scanner := bufio.NewScanner(strings.NewReader(output.Stdout))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+
+		var msg CargoMessage
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			return nil, fmt.Errorf("failed to parse cargo output: %w", err)
+		}
+		if msg.Reason != "compiler-message" || msg.Message == nil || msg.Message.Code == nil {
+			continue
+		}
+		if !strings.HasPrefix(msg.Message.Code.Code, "clippy::") {
+			continue
+		}
+		span := msg.Message.primarySpan()
+		if span == nil {
+			continue
+		}
+
+		violations = append(violations, linter.Violation{
+			File:     span.FileName,
+			Line:     span.LineStart,
+			Column:   span.ColumnStart,
+			Message:  msg.Message.Message,
+			Severity: linter.MapSeverity(msg.Message.Level),
+			RuleID:   msg.Message.Code.Code,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cargo output: %w", err)
+	}
+
+	// A non-zero exit without lint results means the crate failed to build
+	if len(violations) == 0 && output.ExitCode != 0 {
+		return nil, fmt.Errorf("cargo clippy failed: %s", strings.TrimSpace(output.Stderr))
+	}
+
+	return violations, nil
+}
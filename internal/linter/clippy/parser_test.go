@@ -0,0 +1,46 @@
+package clippy
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func TestParseOutput(t *testing.T) {
+	stdout := `{"reason":"compiler-artifact","package_id":"demo 0.1.0"}
+{"reason":"compiler-message","message":{"message":"used ` + "`unwrap()`" + ` on an ` + "`Option`" + ` value","code":{"code":"clippy::unwrap_used","explanation":null},"level":"warning","spans":[{"file_name":"src/main.rs","line_start":4,"column_start":13,"is_primary":true}]}}
+{"reason":"compiler-message","message":{"message":"unused variable: ` + "`x`" + `","code":{"code":"unused_variables"},"level":"warning","spans":[{"file_name":"src/main.rs","line_start":2,"column_start":9,"is_primary":true}]}}
+{"reason":"compiler-message","message":{"message":"2 warnings emitted","code":null,"level":"warning","spans":[]}}
+{"reason":"build-finished","success":true}`
+
+	violations, err := parseOutput(&linter.ToolOutput{Stdout: stdout})
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("parseOutput() returned %d violations, want 1", len(violations))
+	}
+
+	v := violations[0]
+	if v.File != "src/main.rs" || v.Line != 4 || v.Column != 13 || v.RuleID != "clippy::unwrap_used" || v.Severity != "warning" {
+		t.Errorf("violation = %+v", v)
+	}
+}
+
+func TestParseOutput_BuildFailure(t *testing.T) {
+	output := &linter.ToolOutput{
+		Stdout:   `{"reason":"build-finished","success":false}`,
+		Stderr:   "error: could not compile `demo`",
+		ExitCode: 101,
+	}
+	if _, err := parseOutput(output); err == nil {
+		t.Error("parseOutput() expected error for build failure")
+	}
+}
+
+func TestParseOutput_Empty(t *testing.T) {
+	violations, err := parseOutput(&linter.ToolOutput{})
+	if err != nil || len(violations) != 0 {
+		t.Errorf("parseOutput() = %v, %v; want no violations", violations, err)
+	}
+}
@@ -2,6 +2,8 @@ package linter
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/DevSymphony/sym-cli/internal/llm"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
@@ -42,9 +44,75 @@ type Converter interface {
 
 // SingleRuleResult represents the conversion result for a single rule.
 // The Data field contains linter-specific data that BuildConfig understands.
+//
+// NativeRuleIDs optionally lists the tool's own rule IDs enabled for the rule
+// (e.g., SARIF ruleId values). The main converter stores them in the policy
+// rule's check as "ruleIds" so violations can be mapped back to the rule.
 type SingleRuleResult struct {
-	RuleID string      // Original user rule ID
-	Data   interface{} // Linter-specific data (e.g., ESLint rule config, Checkstyle module)
+	RuleID        string      // Original user rule ID
+	Data          interface{} // Linter-specific data (e.g., ESLint rule config, Checkstyle module)
+	NativeRuleIDs []string    // Native rule IDs reported by the tool (optional)
+}
+
+// RuleDataDecoder is implemented by converters whose SingleRuleResult data can
+// be restored from its JSON encoding. It is optional: the main converter
+// caches conversions of such converters in the conversion manifest and
+// reconverts rules of other converters on every run.
+type RuleDataDecoder interface {
+	// DecodeRuleData restores the Data of a SingleRuleResult from JSON.
+	DecodeRuleData(data json.RawMessage) (interface{}, error)
+}
+
+// UnmarshalRuleData decodes rule data of type T, for implementing RuleDataDecoder.
+func UnmarshalRuleData[T any](data json.RawMessage) (interface{}, error) {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("failed to decode rule data: %w", err)
+	}
+	return v, nil
+}
+
+// NativeConverter is implemented by converters that accept a rule's native
+// config (the "native" field of a user rule) without the LLM. It is optional:
+// the main converter reports native config for other converters as an error.
+type NativeConverter interface {
+	// ConvertNative converts a rule from the tool's own config format,
+	// e.g. {"no-console": "error"} for ESLint.
+	ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*SingleRuleResult, error)
+}
+
+// SingleNativeRule decodes native config naming exactly one tool rule, keyed
+// by the rule's name: {"no-console": "error"}. The config may be null.
+func SingleNativeRule(native json.RawMessage) (string, json.RawMessage, error) {
+	var rules map[string]json.RawMessage
+	if err := json.Unmarshal(native, &rules); err != nil {
+		return "", nil, fmt.Errorf("native config must be an object keyed by rule name: %w", err)
+	}
+	if len(rules) != 1 {
+		return "", nil, fmt.Errorf("native config must name exactly one rule, got %d", len(rules))
+	}
+	for name, config := range rules {
+		if name == "" {
+			return "", nil, fmt.Errorf("native config has an empty rule name")
+		}
+		return name, config, nil
+	}
+	return "", nil, nil
+}
+
+// SingleNativeRuleOptions is SingleNativeRule for tools whose rules take an
+// object of options: {"line-too-long": {"max-line-length": 120}}. The
+// options may be null.
+func SingleNativeRuleOptions(native json.RawMessage) (string, map[string]interface{}, error) {
+	name, raw, err := SingleNativeRule(native)
+	if err != nil {
+		return "", nil, err
+	}
+	var options map[string]interface{}
+	if err := json.Unmarshal(raw, &options); err != nil {
+		return "", nil, fmt.Errorf("options of %s must be an object: %w", name, err)
+	}
+	return name, options, nil
 }
 
 // LinterConfig represents a generated configuration file.
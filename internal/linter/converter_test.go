@@ -0,0 +1,73 @@
+package linter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testRuleData struct {
+	Name    string
+	Options map[string]interface{}
+}
+
+func TestUnmarshalRuleData(t *testing.T) {
+	want := testRuleData{Name: "max-len", Options: map[string]interface{}{"max": float64(100)}}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := UnmarshalRuleData[testRuleData](data)
+	if err != nil {
+		t.Fatalf("UnmarshalRuleData: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	ptr, err := UnmarshalRuleData[*testRuleData](data)
+	if err != nil {
+		t.Fatalf("UnmarshalRuleData pointer: %v", err)
+	}
+	if p, ok := ptr.(*testRuleData); !ok || !reflect.DeepEqual(*p, want) {
+		t.Errorf("got %#v, want pointer to %#v", ptr, want)
+	}
+
+	if _, err := UnmarshalRuleData[testRuleData](json.RawMessage(`[1]`)); err == nil {
+		t.Error("expected error for mismatched JSON")
+	}
+}
+
+func TestSingleNativeRule(t *testing.T) {
+	name, config, err := SingleNativeRule(json.RawMessage(`{"no-console": ["error", {"allow": ["warn"]}]}`))
+	if err != nil {
+		t.Fatalf("SingleNativeRule: %v", err)
+	}
+	if name != "no-console" || string(config) != `["error", {"allow": ["warn"]}]` {
+		t.Errorf("got %q %s", name, config)
+	}
+
+	for _, native := range []string{`{}`, `{"a": 1, "b": 2}`, `["no-console"]`, `{"": 1}`} {
+		if _, _, err := SingleNativeRule(json.RawMessage(native)); err == nil {
+			t.Errorf("expected error for %s", native)
+		}
+	}
+}
+
+func TestSingleNativeRuleOptions(t *testing.T) {
+	name, options, err := SingleNativeRuleOptions(json.RawMessage(`{"line-too-long": {"max-line-length": 120}}`))
+	if err != nil {
+		t.Fatalf("SingleNativeRuleOptions: %v", err)
+	}
+	if name != "line-too-long" || !reflect.DeepEqual(options, map[string]interface{}{"max-line-length": float64(120)}) {
+		t.Errorf("got %q %v", name, options)
+	}
+
+	if _, options, err := SingleNativeRuleOptions(json.RawMessage(`{"bare-except": null}`)); err != nil || options != nil {
+		t.Errorf("null options: got %v, %v", options, err)
+	}
+	if _, _, err := SingleNativeRuleOptions(json.RawMessage(`{"line-too-long": 120}`)); err == nil {
+		t.Error("expected error for non-object options")
+	}
+}
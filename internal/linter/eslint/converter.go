@@ -80,6 +80,39 @@ func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule,
 			RuleName: ruleName,
 			Config:   config,
 		},
+		NativeRuleIDs: []string{ruleName},
+	}, nil
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[eslintRuleData](data)
+}
+
+// ConvertNative uses a native ESLint rule entry as is:
+// {"no-console": "error"} or {"max-len": ["error", {"code": 120}]}.
+// A null entry enables the rule at the rule's severity.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	ruleName, raw, err := linter.SingleNativeRule(native)
+	if err != nil {
+		return nil, err
+	}
+
+	var config interface{}
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", ruleName, err)
+	}
+	if config == nil {
+		config = mapSeverity(rule.Severity)
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: eslintRuleData{
+			RuleName: ruleName,
+			Config:   config,
+		},
+		NativeRuleIDs: []string{ruleName},
 	}, nil
 }
 
@@ -201,6 +234,7 @@ Output:
 (Reason: Requires plugin or semantic analysis)`
 
 	userPrompt := fmt.Sprintf("Convert this rule to ESLint configuration:\n\n%s", rule.Say)
+	userPrompt += linter.ParamsPrompt(rule.Params)
 	if rule.Severity != "" {
 		userPrompt += fmt.Sprintf("\nSeverity: %s", rule.Severity)
 	}
@@ -241,11 +275,59 @@ Output:
 	}
 
 	// Build rule configuration using format helper for special rules
-	config := formatESLintRuleConfig(result.RuleName, severity, result.Options)
+	options := applyParams(result.RuleName, result.Options, rule.Params)
+	config := formatESLintRuleConfig(result.RuleName, severity, options)
 
 	return result.RuleName, config, nil
 }
 
+// RuleParams returns the threshold params applied to ESLint's bounded rules.
+func (c *Converter) RuleParams() []linter.RuleParam {
+	return []linter.RuleParam{linter.MaxParam, linter.MinParam}
+}
+
+// thresholdOptions maps rule params to the options holding the bounds of ESLint rules.
+var thresholdOptions = map[string]map[string]string{
+	"complexity":             {"max": "max"},
+	"id-length":              {"max": "max", "min": "min"},
+	"max-classes-per-file":   {"max": "max"},
+	"max-depth":              {"max": "max"},
+	"max-len":                {"max": "code"},
+	"max-lines":              {"max": "max"},
+	"max-lines-per-function": {"max": "max"},
+	"max-nested-callbacks":   {"max": "max"},
+	"max-params":             {"max": "max"},
+	"max-statements":         {"max": "max"},
+}
+
+// applyParams writes threshold params into the options of a bounded rule,
+// replacing the values the LLM chose. Other rules keep their options.
+func applyParams(ruleName string, options interface{}, params map[string]any) interface{} {
+	bounds, ok := thresholdOptions[ruleName]
+	if !ok || len(params) == 0 {
+		return options
+	}
+
+	// Bounded rules also accept a bare number; params always use the object form
+	result := make(map[string]interface{})
+	if opts, ok := options.(map[string]interface{}); ok {
+		for key, value := range opts {
+			result[key] = value
+		}
+	}
+	applied := false
+	for param, option := range bounds {
+		if n, ok := linter.IntParam(params, param); ok {
+			result[option] = n
+			applied = true
+		}
+	}
+	if !applied {
+		return options
+	}
+	return result
+}
+
 // mapSeverity maps user severity to ESLint severity
 func mapSeverity(severity string) string {
 	switch strings.ToLower(severity) {
@@ -2,9 +2,9 @@ package eslint
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -12,6 +12,22 @@ import (
 
 // execute runs ESLint with the given config and files.
 func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	return l.runESLint(ctx, config, files)
+}
+
+// executeFix runs ESLint with --fix, rewriting fixable violations in place.
+// Remaining violations are still reported in the JSON output.
+func (l *Linter) executeFix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	return l.runESLint(ctx, config, files, "--fix")
+}
+
+// runESLint runs ESLint with the given config, files and extra flags.
+//
+// In project config merge mode (linter.WithProjectConfigMerge) the project's
+// .eslintrc cascade is loaded and the generated config is layered on top, so
+// project plugins and parser settings apply. Only violations of generated
+// rules are reported.
+func (l *Linter) runESLint(ctx context.Context, config []byte, files []string, extraArgs ...string) (*linter.ToolOutput, error) {
 	if len(files) == 0 {
 		return &linter.ToolOutput{
 			Stdout:   "[]",
@@ -19,6 +35,14 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 		}, nil
 	}
 
+	mergeProject := linter.ProjectConfigMerge(ctx) && findProjectConfig() != ""
+	if mergeProject {
+		var err error
+		if config, err = stripParser(config); err != nil {
+			return nil, fmt.Errorf("failed to prepare config: %w", err)
+		}
+	}
+
 	// Write config to temp file
 	configPath, err := l.writeConfigFile(config)
 	if err != nil {
@@ -27,28 +51,35 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 	defer func() { _ = os.Remove(configPath) }()
 
 	// Get command and arguments
-	eslintCmd, args := l.getExecutionArgs(configPath, files)
+	eslintCmd, args := l.getExecutionArgs(configPath, files, mergeProject)
+	args = append(args, extraArgs...)
 
 	// Execute with environment variable to support both ESLint 8 and 9
-	// Reset WorkDir to use CWD (Install() may have set it to ToolsDir)
-	l.executor.WorkDir = ""
-	l.executor.Env = map[string]string{
-		"ESLINT_USE_FLAT_CONFIG": "false",
+	output, err := l.executor.Run(ctx, eslintCmd, args, linter.WithEnv("ESLINT_USE_FLAT_CONFIG", "false"))
+	if err != nil || !mergeProject {
+		return output, err
+	}
+
+	output.Stdout, err = filterMessages(output.Stdout, configuredRules(config))
+	if err != nil {
+		return nil, err
 	}
-	return l.executor.Execute(ctx, eslintCmd, args...)
+	return output, nil
+}
+
+// resolveESLint locates ESLint: project node_modules, PATH, then tools dir.
+func (l *Linter) resolveESLint() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "eslint",
+		Node:         true,
+		ManagedPaths: []string{l.getESLintPath()},
+	})
 }
 
 // getESLintCommand returns the ESLint command to use.
 func (l *Linter) getESLintCommand() string {
-	// Try local installation first
-	localPath := l.getESLintPath()
-	if _, err := os.Stat(localPath); err == nil {
-		return localPath
-	}
-
-	// Try global eslint
-	if _, err := exec.LookPath("eslint"); err == nil {
-		return "eslint"
+	if tool := l.resolveESLint(); tool.Found() {
+		return tool.Path
 	}
 
 	// Fall back to npx with ESLint 8.x
@@ -56,7 +87,8 @@ func (l *Linter) getESLintCommand() string {
 }
 
 // getExecutionArgs returns the command and arguments for ESLint execution.
-func (l *Linter) getExecutionArgs(configPath string, files []string) (string, []string) {
+// mergeProject keeps the project's .eslintrc files in effect.
+func (l *Linter) getExecutionArgs(configPath string, files []string, mergeProject bool) (string, []string) {
 	eslintCmd := l.getESLintCommand()
 
 	var args []string
@@ -70,13 +102,78 @@ func (l *Linter) getExecutionArgs(configPath string, files []string) (string, []
 	args = append(args,
 		"--config", configPath,
 		"--format", "json",
-		"--no-eslintrc", // Don't load user's .eslintrc
 	)
+	if !mergeProject {
+		args = append(args, "--no-eslintrc") // Don't load user's .eslintrc
+	}
 	args = append(args, files...)
 
 	return eslintCmd, args
 }
 
+// findProjectConfig returns the project's legacy ESLint config file, or "".
+func findProjectConfig() string {
+	return linter.FindProjectFile(
+		".eslintrc.js", ".eslintrc.cjs", ".eslintrc.yaml", ".eslintrc.yml", ".eslintrc.json", ".eslintrc",
+	)
+}
+
+// stripParser removes parser settings from the generated config so the
+// project's parser configuration applies in merge mode.
+func stripParser(config []byte) ([]byte, error) {
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, err
+	}
+	delete(cfg, "parser")
+	delete(cfg, "parserOptions")
+	return json.Marshal(cfg)
+}
+
+// configuredRules returns the rule names enabled by the generated config.
+func configuredRules(config []byte) map[string]bool {
+	var cfg struct {
+		Rules map[string]interface{} `json:"rules"`
+	}
+	_ = json.Unmarshal(config, &cfg)
+
+	rules := make(map[string]bool, len(cfg.Rules))
+	for name := range cfg.Rules {
+		rules[name] = true
+	}
+	return rules
+}
+
+// filterMessages drops messages of rules not in the generated config, which
+// come from the project's own config in merge mode. Messages without a rule
+// (parse errors) are kept.
+func filterMessages(stdout string, rules map[string]bool) (string, error) {
+	if stdout == "" || stdout == "[]" {
+		return stdout, nil
+	}
+
+	var results ESLintOutput
+	if err := json.Unmarshal([]byte(stdout), &results); err != nil {
+		return "", fmt.Errorf("failed to parse ESLint output: %w", err)
+	}
+
+	for i := range results {
+		kept := results[i].Messages[:0]
+		for _, msg := range results[i].Messages {
+			if msg.RuleID == "" || rules[msg.RuleID] {
+				kept = append(kept, msg)
+			}
+		}
+		results[i].Messages = kept
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // writeConfigFile writes ESLint config to a temp file.
 func (l *Linter) writeConfigFile(config []byte) (string, error) {
 	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
@@ -72,7 +72,7 @@ func TestGetExecutionArgs(t *testing.T) {
 	configPath := "/tmp/config.json"
 	files := []string{"file1.js", "file2.js"}
 
-	cmd, args := a.getExecutionArgs(configPath, files)
+	cmd, args := a.getExecutionArgs(configPath, files, false)
 
 	if cmd == "" {
 		t.Error("Expected non-empty command")
@@ -198,3 +198,37 @@ func TestParseOutput_InvalidJSON(t *testing.T) {
 		t.Error("Expected error for invalid JSON")
 	}
 }
+
+func TestFilterMessages(t *testing.T) {
+	stdout := `[{"filePath":"/app/a.ts","messages":[
+		{"ruleId":"max-len","severity":2,"message":"too long","line":1,"column":1},
+		{"ruleId":"react/jsx-key","severity":2,"message":"missing key","line":2,"column":1},
+		{"ruleId":null,"severity":2,"message":"Parsing error","line":3,"column":1}
+	]}]`
+
+	filtered, err := filterMessages(stdout, configuredRules([]byte(`{"rules":{"max-len":["error",{"code":100}]}}`)))
+	if err != nil {
+		t.Fatalf("filterMessages() error = %v", err)
+	}
+
+	violations, err := parseOutput(&linter.ToolOutput{Stdout: filtered})
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("got %d violations, want 2 (project rule dropped)", len(violations))
+	}
+	if violations[0].RuleID != "max-len" || violations[1].Message != "Parsing error" {
+		t.Errorf("violations = %+v", violations)
+	}
+}
+
+func TestStripParser(t *testing.T) {
+	config, err := stripParser([]byte(`{"parser":"@typescript-eslint/parser","parserOptions":{"ecmaVersion":2020},"rules":{"semi":"error"}}`))
+	if err != nil {
+		t.Fatalf("stripParser() error = %v", err)
+	}
+	if string(config) != `{"rules":{"semi":"error"}}` {
+		t.Errorf("stripParser() = %s", config)
+	}
+}
@@ -11,8 +11,13 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Fixer       = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
 
 // Linter wraps ESLint for JavaScript/TypeScript validation.
 //
@@ -61,43 +66,23 @@ func (l *Linter) GetCapabilities() linter.Capabilities {
 }
 
 // CheckAvailability checks if ESLint and TypeScript parser are installed.
+// ESLint may come from the project, PATH or the tools dir; the parser is
+// loaded by the generated config from the tools dir, except in merge mode
+// where the project's parser settings are used.
 func (l *Linter) CheckAvailability(ctx context.Context) error {
-	eslintPath := l.getESLintPath()
-	parserPath := l.getTypeScriptParserPath()
-
-	// Check local installation (both ESLint and TypeScript parser required)
-	eslintExists := false
-	parserExists := false
-
-	if _, err := os.Stat(eslintPath); err == nil {
-		eslintExists = true
-	}
-	if _, err := os.Stat(parserPath); err == nil {
-		parserExists = true
+	tool := l.resolveESLint()
+	if !tool.Found() {
+		return fmt.Errorf("eslint not found (checked: project node_modules, global PATH and %s)", l.getESLintPath())
 	}
 
-	// If both exist locally, we're good
-	if eslintExists && parserExists {
+	if linter.ProjectConfigMerge(ctx) && findProjectConfig() != "" {
 		return nil
 	}
 
-	// Try global ESLint installation
-	if !eslintExists {
-		cmd := exec.CommandContext(ctx, "eslint", "--version")
-		if err := cmd.Run(); err == nil {
-			eslintExists = true
-		}
-	}
-
-	// If ESLint exists but parser doesn't, need to install
-	if eslintExists && !parserExists {
+	if _, err := os.Stat(l.getTypeScriptParserPath()); err != nil {
 		return fmt.Errorf("@typescript-eslint/parser not found (required for TypeScript support)")
 	}
 
-	if !eslintExists {
-		return fmt.Errorf("eslint not found (checked: %s and global PATH)", eslintPath)
-	}
-
 	return nil
 }
 
@@ -114,10 +99,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Determine version
-	version := config.Version
-	if version == "" {
-		version = "^8.0.0" // Default to ESLint 8.x
-	}
+	version := config.ToolVersion(l.Name(), "^8.0.0") // Default to ESLint 8.x
 
 	// Initialize package.json if needed
 	packageJSON := filepath.Join(l.ToolsDir, "package.json")
@@ -128,8 +110,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Install ESLint and TypeScript parser
-	l.executor.WorkDir = l.ToolsDir
-	_, err := l.executor.Execute(ctx, "npm", "install", fmt.Sprintf("eslint@%s", version), "@typescript-eslint/parser")
+	_, err := l.executor.Run(ctx, "npm", []string{"install", fmt.Sprintf("eslint@%s", version), "@typescript-eslint/parser"}, linter.WithWorkDir(l.ToolsDir))
 	if err != nil {
 		return fmt.Errorf("npm install failed: %w", err)
 	}
@@ -137,12 +118,33 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	return nil
 }
 
+// Locate reports the ESLint installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolveESLint(), "--version")
+}
+
+// Uninstall removes ESLint and the TypeScript parser from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.NpmUninstall(ctx, l.ToolsDir, "eslint", "@typescript-eslint/parser")
+}
+
 // Execute runs ESLint with the given config and files.
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
 	// Implementation in executor.go
 	return l.execute(ctx, config, files)
 }
 
+// RuleConfig keeps only the given rules of the .eslintrc.json config.
+func (l *Linter) RuleConfig(config []byte, ruleIDs []string) ([]byte, error) {
+	return linter.KeepJSONRules(config, ruleIDs)
+}
+
+// Fix runs ESLint with --fix, rewriting files in place.
+func (l *Linter) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.executeFix(ctx, config, files)
+}
+
 // ParseOutput converts ESLint JSON output to violations.
 func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
 	// Implementation in parser.go
@@ -0,0 +1,101 @@
+package linter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Examples returns a rule's good and bad examples: the structured examples
+// field when it has snippets, otherwise the example text split by ParseExamples.
+func Examples(rule schema.UserRule) schema.RuleExamples {
+	if rule.Examples != nil && (len(rule.Examples.Good) > 0 || len(rule.Examples.Bad) > 0) {
+		return *rule.Examples
+	}
+	return ParseExamples(rule.Example)
+}
+
+// ParseExamples splits a rule's example text into good and bad snippets.
+//
+// Examples follow the policy template convention, where a marker line starts
+// each snippet:
+//
+//	// ✅ 좋은 예:
+//	...
+//	// ❌ 나쁜 예:
+//	...
+//
+// "Good"/"Bad" and "Correct"/"Incorrect" markers are also recognized.
+// Text without markers yields no examples.
+func ParseExamples(example string) schema.RuleExamples {
+	var examples schema.RuleExamples
+	var current *[]string
+	var lines []string
+
+	flush := func() {
+		if current != nil {
+			if code := strings.TrimSpace(strings.Join(lines, "\n")); code != "" {
+				*current = append(*current, code)
+			}
+		}
+		lines = nil
+	}
+
+	for _, line := range strings.Split(example, "\n") {
+		switch exampleMarker(line) {
+		case "good":
+			flush()
+			current = &examples.Good
+		case "bad":
+			flush()
+			current = &examples.Bad
+		default:
+			lines = append(lines, line)
+		}
+	}
+	flush()
+
+	return examples
+}
+
+var (
+	goodMarker = regexp.MustCompile(`^(?i:good|correct|compliant)( (?i:example|code))?s?$|^좋은 예`)
+	badMarker  = regexp.MustCompile(`^(?i:bad|incorrect|wrong|non-compliant)( (?i:example|code))?s?$|^나쁜 예`)
+)
+
+// exampleMarker returns "good" or "bad" for a marker line, or "".
+func exampleMarker(line string) string {
+	trimmed := strings.TrimSpace(line)
+	if strings.Contains(trimmed, "✅") {
+		return "good"
+	}
+	if strings.Contains(trimmed, "❌") {
+		return "bad"
+	}
+
+	// Strip comment syntax: "// Good:", "# Bad example", "<!-- Good -->"
+	text := strings.TrimLeft(trimmed, "/#*<!- ")
+	text = strings.TrimRight(text, ":->* ")
+	switch {
+	case goodMarker.MatchString(text):
+		return "good"
+	case badMarker.MatchString(text):
+		return "bad"
+	}
+	return ""
+}
+
+// ExampleLanguage returns the language a rule's examples are written in:
+// the structured examples field's language when set, otherwise the first of
+// the given candidate languages (typically the rule's languages the engine
+// supports). Returns "" when neither is known.
+func ExampleLanguage(rule schema.UserRule, candidates []string) string {
+	if rule.Examples != nil && rule.Examples.Language != "" {
+		return rule.Examples.Language
+	}
+	if len(candidates) > 0 {
+		return candidates[0]
+	}
+	return ""
+}
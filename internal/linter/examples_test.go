@@ -0,0 +1,74 @@
+package linter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+func TestParseExamples(t *testing.T) {
+	tests := []struct {
+		name    string
+		example string
+		want    schema.RuleExamples
+	}{
+		{
+			name:    "template markers",
+			example: "// ✅ 좋은 예:\nconst a = 1;\n\n// ❌ 나쁜 예: var 사용\nvar a = 1;",
+			want:    schema.RuleExamples{Good: []string{"const a = 1;"}, Bad: []string{"var a = 1;"}},
+		},
+		{
+			name:    "english markers",
+			example: "Bad:\nx == None\nGood example:\nx is None\n# Bad\nNone == x",
+			want:    schema.RuleExamples{Good: []string{"x is None"}, Bad: []string{"x == None", "None == x"}},
+		},
+		{
+			name:    "code lines are not markers",
+			example: "// Good\nbadge := newBadge()\ngood := true",
+			want:    schema.RuleExamples{Good: []string{"badge := newBadge()\ngood := true"}},
+		},
+		{
+			name:    "no markers",
+			example: "use const instead of var",
+			want:    schema.RuleExamples{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseExamples(tt.example); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseExamples() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExamples_PrefersStructuredField(t *testing.T) {
+	rule := schema.UserRule{
+		Example:  "// Good\nconst a = 1;",
+		Examples: &schema.RuleExamples{Bad: []string{"var a = 1;"}},
+	}
+	if got := Examples(rule); !reflect.DeepEqual(got, *rule.Examples) {
+		t.Errorf("Examples() = %#v, want structured examples", got)
+	}
+
+	rule.Examples = &schema.RuleExamples{}
+	want := schema.RuleExamples{Good: []string{"const a = 1;"}}
+	if got := Examples(rule); !reflect.DeepEqual(got, want) {
+		t.Errorf("Examples() = %#v, want %#v", got, want)
+	}
+}
+
+func TestExampleLanguage(t *testing.T) {
+	rule := schema.UserRule{Examples: &schema.RuleExamples{Language: "typescript"}}
+	if got := ExampleLanguage(rule, []string{"javascript"}); got != "typescript" {
+		t.Errorf("ExampleLanguage() = %q, want typescript", got)
+	}
+	if got := ExampleLanguage(schema.UserRule{}, []string{"javascript", "typescript"}); got != "javascript" {
+		t.Errorf("ExampleLanguage() = %q, want javascript", got)
+	}
+	if got := ExampleLanguage(schema.UserRule{}, nil); got != "" {
+		t.Errorf("ExampleLanguage() = %q, want empty", got)
+	}
+}
@@ -0,0 +1,483 @@
+package goanalysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// AnalyzerSpec is a parameterized analyzer of the library.
+//
+// New builds a fresh *analysis.Analyzer (Run only; see Analyzer) for one
+// rule's parameters, so rules using the same analyzer with different
+// parameters never share state.
+type AnalyzerSpec struct {
+	Name   string
+	Doc    string
+	Params []Param
+	New    func(params Params) *analysis.Analyzer
+}
+
+// library holds the analyzers, keyed by name.
+var library = map[string]*AnalyzerSpec{
+	"ctxfirst": {
+		Name: "ctxfirst",
+		Doc:  "a context parameter must be the first parameter of functions and methods",
+		Params: []Param{
+			{Name: "type", Kind: ParamString, Default: "context.Context", Doc: "qualified context type (import path + name)"},
+		},
+		New: newCtxFirst,
+	},
+	"errwrap": {
+		Name: "errwrap",
+		Doc:  "errors passed to error-formatting calls must be wrapped with %w, not %v or %s",
+		Params: []Param{
+			{Name: "funcs", Kind: ParamList, Default: "fmt.Errorf", Doc: "formatting functions whose first argument is the format"},
+		},
+		New: newErrWrap,
+	},
+	"noinit": {
+		Name: "noinit",
+		Doc:  "init() functions are not allowed outside the allowed packages",
+		Params: []Param{
+			{Name: "allowPackages", Kind: ParamList, Default: "main", Doc: "package names that may declare init()"},
+			{Name: "allowTests", Kind: ParamBool, Default: "true", Doc: "allow init() in _test.go files"},
+		},
+		New: newNoInit,
+	},
+	"forbiddencall": {
+		Name: "forbiddencall",
+		Doc:  "calls to the listed functions are forbidden (e.g., fmt.Println, os.Exit, panic)",
+		Params: []Param{
+			{Name: "funcs", Kind: ParamList, Required: true, Doc: "functions as pkg.Func (import path or last path element) or builtin/local names"},
+		},
+		New: newForbiddenCall,
+	},
+	"funclength": {
+		Name: "funclength",
+		Doc:  "function bodies must not exceed a number of lines",
+		Params: []Param{
+			{Name: "max", Kind: ParamInt, Default: "60", Doc: "max body lines"},
+		},
+		New: newFuncLength,
+	},
+	"maxparams": {
+		Name: "maxparams",
+		Doc:  "functions must not declare more than a number of parameters",
+		Params: []Param{
+			{Name: "max", Kind: ParamInt, Default: "5", Doc: "max parameters"},
+		},
+		New: newMaxParams,
+	},
+	"errprefix": {
+		Name: "errprefix",
+		Doc:  "package-level sentinel errors (errors.New, fmt.Errorf) must be named with a prefix",
+		Params: []Param{
+			{Name: "prefix", Kind: ParamString, Default: "Err", Doc: "name prefix; unexported errors use it lowercased"},
+		},
+		New: newErrPrefix,
+	},
+}
+
+// Analyzer builds the analyzer for one rule's validated parameters.
+func (s *AnalyzerSpec) Analyzer(params Params) *analysis.Analyzer {
+	a := s.New(params)
+	a.Name = s.Name
+	a.Doc = s.Doc
+	return a
+}
+
+// Lookup returns the analyzer spec with the given name.
+func Lookup(name string) (*AnalyzerSpec, bool) {
+	spec, ok := library[name]
+	return spec, ok
+}
+
+// AnalyzerNames returns the sorted analyzer names.
+func AnalyzerNames() []string {
+	names := make([]string, 0, len(library))
+	for name := range library {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ===== Analyzers =====
+
+func newCtxFirst(params Params) *analysis.Analyzer {
+	want := params.String("type")
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				imports := importNames(file)
+				ast.Inspect(file, func(n ast.Node) bool {
+					var ft *ast.FuncType
+					name := "function literal"
+					switch fn := n.(type) {
+					case *ast.FuncDecl:
+						ft, name = fn.Type, fn.Name.Name
+					case *ast.FuncLit:
+						ft = fn.Type
+					default:
+						return true
+					}
+					for i, field := range flattenParams(ft.Params) {
+						if i > 0 && qualifiedType(imports, field.Type) == want {
+							pass.Reportf(field.Type.Pos(), "%s should be the first parameter of %s", path.Base(want), name)
+							break
+						}
+					}
+					return true
+				})
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newErrWrap(params Params) *analysis.Analyzer {
+	funcs := params.List("funcs")
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				imports := importNames(file)
+				ast.Inspect(file, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok || len(call.Args) < 2 || !matchesFunc(funcs, calleeNames(pass, imports, call.Fun)) {
+						return true
+					}
+					lit, ok := call.Args[0].(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						return true
+					}
+					format, err := strconv.Unquote(lit.Value)
+					if err != nil {
+						return true
+					}
+					verbs, ok := formatVerbs(format)
+					if !ok {
+						return true
+					}
+					for i, arg := range call.Args[1:] {
+						if i < len(verbs) && verbs[i] != 'w' && isError(pass, arg) {
+							pass.Reportf(arg.Pos(), "error is formatted with %%%c; wrap it with %%w", verbs[i])
+						}
+					}
+					return true
+				})
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newNoInit(params Params) *analysis.Analyzer {
+	allowed := params.List("allowPackages")
+	allowTests := params.Bool("allowTests")
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				pkg := file.Name.Name
+				if containsString(allowed, pkg) {
+					continue
+				}
+				if allowTests && strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
+					continue
+				}
+				for _, decl := range file.Decls {
+					if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "init" {
+						pass.Reportf(fn.Name.Pos(), "init() is not allowed in package %s", pkg)
+					}
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newForbiddenCall(params Params) *analysis.Analyzer {
+	funcs := params.List("funcs")
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				imports := importNames(file)
+				ast.Inspect(file, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+					names := calleeNames(pass, imports, call.Fun)
+					if matchesFunc(funcs, names) {
+						pass.Reportf(call.Pos(), "call to %s is forbidden", names[len(names)-1])
+					}
+					return true
+				})
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newFuncLength(params Params) *analysis.Analyzer {
+	limit := params.Int("max")
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				for _, decl := range file.Decls {
+					fn, ok := decl.(*ast.FuncDecl)
+					if !ok || fn.Body == nil {
+						continue
+					}
+					lines := pass.Fset.Position(fn.Body.Rbrace).Line - pass.Fset.Position(fn.Body.Lbrace).Line - 1
+					if lines > limit {
+						pass.Reportf(fn.Name.Pos(), "function %s is %d lines long (max %d)", fn.Name.Name, lines, limit)
+					}
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newMaxParams(params Params) *analysis.Analyzer {
+	limit := params.Int("max")
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				for _, decl := range file.Decls {
+					fn, ok := decl.(*ast.FuncDecl)
+					if !ok {
+						continue
+					}
+					if n := len(flattenParams(fn.Type.Params)); n > limit {
+						pass.Reportf(fn.Name.Pos(), "function %s has %d parameters (max %d)", fn.Name.Name, n, limit)
+					}
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+func newErrPrefix(params Params) *analysis.Analyzer {
+	prefix := params.String("prefix")
+	unexported := prefix
+	if prefix != "" {
+		unexported = strings.ToLower(prefix[:1]) + prefix[1:]
+	}
+	return &analysis.Analyzer{
+		Run: func(pass *analysis.Pass) (any, error) {
+			for _, file := range pass.Files {
+				imports := importNames(file)
+				for _, decl := range file.Decls {
+					gen, ok := decl.(*ast.GenDecl)
+					if !ok || gen.Tok != token.VAR {
+						continue
+					}
+					for _, s := range gen.Specs {
+						vs := s.(*ast.ValueSpec)
+						for i, name := range vs.Names {
+							if i >= len(vs.Values) || name.Name == "_" || !isErrorConstructor(pass, imports, vs.Values[i]) {
+								continue
+							}
+							want := unexported
+							if name.IsExported() {
+								want = prefix
+							}
+							if !strings.HasPrefix(name.Name, want) {
+								pass.Reportf(name.Pos(), "error variable %s should be named %s...", name.Name, want)
+							}
+						}
+					}
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+// ===== Helpers =====
+
+// importNames maps the local names of a file's imports to import paths.
+func importNames(file *ast.File) map[string]string {
+	names := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := packageName(p)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		names[name] = p
+	}
+	return names
+}
+
+// packageName guesses the package name of an import path from its last
+// element: "gopkg.in/yaml.v3" → yaml, "github.com/x/go-cmp/v2" → cmp.
+func packageName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+	if i := strings.Index(name, ".v"); i > 0 {
+		name = name[:i]
+	}
+	name = strings.TrimPrefix(name, "go-")
+	name = strings.TrimSuffix(strings.TrimSuffix(name, "-go"), ".go")
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// qualifiedType returns "importpath.Name" for a package-qualified type expression.
+func qualifiedType(imports map[string]string, expr ast.Expr) string {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || imports[pkg.Name] == "" {
+		return ""
+	}
+	return imports[pkg.Name] + "." + sel.Sel.Name
+}
+
+// calleeNames returns the names a call target may be configured by: for
+// package functions "path/pkg.Func" and "pkg.Func", otherwise the identifier.
+// The last name is the one used in messages.
+func calleeNames(pass *analysis.Pass, imports map[string]string, fun ast.Expr) []string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return []string{f.Name}
+	case *ast.SelectorExpr:
+		pkg, ok := f.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		if _, local := pass.TypesInfo.Uses[pkg].(*types.Var); local {
+			return nil // method call on a variable that shadows the import
+		}
+		if importPath, ok := imports[pkg.Name]; ok {
+			return []string{importPath + "." + f.Sel.Name, packageName(importPath) + "." + f.Sel.Name}
+		}
+	}
+	return nil
+}
+
+// matchesFunc reports whether any callee name is configured.
+func matchesFunc(funcs, names []string) bool {
+	for _, name := range names {
+		if containsString(funcs, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isErrorConstructor reports whether expr calls errors.New or fmt.Errorf.
+func isErrorConstructor(pass *analysis.Pass, imports map[string]string, expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	return matchesFunc([]string{"errors.New", "fmt.Errorf"}, calleeNames(pass, imports, call.Fun))
+}
+
+// isError reports whether expr is an error value. Imported packages are not
+// type-checked, so untyped expressions fall back to the err naming convention.
+func isError(pass *analysis.Pass, expr ast.Expr) bool {
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Type != nil && tv.Type != types.Typ[types.Invalid] {
+		return types.Implements(tv.Type, errorType)
+	}
+
+	var name string
+	switch e := expr.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		name = e.Sel.Name
+	default:
+		return false
+	}
+	return name == "err" || strings.HasSuffix(name, "Err") || strings.HasSuffix(name, "err")
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// formatVerbs returns the verbs of a printf format in argument order.
+// Returns false for formats with explicit argument indexes.
+func formatVerbs(format string) ([]rune, bool) {
+	var verbs []rune
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '%' {
+			continue
+		}
+		i++
+		for i < len(runes) && strings.ContainsRune("+-# 0123456789.*[]", runes[i]) {
+			if runes[i] == '[' {
+				return nil, false
+			}
+			if runes[i] == '*' {
+				verbs = append(verbs, '*') // width/precision argument
+			}
+			i++
+		}
+		if i < len(runes) && runes[i] != '%' {
+			verbs = append(verbs, runes[i])
+		}
+	}
+	return verbs, true
+}
+
+// flattenParams returns one field per declared parameter.
+func flattenParams(list *ast.FieldList) []*ast.Field {
+	if list == nil {
+		return nil
+	}
+	var params []*ast.Field
+	for _, field := range list.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			params = append(params, field)
+		}
+	}
+	return params
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// describe returns a one-line description of an analyzer and its params for prompts and docs.
+func (s *AnalyzerSpec) describe() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("- %q: %s", s.Name, s.Doc))
+	for _, p := range s.Params {
+		sb.WriteString(fmt.Sprintf("\n    param %q (%s", p.Name, p.Kind))
+		if p.Required {
+			sb.WriteString(", required")
+		} else if p.Default != "" {
+			sb.WriteString(fmt.Sprintf(", default %q", p.Default))
+		}
+		sb.WriteString(fmt.Sprintf("): %s", p.Doc))
+	}
+	return sb.String()
+}
@@ -0,0 +1,267 @@
+package goanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+// Converter maps Go conventions onto library analyzers and their params using LLM.
+type Converter struct{}
+
+// NewConverter creates a new goanalysis converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return Name
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"go"}
+}
+
+// GetLLMDescription returns a description of the goanalysis engine for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Built-in Go analysis engine (in-process go/analysis analyzers, Go only, no download)
+  - CAN: context.Context must be the first parameter, errors must be wrapped with %w in fmt.Errorf,
+         no init() outside main packages, forbidden function calls (fmt.Println, os.Exit, panic),
+         max function length, max parameter count, sentinel error naming (ErrXxx)
+  - CANNOT: Other languages, rules outside its analyzer library, formatting, business logic`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use the goanalysis engine
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For Go conventions about function signatures, error wrapping, init() or forbidden calls → use sym-goanalysis",
+		"Prefer sym-goanalysis over llm-validator for Go rules it has an analyzer for; results are precise and deterministic",
+		"Use golangci-lint for Go rules matching one of its built-in linters (errcheck, gocyclo, revive rules)",
+	}
+}
+
+// goanalysisRuleData holds one generated analyzer rule
+type goanalysisRuleData struct {
+	Rule Rule
+}
+
+// llmRule is the LLM's answer; params may be numbers, booleans or lists.
+type llmRule struct {
+	Analyzer string                 `json:"analyzer"`
+	Params   map[string]interface{} `json:"params,omitempty"`
+	Message  string                 `json:"message,omitempty"`
+}
+
+// ConvertSingleRule converts ONE user rule to an analyzer rule.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if no analyzer of the library checks the rule (skip),
+//	(nil, error) on actual conversion error.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var answer llmRule
+	if err := json.Unmarshal([]byte(response), &answer); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+	if answer.Analyzer == "" {
+		return nil, nil
+	}
+
+	answer.Params = applyParams(answer.Analyzer, answer.Params, rule.Params)
+	return analyzerResult(rule, answer)
+}
+
+// RuleParams returns the parameters of all analyzers in the library; a rule's
+// params override those of the analyzer the LLM selects.
+func (c *Converter) RuleParams() []linter.RuleParam {
+	var params []linter.RuleParam
+	seen := make(map[string]bool)
+	for _, name := range AnalyzerNames() {
+		spec, _ := Lookup(name)
+		for _, p := range spec.Params {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				params = append(params, linter.RuleParam{Name: p.Name, Kind: p.Kind, Doc: p.Doc})
+			}
+		}
+	}
+	return params
+}
+
+// applyParams sets the rule params the analyzer accepts over the LLM's values.
+func applyParams(analyzer string, params, ruleParams map[string]interface{}) map[string]interface{} {
+	spec, ok := Lookup(analyzer)
+	if !ok || len(ruleParams) == 0 {
+		return params
+	}
+	result := make(map[string]interface{}, len(params)+len(ruleParams))
+	for name, value := range params {
+		result[name] = value
+	}
+	for _, p := range spec.Params {
+		if value, ok := ruleParams[p.Name]; ok {
+			result[p.Name] = value
+		}
+	}
+	return result
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[goanalysisRuleData](data)
+}
+
+// ConvertNative selects an analyzer with its parameters: {"maxparams": {"max": 4}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	analyzer, params, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+	return analyzerResult(rule, llmRule{Analyzer: analyzer, Params: params})
+}
+
+// analyzerResult builds and validates the analyzer rule of a user rule.
+func analyzerResult(rule schema.UserRule, answer llmRule) (*linter.SingleRuleResult, error) {
+	spec := Rule{
+		ID:       rule.ID,
+		Analyzer: answer.Analyzer,
+		Params:   stringParams(answer.Params),
+		Message:  answer.Message,
+		Severity: rule.Severity,
+	}
+	if rule.Message != "" {
+		spec.Message = rule.Message
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          goanalysisRuleData{Rule: spec},
+		NativeRuleIDs: []string{rule.ID},
+	}, nil
+}
+
+// BuildConfig assembles sym-goanalysis.json from successful conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	cfg := Config{Rules: []Rule{}}
+	for _, r := range results {
+		data, ok := r.Data.(goanalysisRuleData)
+		if !ok {
+			continue
+		}
+		cfg.Rules = append(cfg.Rules, data.Rule)
+	}
+	if len(cfg.Rules) == 0 {
+		return nil, nil
+	}
+
+	content, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: "sym-goanalysis.json",
+		Content:  content,
+		Format:   "json",
+	}, nil
+}
+
+// buildPrompt builds the analyzer selection prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	var sb strings.Builder
+
+	sb.WriteString(`You are a Go static analysis expert. Map the natural language coding rule to ONE analyzer of this library and its params.
+
+Available analyzers:
+`)
+	for _, name := range AnalyzerNames() {
+		spec, _ := Lookup(name)
+		sb.WriteString(spec.describe())
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(`
+Params are strings; lists are comma-separated. Omit params to use the defaults. "message" optionally explains the violation.
+
+Return ONLY a JSON object (no markdown fences), for example:
+{"analyzer": "ctxfirst"}
+{"analyzer": "forbiddencall", "params": {"funcs": "fmt.Println,fmt.Printf"}, "message": "Use the structured logger"}
+{"analyzer": "funclength", "params": {"max": "40"}}
+
+If no analyzer checks the rule, return:
+{"analyzer": ""}`)
+
+	sb.WriteString(fmt.Sprintf("\n\nConvert this rule:\n\n%s", rule.Say))
+	sb.WriteString(linter.ParamsPrompt(rule.Params))
+	if len(rule.Languages) > 0 {
+		sb.WriteString(fmt.Sprintf("\nLanguages: %s", strings.Join(rule.Languages, ", ")))
+	}
+	if rule.Example != "" {
+		sb.WriteString(fmt.Sprintf("\nExample:\n%s", rule.Example))
+	}
+	return sb.String()
+}
+
+// stringParams converts JSON param values to their string form.
+func stringParams(values map[string]interface{}) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	params := make(map[string]string, len(values))
+	for name, value := range values {
+		params[name] = paramString(value)
+	}
+	return params
+}
+
+func paramString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, paramString(item))
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,128 @@
+package goanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider is a mock LLM provider for testing
+type mockProvider struct {
+	response string
+}
+
+func (m *mockProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string {
+	return "mock"
+}
+
+func (m *mockProvider) Close() error {
+	return nil
+}
+
+func TestConverter_ConvertSingleRule(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{
+		ID:        "GO-1",
+		Say:       "Functions must have at most 4 parameters",
+		Languages: []string{"go"},
+		Severity:  "warning",
+	}
+	provider := &mockProvider{response: `{"analyzer": "maxparams", "params": {"max": 4}, "message": "Group parameters into a struct"}`}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, provider)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, []string{"GO-1"}, result.NativeRuleIDs)
+
+	data := result.Data.(goanalysisRuleData)
+	assert.Equal(t, Rule{
+		ID:       "GO-1",
+		Analyzer: "maxparams",
+		Params:   map[string]string{"max": "4"},
+		Message:  "Group parameters into a struct",
+		Severity: "warning",
+	}, data.Rule)
+
+	config, err := c.BuildConfig([]*linter.SingleRuleResult{result})
+	require.NoError(t, err)
+	assert.Equal(t, "sym-goanalysis.json", config.Filename)
+	parsed, err := ParseConfig(config.Content)
+	require.NoError(t, err)
+	assert.Equal(t, data.Rule, parsed.Rules[0])
+}
+
+func TestConverter_ConvertSingleRule_ListAndBoolParams(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "GO-2", Say: "Only main may use init, also in tests"}
+	provider := &mockProvider{response: `{"analyzer": "noinit", "params": {"allowPackages": ["main", "tools"], "allowTests": false}}`}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, provider)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"allowPackages": "main,tools", "allowTests": "false"}, result.Data.(goanalysisRuleData).Rule.Params)
+}
+
+func TestConverter_ConvertSingleRule_SkipAndInvalid(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "R1", Say: "Use meaningful names"}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `{"analyzer": ""}`})
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `{"analyzer": "forbiddencall"}`})
+	assert.ErrorContains(t, err, "param funcs is required")
+}
+
+func TestConverter_BuildPromptListsLibrary(t *testing.T) {
+	prompt := NewConverter().buildPrompt(schema.UserRule{Say: "No init functions"})
+	for _, name := range AnalyzerNames() {
+		assert.Contains(t, prompt, name)
+	}
+	assert.Contains(t, prompt, "No init functions")
+}
+
+func TestConverter_ConvertNative(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "GO-1", Say: "Functions must have at most 4 parameters", Severity: "warning"}
+
+	result, err := c.ConvertNative(context.Background(), rule, json.RawMessage(`{"maxparams": {"max": 4}}`))
+	require.NoError(t, err)
+	assert.Equal(t, Rule{
+		ID:       "GO-1",
+		Analyzer: "maxparams",
+		Params:   map[string]string{"max": "4"},
+		Severity: "warning",
+	}, result.Data.(goanalysisRuleData).Rule)
+
+	_, err = c.ConvertNative(context.Background(), rule, json.RawMessage(`{"forbiddencall": null}`))
+	assert.ErrorContains(t, err, "param funcs is required")
+}
+
+func TestConverter_ConvertSingleRule_Params(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{
+		ID:     "GO-2",
+		Say:    "Functions must have at most 3 parameters",
+		Params: map[string]any{"max": float64(3), "funcs": "fmt.Println"},
+	}
+	provider := &mockProvider{response: `{"analyzer": "maxparams", "params": {"max": 4}}`}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, provider)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	// max overrides the LLM's value; funcs belongs to other analyzers
+	assert.Equal(t, map[string]string{"max": "3"}, result.Data.(goanalysisRuleData).Rule.Params)
+
+	assert.NoError(t, linter.ValidateParams(rule.Params, c.RuleParams()))
+	assert.Error(t, linter.ValidateParams(map[string]any{"maximum": 3}, c.RuleParams()))
+}
@@ -0,0 +1,247 @@
+package goanalysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Check runs the rules' analyzers over the Go files, resolved relative to root.
+//
+// Each target file is analyzed together with the other files of its package
+// directory, so package-local types are known. Imported packages are not
+// loaded: the engine needs no Go toolchain, module cache or network, and
+// analyzers fall back to syntax where imported types would be needed.
+// Only diagnostics in the target files are reported.
+func Check(cfg *Config, root string, files []string) ([]linter.Violation, error) {
+	analyzers := make([]*analysis.Analyzer, len(cfg.Rules))
+	for i := range cfg.Rules {
+		rule := &cfg.Rules[i]
+		spec, ok := Lookup(rule.Analyzer)
+		if !ok {
+			return nil, fmt.Errorf("goanalysis rule %s: unknown analyzer %q", rule.ID, rule.Analyzer)
+		}
+		params, err := spec.params(rule.Params)
+		if err != nil {
+			return nil, fmt.Errorf("goanalysis rule %s: %w", rule.ID, err)
+		}
+		analyzers[i] = spec.Analyzer(params)
+	}
+	if err := analysis.Validate(analyzers); err != nil {
+		return nil, fmt.Errorf("invalid analyzers: %w", err)
+	}
+
+	var violations []linter.Violation
+	for _, pkg := range loadPackages(root, files) {
+		for i, a := range analyzers {
+			diagnostics, err := pkg.run(a)
+			if err != nil {
+				return nil, fmt.Errorf("analyzer %s failed in %s: %w", a.Name, pkg.path, err)
+			}
+			for _, d := range diagnostics {
+				pos := pkg.fset.Position(d.Pos)
+				if file, ok := pkg.targets[pos.Filename]; ok {
+					violations = append(violations, cfg.Rules[i].violation(file, pos, d.Message))
+				}
+			}
+		}
+	}
+	return violations, nil
+}
+
+// pkg is the parsed and type-checked files of one package.
+type pkg struct {
+	path    string
+	fset    *token.FileSet
+	files   []*ast.File
+	types   *types.Package
+	info    *types.Info
+	targets map[string]string // parsed file name -> target path as given
+}
+
+// loadPackages parses the packages containing the target .go files.
+func loadPackages(root string, files []string) []*pkg {
+	// Target files by directory
+	dirs := make(map[string]map[string]string)
+	for _, file := range files {
+		if filepath.Ext(file) != ".go" {
+			continue
+		}
+		abs := file
+		if !filepath.IsAbs(abs) {
+			abs = filepath.Join(root, file)
+		}
+		dir := filepath.Dir(abs)
+		if dirs[dir] == nil {
+			dirs[dir] = make(map[string]string)
+		}
+		dirs[dir][abs] = file
+	}
+
+	var pkgs []*pkg
+	for _, dir := range sortedDirs(dirs) {
+		pkgs = append(pkgs, loadDir(root, dir, dirs[dir])...)
+	}
+	return pkgs
+}
+
+// loadDir parses the .go files of dir and returns the packages (e.g., foo and
+// foo_test) that contain targets. Files that fail to parse are skipped.
+func loadDir(root, dir string, targets map[string]string) []*pkg {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	byName := make(map[string]*pkg)
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".go" {
+			continue
+		}
+		filename := filepath.Join(dir, entry.Name())
+		f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+
+		p := byName[f.Name.Name]
+		if p == nil {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				rel = dir
+			}
+			p = &pkg{path: filepath.ToSlash(rel), fset: fset, targets: make(map[string]string)}
+			byName[f.Name.Name] = p
+			names = append(names, f.Name.Name)
+		}
+		p.files = append(p.files, f)
+		if target, ok := targets[filename]; ok {
+			p.targets[filename] = target
+		}
+	}
+
+	sort.Strings(names)
+	var pkgs []*pkg
+	for _, name := range names {
+		p := byName[name]
+		if len(p.targets) == 0 {
+			continue
+		}
+		p.typeCheck()
+		pkgs = append(pkgs, p)
+	}
+	return pkgs
+}
+
+// typeCheck records the package-local type information. Type errors, such as
+// unresolved members of imported packages, are expected and ignored.
+func (p *pkg) typeCheck() {
+	p.info = &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+	}
+	config := types.Config{
+		Importer:    make(stubImporter),
+		FakeImportC: true,
+		Error:       func(error) {},
+	}
+	p.types, _ = config.Check(p.path, p.fset, p.files, p.info)
+}
+
+// run runs an analyzer and its prerequisites on the package.
+func (p *pkg) run(a *analysis.Analyzer) ([]analysis.Diagnostic, error) {
+	var diagnostics []analysis.Diagnostic
+	results := make(map[*analysis.Analyzer]any)
+
+	var visit func(a *analysis.Analyzer, report bool) error
+	visit = func(a *analysis.Analyzer, report bool) error {
+		if _, done := results[a]; done {
+			return nil
+		}
+		for _, req := range a.Requires {
+			if err := visit(req, false); err != nil {
+				return err
+			}
+		}
+
+		pass := &analysis.Pass{
+			Analyzer:   a,
+			Fset:       p.fset,
+			Files:      p.files,
+			Pkg:        p.types,
+			TypesInfo:  p.info,
+			TypesSizes: types.SizesFor("gc", "amd64"),
+			ResultOf:   results,
+			ReadFile:   os.ReadFile,
+			Report: func(d analysis.Diagnostic) {
+				if report {
+					diagnostics = append(diagnostics, d)
+				}
+			},
+		}
+		result, err := a.Run(pass)
+		if err != nil {
+			return err
+		}
+		results[a] = result
+		return nil
+	}
+
+	if err := visit(a, true); err != nil {
+		return nil, err
+	}
+	return diagnostics, nil
+}
+
+// stubImporter returns empty packages for imports, named by packageName.
+type stubImporter map[string]*types.Package
+
+func (s stubImporter) Import(importPath string) (*types.Package, error) {
+	if p, ok := s[importPath]; ok {
+		return p, nil
+	}
+	p := types.NewPackage(importPath, packageName(importPath))
+	p.MarkComplete()
+	s[importPath] = p
+	return p, nil
+}
+
+func sortedDirs(m map[string]map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (r *Rule) violation(file string, pos token.Position, msg string) linter.Violation {
+	if r.Message != "" {
+		msg = fmt.Sprintf("%s (%s)", r.Message, msg)
+	}
+	severity := "error"
+	if r.Severity != "" {
+		severity = linter.MapSeverity(r.Severity)
+	}
+	return linter.Violation{
+		File:     file,
+		Line:     pos.Line,
+		Column:   pos.Column,
+		Message:  msg,
+		Severity: severity,
+		RuleID:   r.ID,
+	}
+}
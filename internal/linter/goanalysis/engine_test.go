@@ -0,0 +1,238 @@
+package goanalysis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeProject creates files under a temp dir and returns the root.
+func writeProject(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return root
+}
+
+// summary is a violation reduced to the fields tests compare.
+type summary struct {
+	File string
+	Line int
+	Rule string
+}
+
+func summarize(violations []linter.Violation) []summary {
+	var out []summary
+	for _, v := range violations {
+		out = append(out, summary{File: v.File, Line: v.Line, Rule: v.RuleID})
+	}
+	return out
+}
+
+func check(t *testing.T, files map[string]string, targets []string, rules ...Rule) []linter.Violation {
+	t.Helper()
+	root := writeProject(t, files)
+	violations, err := Check(&Config{Rules: rules}, root, targets)
+	require.NoError(t, err)
+	return violations
+}
+
+func TestCheck_CtxFirst(t *testing.T) {
+	src := `package svc
+
+import (
+	"context"
+	stdctx "context"
+)
+
+func Good(ctx context.Context, id string) {}
+
+func Bad(id string, ctx context.Context) {}
+
+func Alias(id string, ctx stdctx.Context) {}
+
+var _ = func(n int, ctx context.Context) {}
+`
+	violations := check(t, map[string]string{"svc/svc.go": src}, []string{"svc/svc.go"},
+		Rule{ID: "GO-1", Analyzer: "ctxfirst"})
+	assert.Equal(t, []summary{
+		{File: "svc/svc.go", Line: 10, Rule: "GO-1"},
+		{File: "svc/svc.go", Line: 12, Rule: "GO-1"},
+		{File: "svc/svc.go", Line: 14, Rule: "GO-1"},
+	}, summarize(violations))
+	assert.Contains(t, violations[0].Message, "context.Context should be the first parameter of Bad")
+}
+
+func TestCheck_ErrWrap(t *testing.T) {
+	src := `package svc
+
+import (
+	"errors"
+	"fmt"
+)
+
+type myErr struct{}
+
+func (myErr) Error() string { return "x" }
+
+func run(name string) error {
+	err := errors.New("boom")
+	_ = fmt.Errorf("load %s: %w", name, err)
+	_ = fmt.Errorf("load %s: %v", name, err)
+	_ = fmt.Errorf("custom: %s", myErr{})
+	_ = fmt.Errorf("count %d", 3)
+	return nil
+}
+`
+	violations := check(t, map[string]string{"svc/svc.go": src}, []string{"svc/svc.go"},
+		Rule{ID: "GO-2", Analyzer: "errwrap"})
+	assert.Equal(t, []summary{
+		{File: "svc/svc.go", Line: 15, Rule: "GO-2"},
+		{File: "svc/svc.go", Line: 16, Rule: "GO-2"},
+	}, summarize(violations))
+	assert.Contains(t, violations[0].Message, "wrap it with %w")
+}
+
+func TestCheck_NoInit(t *testing.T) {
+	files := map[string]string{
+		"cmd/app/main.go":   "package main\n\nfunc init() {}\n\nfunc main() {}\n",
+		"lib/lib.go":        "package lib\n\nfunc init() {}\n",
+		"lib/lib_test.go":   "package lib\n\nfunc init() {}\n",
+		"lib/other/type.go": "package other\n\ntype T struct{}\n\nfunc (T) init() {}\n",
+	}
+	targets := []string{"cmd/app/main.go", "lib/lib.go", "lib/lib_test.go", "lib/other/type.go"}
+
+	violations := check(t, files, targets, Rule{ID: "GO-3", Analyzer: "noinit"})
+	assert.Equal(t, []summary{{File: "lib/lib.go", Line: 3, Rule: "GO-3"}}, summarize(violations))
+
+	violations = check(t, files, targets, Rule{ID: "GO-3", Analyzer: "noinit", Params: map[string]string{"allowTests": "false"}})
+	assert.Len(t, violations, 2)
+}
+
+func TestCheck_ForbiddenCall(t *testing.T) {
+	src := `package svc
+
+import (
+	"fmt"
+	f "fmt"
+	"os"
+)
+
+func run() {
+	fmt.Println("a")
+	f.Println("b")
+	os.Exit(1)
+	fmt.Sprintf("ok")
+}
+
+func shadow() {
+	fmt := struct{ Println func(string) }{}
+	fmt.Println("not the package")
+}
+`
+	violations := check(t, map[string]string{"svc/svc.go": src}, []string{"svc/svc.go"},
+		Rule{ID: "GO-4", Analyzer: "forbiddencall", Params: map[string]string{"funcs": "fmt.Println, os.Exit"}, Message: "Use the logger"})
+	assert.Equal(t, []summary{
+		{File: "svc/svc.go", Line: 10, Rule: "GO-4"},
+		{File: "svc/svc.go", Line: 11, Rule: "GO-4"},
+		{File: "svc/svc.go", Line: 12, Rule: "GO-4"},
+	}, summarize(violations))
+	assert.Contains(t, violations[0].Message, "Use the logger")
+}
+
+func TestCheck_SizeLimits(t *testing.T) {
+	src := `package svc
+
+func short() {
+	_ = 1
+}
+
+func long() {
+	_ = 1
+	_ = 2
+	_ = 3
+}
+
+func many(a, b int, c string) {}
+`
+	violations := check(t, map[string]string{"svc/svc.go": src}, []string{"svc/svc.go"},
+		Rule{ID: "GO-5", Analyzer: "funclength", Params: map[string]string{"max": "2"}},
+		Rule{ID: "GO-6", Analyzer: "maxparams", Params: map[string]string{"max": "2"}, Severity: "warning"})
+	assert.Equal(t, []summary{
+		{File: "svc/svc.go", Line: 7, Rule: "GO-5"},
+		{File: "svc/svc.go", Line: 13, Rule: "GO-6"},
+	}, summarize(violations))
+	assert.Contains(t, violations[0].Message, "function long is 3 lines long (max 2)")
+	assert.Equal(t, "warning", violations[1].Severity)
+}
+
+func TestCheck_ErrPrefix(t *testing.T) {
+	src := `package svc
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("not found")
+	NotFound    = errors.New("not found")
+	errClosed   = errors.New("closed")
+	closed      = errors.New("closed")
+	name        = "svc"
+)
+`
+	violations := check(t, map[string]string{"svc/svc.go": src}, []string{"svc/svc.go"},
+		Rule{ID: "GO-7", Analyzer: "errprefix"})
+	assert.Equal(t, []summary{
+		{File: "svc/svc.go", Line: 7, Rule: "GO-7"},
+		{File: "svc/svc.go", Line: 9, Rule: "GO-7"},
+	}, summarize(violations))
+}
+
+func TestCheck_OnlyTargetsReported(t *testing.T) {
+	files := map[string]string{
+		"svc/a.go": "package svc\n\nfunc init() {}\n",
+		"svc/b.go": "package svc\n\nfunc init() {}\n",
+	}
+	violations := check(t, files, []string{"svc/a.go", "README.md"}, Rule{ID: "GO-3", Analyzer: "noinit"})
+	assert.Equal(t, []summary{{File: "svc/a.go", Line: 3, Rule: "GO-3"}}, summarize(violations))
+}
+
+func TestRule_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		want string
+	}{
+		{"valid", Rule{ID: "R", Analyzer: "funclength", Params: map[string]string{"max": "40"}}, ""},
+		{"missing id", Rule{Analyzer: "noinit"}, "id is required"},
+		{"unknown analyzer", Rule{ID: "R", Analyzer: "nope"}, `unknown analyzer "nope"`},
+		{"required param", Rule{ID: "R", Analyzer: "forbiddencall"}, "param funcs is required"},
+		{"bad int", Rule{ID: "R", Analyzer: "maxparams", Params: map[string]string{"max": "many"}}, "max"},
+		{"bad bool", Rule{ID: "R", Analyzer: "noinit", Params: map[string]string{"allowTests": "maybe"}}, "allowTests"},
+		{"unknown param", Rule{ID: "R", Analyzer: "noinit", Params: map[string]string{"depth": "1"}}, "unknown param(s) depth"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.want == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	assert.Equal(t, "yaml", packageName("gopkg.in/yaml.v3"))
+	assert.Equal(t, "chi", packageName("github.com/go-chi/chi/v5"))
+	assert.Equal(t, "openai", packageName("github.com/sashabaranov/go-openai"))
+	assert.Equal(t, "context", packageName("context"))
+}
@@ -0,0 +1,114 @@
+package goanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Name is the engine name used in code-policy.json.
+const Name = "sym-goanalysis"
+
+// Compile-time interface checks
+var (
+	_ linter.Linter  = (*Linter)(nil)
+	_ linter.Locator = (*Linter)(nil)
+)
+
+// Linter is the built-in Go analysis engine.
+//
+// It runs go/analysis analyzers in-process like sym-pattern. Files are
+// resolved relative to the CWD at execution time, which is the project root
+// during validation.
+type Linter struct{}
+
+// New creates a new goanalysis linter.
+func New() *Linter {
+	return &Linter{}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return Name
+}
+
+// GetCapabilities returns the goanalysis engine capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:                Name,
+		SupportedLanguages:  []string{"go"},
+		SupportedCategories: []string{"naming", "error_handling", "complexity", "pattern", "ast"},
+		Version:             "builtin",
+		FileInput:           linter.FileInputList, // in-process
+	}
+}
+
+// CheckAvailability always succeeds; the engine is built in.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	return nil
+}
+
+// Install is a no-op; the engine is built in.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	return nil
+}
+
+// Locate reports the built-in engine.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.Installation{Source: linter.ToolSourceBuiltin}, nil
+}
+
+// Execute runs the analyzers of the sym-goanalysis.json config on the files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	start := time.Now()
+
+	cfg, err := ParseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	violations, err := Check(cfg, root, files)
+	if err != nil {
+		return nil, err
+	}
+	if violations == nil {
+		violations = []linter.Violation{}
+	}
+
+	out, err := json.Marshal(violations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode violations: %w", err)
+	}
+
+	exitCode := 0
+	if len(violations) > 0 {
+		exitCode = 1
+	}
+	return &linter.ToolOutput{
+		Stdout:   string(out),
+		ExitCode: exitCode,
+		Duration: time.Since(start).String(),
+	}, nil
+}
+
+// ParseOutput decodes the violations encoded by Execute.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	if output == nil || output.Stdout == "" {
+		return nil, nil
+	}
+
+	var violations []linter.Violation
+	if err := json.Unmarshal([]byte(output.Stdout), &violations); err != nil {
+		return nil, fmt.Errorf("failed to parse goanalysis output: %w", err)
+	}
+	return violations, nil
+}
@@ -0,0 +1,13 @@
+package goanalysis
+
+import (
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func init() {
+	_ = linter.Global().RegisterTool(
+		New(),
+		NewConverter(),
+		"sym-goanalysis.json",
+	)
+}
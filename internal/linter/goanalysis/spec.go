@@ -0,0 +1,151 @@
+package goanalysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Config is the sym-goanalysis.json config file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule applies one analyzer of the library with its parameters.
+type Rule struct {
+	ID       string            `json:"id"`
+	Analyzer string            `json:"analyzer"`
+	Params   map[string]string `json:"params,omitempty"`
+	Message  string            `json:"message,omitempty"`
+	Severity string            `json:"severity,omitempty"`
+}
+
+// ParseConfig parses and validates a sym-goanalysis.json config.
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse goanalysis config: %w", err)
+	}
+	for i := range cfg.Rules {
+		if err := cfg.Rules[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &cfg, nil
+}
+
+// Validate checks that the analyzer exists and accepts the parameters.
+func (r *Rule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("goanalysis rule: id is required")
+	}
+
+	spec, ok := Lookup(r.Analyzer)
+	if !ok {
+		return fmt.Errorf("goanalysis rule %s: unknown analyzer %q (expected one of: %s)", r.ID, r.Analyzer, strings.Join(AnalyzerNames(), ", "))
+	}
+	if _, err := spec.params(r.Params); err != nil {
+		return fmt.Errorf("goanalysis rule %s: %w", r.ID, err)
+	}
+	return nil
+}
+
+// Param kinds.
+const (
+	ParamInt    = "int"    // decimal integer
+	ParamBool   = "bool"   // true or false
+	ParamString = "string" // any text
+	ParamList   = "list"   // comma-separated values
+)
+
+// Param describes one analyzer parameter.
+type Param struct {
+	Name     string
+	Kind     string
+	Default  string
+	Required bool
+	Doc      string
+}
+
+// Params are the validated parameter values of one rule, defaults applied.
+type Params map[string]string
+
+// Int returns an int parameter.
+func (p Params) Int(name string) int {
+	n, _ := strconv.Atoi(p[name])
+	return n
+}
+
+// Bool returns a bool parameter.
+func (p Params) Bool(name string) bool {
+	b, _ := strconv.ParseBool(p[name])
+	return b
+}
+
+// String returns a string parameter.
+func (p Params) String(name string) string {
+	return p[name]
+}
+
+// List returns the non-empty values of a list parameter.
+func (p Params) List(name string) []string {
+	var values []string
+	for _, v := range strings.Split(p[name], ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// params validates values against the analyzer's parameters and applies defaults.
+func (s *AnalyzerSpec) params(values map[string]string) (Params, error) {
+	known := make(map[string]bool, len(s.Params))
+	result := make(Params, len(s.Params))
+	for _, p := range s.Params {
+		known[p.Name] = true
+		value, ok := values[p.Name]
+		if !ok || value == "" {
+			if p.Required {
+				return nil, fmt.Errorf("analyzer %s: param %s is required", s.Name, p.Name)
+			}
+			value = p.Default
+		}
+		if err := checkKind(p, value); err != nil {
+			return nil, fmt.Errorf("analyzer %s: %w", s.Name, err)
+		}
+		result[p.Name] = value
+	}
+
+	var unknown []string
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("analyzer %s: unknown param(s) %s", s.Name, strings.Join(unknown, ", "))
+	}
+	return result, nil
+}
+
+// checkKind validates a parameter value against its kind.
+func checkKind(p Param, value string) error {
+	if value == "" {
+		return nil
+	}
+	switch p.Kind {
+	case ParamInt:
+		if n, err := strconv.Atoi(value); err != nil || n < 0 {
+			return fmt.Errorf("param %s must be a non-negative integer, got %q", p.Name, value)
+		}
+	case ParamBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("param %s must be true or false, got %q", p.Name, value)
+		}
+	}
+	return nil
+}
@@ -88,6 +88,34 @@ func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule,
 	}, nil
 }
 
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[golangciLinterData](data)
+}
+
+// ConvertNative enables a linter or formatter with its settings:
+// {"lll": {"line-length": 120}} or {"gofmt": null}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	name, settings, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+
+	isFormatter := isValidFormatter(name)
+	if !isFormatter && !isValidLinter(name) {
+		return nil, fmt.Errorf("invalid linter or formatter name: %s", name)
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: golangciLinterData{
+			Name:        name,
+			IsFormatter: isFormatter,
+			Settings:    settings,
+		},
+	}, nil
+}
+
 // BuildConfig assembles golangci-lint configuration from successful rule conversions.
 func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
 	if len(results) == 0 {
@@ -352,6 +380,7 @@ Output:
 (Reason: golangci-lint does not check file names)`
 
 	userPrompt := fmt.Sprintf("Convert this Go coding rule to golangci-lint linter or formatter:\n\n%s", rule.Say)
+	userPrompt += linter.ParamsPrompt(rule.Params)
 
 	// Call LLM
 	prompt := systemPrompt + "\n\n" + userPrompt
@@ -393,7 +422,42 @@ Output:
 		}
 	}
 
-	return result.Name, result.IsFormatter, result.Settings, nil
+	return result.Name, result.IsFormatter, applyParams(result.Name, result.Settings, rule.Params), nil
+}
+
+// RuleParams returns the threshold params applied to linters with bounds.
+func (c *Converter) RuleParams() []linter.RuleParam {
+	return []linter.RuleParam{linter.MaxParam, linter.MinParam}
+}
+
+// thresholdSettings maps rule params to the settings bounding each linter.
+// Complexity linters report values above their setting, so max maps to it directly.
+var thresholdSettings = map[string]map[string]string{
+	"cyclop":     {"max": "max-complexity"},
+	"funlen":     {"max": "lines"},
+	"gocognit":   {"max": "min-complexity"},
+	"gocyclo":    {"max": "min-complexity"},
+	"lll":        {"max": "line-length"},
+	"varnamelen": {"min": "min-name-length"},
+}
+
+// applyParams writes threshold params into a linter's settings, replacing the
+// values the LLM chose.
+func applyParams(name string, settings map[string]interface{}, params map[string]any) map[string]interface{} {
+	bounds, ok := thresholdSettings[name]
+	if !ok || len(params) == 0 {
+		return settings
+	}
+	result := make(map[string]interface{}, len(settings)+len(bounds))
+	for key, value := range settings {
+		result[key] = value
+	}
+	for param, setting := range bounds {
+		if n, ok := linter.IntParam(params, param); ok {
+			result[setting] = n
+		}
+	}
+	return result
 }
 
 // validateConfig validates the golangci-lint configuration
@@ -2,6 +2,7 @@ package golangcilint
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -421,3 +422,34 @@ func TestCompileTimeInterfaceCheck_Converter(t *testing.T) {
 	var _ linter.Converter = (*Converter)(nil)
 	// If this compiles, the interface is correctly implemented
 }
+
+func TestConverter_ConvertNative(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "rule-1", Say: "Lines must not exceed 120 characters"}
+
+	result, err := c.ConvertNative(context.Background(), rule, json.RawMessage(`{"lll": {"line-length": 120}}`))
+	require.NoError(t, err)
+	assert.Equal(t, golangciLinterData{Name: "lll", Settings: map[string]interface{}{"line-length": float64(120)}}, result.Data)
+
+	result, err = c.ConvertNative(context.Background(), rule, json.RawMessage(`{"gofmt": null}`))
+	require.NoError(t, err)
+	assert.True(t, result.Data.(golangciLinterData).IsFormatter)
+
+	_, err = c.ConvertNative(context.Background(), rule, json.RawMessage(`{"not-a-linter": null}`))
+	assert.Error(t, err)
+}
+
+func TestConverter_ConvertSingleRule_Params(t *testing.T) {
+	c := NewConverter()
+	mockLLM := &mockProvider{response: `{"name": "lll", "is_formatter": false, "settings": {"line-length": 100, "tab-width": 4}}`}
+	rule := schema.UserRule{ID: "rule-1", Say: "Lines must be short", Params: map[string]any{"max": float64(120)}}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, mockLLM)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, map[string]interface{}{"line-length": 120, "tab-width": float64(4)}, result.Data.(golangciLinterData).Settings)
+
+	// Linters without a bound keep their settings
+	assert.Equal(t, map[string]interface{}{"check-type-assertions": true},
+		applyParams("errcheck", map[string]interface{}{"check-type-assertions": true}, rule.Params))
+}
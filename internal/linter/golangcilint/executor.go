@@ -2,9 +2,11 @@ package golangcilint
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -32,15 +34,33 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 		}, nil
 	}
 
+	// A project dir (e.g., scaffolded rule examples) is checked in place, with
+	// the config next to it so reported paths are relative to that dir.
+	projectDir := linter.ProjectDir(ctx)
+	configDir := filepath.Join(l.ToolsDir, ".tmp")
+	var execOpts []linter.ExecOption
+	if projectDir != "" {
+		absDir, err := filepath.Abs(projectDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve project dir: %w", err)
+		}
+		projectDir = absDir
+		configDir = projectDir
+		execOpts = append(execOpts, linter.WithWorkDir(projectDir))
+	}
+
 	// Create temp config file
-	configFile, err := l.createTempConfig(config)
+	configFile, err := l.createTempConfig(config, configDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temp config: %w", err)
 	}
 	defer func() { _ = os.Remove(configFile) }()
 
 	// Build command
-	golangciLintPath := l.getGolangciLintPath()
+	tool := l.resolveGolangciLint(ctx)
+	if !tool.Found() {
+		return nil, fmt.Errorf("golangci-lint v2 not found: run Install first")
+	}
 
 	// golangci-lint command format (v2.x):
 	// golangci-lint v2 doesn't handle individual files well, so we run on ./...
@@ -54,26 +74,21 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 		"./...",              // Check all packages (v2 doesn't support individual files)
 	}
 
-	// Store working directory for path resolution
-	l.workDir, _ = os.Getwd()
-
-	// Store files for filtering results later
-	l.targetFiles = make(map[string]bool)
-	for _, f := range goFiles {
-		// Normalize paths for comparison
-		absPath, err := filepath.Abs(f)
-		if err == nil {
-			l.targetFiles[absPath] = true
-		}
-		l.targetFiles[f] = true
-	}
-
 	// Execute
 	start := time.Now()
 
-	output, err := l.executor.Execute(ctx, golangciLintPath, args...)
+	name, args := tool.Command(args...)
+	output, err := l.executor.Run(ctx, name, args, execOpts...)
 	duration := time.Since(start)
 
+	// ./... reports the whole module; keep only issues in the requested files.
+	// Filtering here keeps the linter stateless between Execute and ParseOutput.
+	if output != nil {
+		if filtered, filterErr := filterIssues(output.Stdout, newTargetSet(goFiles, projectDir)); filterErr == nil {
+			output.Stdout = filtered
+		}
+	}
+
 	if output == nil {
 		output = &linter.ToolOutput{
 			Stdout:   "",
@@ -108,22 +123,140 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 	return output, nil
 }
 
-// createTempConfig creates a temporary config file.
-func (l *Linter) createTempConfig(config []byte) (string, error) {
+// createTempConfig creates a temporary config file in tempDir.
+func (l *Linter) createTempConfig(config []byte, tempDir string) (string, error) {
 	// Ensure temp directory exists
-	tempDir := filepath.Join(l.ToolsDir, ".tmp")
 	if err := os.MkdirAll(tempDir, 0755); err != nil {
 		return "", fmt.Errorf("failed to create temp dir: %w", err)
 	}
 
-	// Create temp file
-	tempFile := filepath.Join(tempDir, "golangci-lint-config-temp.yml")
+	// Create a unique temp file so concurrent runs do not share a config
+	tempFile, err := os.CreateTemp(tempDir, "golangci-lint-config-*.yml")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tempFile.Close() }()
 
-	if err := os.WriteFile(tempFile, config, 0644); err != nil {
+	if _, err := tempFile.Write(config); err != nil {
+		_ = os.Remove(tempFile.Name())
 		return "", err
 	}
 
-	return tempFile, nil
+	return tempFile.Name(), nil
+}
+
+// targetSet is the set of files one Execute call checks.
+type targetSet struct {
+	files      map[string]bool
+	workDir    string // working directory at execution time, for path resolution
+	projectDir string // set when golangci-lint ran in a project dir; reported paths are relative to it
+}
+
+// newTargetSet builds the target set of files relative to the current
+// directory, or to projectDir when golangci-lint runs there.
+func newTargetSet(files []string, projectDir string) targetSet {
+	workDir := projectDir
+	if workDir == "" {
+		workDir, _ = os.Getwd()
+	}
+	targets := targetSet{files: make(map[string]bool), workDir: workDir, projectDir: projectDir}
+	for _, f := range files {
+		// Normalize paths for comparison
+		absPath, err := filepath.Abs(f)
+		if err == nil {
+			targets.files[absPath] = true
+		}
+		targets.files[f] = true
+	}
+	return targets
+}
+
+// filterIssues removes issues outside the target files from golangci-lint JSON output.
+func filterIssues(stdout string, targets targetSet) (string, error) {
+	if stdout == "" || len(targets.files) == 0 {
+		return stdout, nil
+	}
+
+	var result golangciOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		return "", fmt.Errorf("failed to parse golangci-lint output: %w", err)
+	}
+
+	kept := make([]golangciIssue, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		if targets.contains(issue.Pos.Filename) {
+			if targets.projectDir != "" && !filepath.IsAbs(issue.Pos.Filename) {
+				issue.Pos.Filename = filepath.Join(targets.projectDir, issue.Pos.Filename)
+			}
+			kept = append(kept, issue)
+		}
+	}
+	result.Issues = kept
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// contains checks if a file path matches any of the target files.
+func (t targetSet) contains(filePath string) bool {
+	// Clean the file path
+	cleanFilePath := filepath.Clean(filePath)
+
+	// Check against each target file using multiple strategies
+	for target := range t.files {
+		cleanTarget := filepath.Clean(target)
+
+		// Strategy 1: Direct base name match (for simple cases)
+		if filepath.Base(cleanFilePath) == filepath.Base(cleanTarget) {
+			// For files in root, base name match is sufficient
+			// For files in subdirs, check if paths end the same way
+			if cleanTarget == filepath.Base(cleanTarget) {
+				// Target is just a filename, match by base name
+				if filepath.Base(cleanFilePath) == cleanTarget {
+					return true
+				}
+			}
+		}
+
+		// Strategy 2: Suffix match - violation path ends with target path
+		// e.g., "../../../ik/sym-cli/test_violations.go" ends with "test_violations.go"
+		if strings.HasSuffix(cleanFilePath, string(filepath.Separator)+cleanTarget) ||
+			cleanFilePath == cleanTarget {
+			return true
+		}
+
+		// Strategy 3: Target path ends with violation base path
+		// e.g., "internal/foo/bar.go" should match "bar.go" from violations
+		violationBase := filepath.Base(cleanFilePath)
+		if strings.HasSuffix(cleanTarget, string(filepath.Separator)+violationBase) ||
+			cleanTarget == violationBase {
+			return true
+		}
+
+		// Strategy 4: Extract project-relative path from violation
+		// For paths like "../../../ik/sym-cli/internal/foo.go", extract "internal/foo.go"
+		if t.workDir != "" {
+			// Get the last N path components from violation that might match target
+			parts := strings.Split(cleanFilePath, string(filepath.Separator))
+			for i := len(parts) - 1; i >= 0; i-- {
+				suffix := strings.Join(parts[i:], string(filepath.Separator))
+				if suffix == cleanTarget {
+					return true
+				}
+				// Also check absolute target
+				absTarget := filepath.Join(t.workDir, cleanTarget)
+				absSuffix := filepath.Join(t.workDir, suffix)
+				if absSuffix == absTarget {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
 }
 
 // filterGoFiles filters the file list to only include .go files.
@@ -3,10 +3,9 @@ package golangcilint
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -14,8 +13,14 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter            = (*Linter)(nil)
+	_ linter.ArtifactProvider  = (*Linter)(nil)
+	_ linter.Locator           = (*Linter)(nil)
+	_ linter.Uninstaller       = (*Linter)(nil)
+	_ linter.ExampleScaffolder = (*Linter)(nil)
+)
 
 const (
 	// DefaultVersion is the default golangci-lint version.
@@ -39,7 +44,7 @@ const (
 // - And many more...
 //
 // Note: Linter is goroutine-safe and stateless. WorkDir is determined
-// by CWD at execution time, not stored in the linter.
+// by CWD (or linter.ProjectDir) at execution time, not stored in the linter.
 type Linter struct {
 	// ToolsDir is where golangci-lint is installed.
 	// Default: ~/.sym/tools
@@ -51,12 +56,6 @@ type Linter struct {
 
 	// executor runs subprocess
 	executor *linter.SubprocessExecutor
-
-	// targetFiles stores the files to filter results by (set during Execute)
-	targetFiles map[string]bool
-
-	// workDir stores the working directory when Execute is called
-	workDir string
 }
 
 // New creates a new golangci-lint linter.
@@ -93,21 +92,21 @@ func (l *Linter) GetCapabilities() linter.Capabilities {
 			"naming",
 			"ast",
 		},
-		Version: DefaultVersion,
+		Version:   DefaultVersion,
+		FileInput: linter.FileInputProject, // runs on ./...
 	}
 }
 
 // CheckAvailability checks if golangci-lint is available.
 func (l *Linter) CheckAvailability(ctx context.Context) error {
-	golangciLintPath := l.getGolangciLintPath()
-
-	// Check if golangci-lint binary exists
-	if _, err := os.Stat(golangciLintPath); os.IsNotExist(err) {
-		return fmt.Errorf("golangci-lint not found at %s: run Install first", golangciLintPath)
+	tool := l.resolveGolangciLint(ctx)
+	if !tool.Found() {
+		return fmt.Errorf("golangci-lint v2 not found (checked: go.mod tool, global PATH and %s): run Install first", l.getGolangciLintPath(ctx))
 	}
 
 	// Try to run golangci-lint version check
-	cmd := exec.CommandContext(ctx, golangciLintPath, "version")
+	name, args := tool.Command("version")
+	cmd := exec.CommandContext(ctx, name, args...)
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("golangci-lint execution failed: %w", err)
 	}
@@ -123,20 +122,19 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Determine version
-	version := config.Version
-	if version == "" {
-		version = DefaultVersion
-	}
+	version := config.ToolVersion(l.Name(), DefaultVersion)
 
-	// Get download URL and archive extension
-	url, ext, err := l.getDownloadURL(version)
+	art, err := l.Artifact(version, runtime.GOOS, runtime.GOARCH)
 	if err != nil {
 		return err
 	}
+	ext := "tar.gz"
+	if strings.HasSuffix(art.Name, ".zip") {
+		ext = "zip"
+	}
 
 	// Destination paths
-	archiveName := filepath.Base(url)
-	archivePath := filepath.Join(l.ToolsDir, archiveName)
+	archivePath := filepath.Join(l.ToolsDir, art.Name)
 	installDir := filepath.Join(l.ToolsDir, fmt.Sprintf("golangci-lint-%s", version))
 
 	// Check if already exists
@@ -146,8 +144,8 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 		}
 	}
 
-	// Download
-	if err := l.downloadFile(ctx, url, archivePath); err != nil {
+	// Download (or copy from the tools source) and verify against tools.lock
+	if err := linter.FetchArtifact(ctx, config, art, archivePath); err != nil {
 		return fmt.Errorf("failed to download golangci-lint: %w", err)
 	}
 	defer func() { _ = os.Remove(archivePath) }()
@@ -159,7 +157,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 
 	// Make golangci-lint binary executable (Unix only)
 	if runtime.GOOS != "windows" {
-		binaryPath := l.getGolangciLintPath()
+		binaryPath := golangciLintBinary(installDir)
 		if err := os.Chmod(binaryPath, 0755); err != nil {
 			return fmt.Errorf("failed to make golangci-lint executable: %w", err)
 		}
@@ -168,101 +166,94 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	return nil
 }
 
+// Locate reports the golangci-lint installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolveGolangciLint(ctx), "version")
+}
+
+// Uninstall removes all golangci-lint versions from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, "golangci-lint-*")
+}
+
 // Execute runs golangci-lint with the given config and files.
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
 	return l.execute(ctx, config, files)
 }
 
 // ParseOutput converts golangci-lint JSON output to violations.
+// Execute has already filtered the issues to the target files.
 func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
-	violations, err := parseOutput(output)
-	if err != nil {
+	return parseOutput(output)
+}
+
+// ScaffoldExamples lays the examples out as a Go module, since golangci-lint
+// only checks packages of the module it runs in. Each example gets its own
+// package directory so examples cannot clash over declarations.
+func (l *Linter) ScaffoldExamples(dir string, names []string, ext string) ([]string, error) {
+	goMod := "module sym-examples\n\ngo 1.21\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644); err != nil {
 		return nil, err
 	}
-
-	// Filter violations to only include target files (if set)
-	if len(l.targetFiles) > 0 {
-		filtered := make([]linter.Violation, 0)
-		for _, v := range violations {
-			// Check if the file is in our target list
-			if l.isTargetFile(v.File) {
-				filtered = append(filtered, v)
-			}
+	paths := make([]string, len(names))
+	for i, name := range names {
+		pkgDir := filepath.Join(dir, name)
+		if err := os.MkdirAll(pkgDir, 0755); err != nil {
+			return nil, err
 		}
-		return filtered, nil
+		paths[i] = filepath.Join(pkgDir, name+ext)
 	}
-
-	// If no target files filter set, return all violations
-	return violations, nil
+	return paths, nil
 }
 
-// isTargetFile checks if a file path matches any of the target files.
-func (l *Linter) isTargetFile(filePath string) bool {
-	// Clean the file path
-	cleanFilePath := filepath.Clean(filePath)
-
-	// Check against each target file using multiple strategies
-	for target := range l.targetFiles {
-		cleanTarget := filepath.Clean(target)
-
-		// Strategy 1: Direct base name match (for simple cases)
-		if filepath.Base(cleanFilePath) == filepath.Base(cleanTarget) {
-			// For files in root, base name match is sufficient
-			// For files in subdirs, check if paths end the same way
-			if cleanTarget == filepath.Base(cleanTarget) {
-				// Target is just a filename, match by base name
-				if filepath.Base(cleanFilePath) == cleanTarget {
-					return true
-				}
-			}
-		}
-
-		// Strategy 2: Suffix match - violation path ends with target path
-		// e.g., "../../../ik/sym-cli/test_violations.go" ends with "test_violations.go"
-		if strings.HasSuffix(cleanFilePath, string(filepath.Separator)+cleanTarget) ||
-			cleanFilePath == cleanTarget {
-			return true
-		}
+// resolveGolangciLint locates golangci-lint: go.mod tool directive, PATH,
+// then tools dir. Project and PATH installations are only used if they are
+// v2, since the generated config uses the v2 format.
+func (l *Linter) resolveGolangciLint(ctx context.Context) linter.ToolCommand {
+	managed := l.getGolangciLintPath(ctx)
+	if l.GolangciLintPath != "" {
+		return linter.ToolCommand{Path: managed, Source: linter.ToolSourceManaged}
+	}
 
-		// Strategy 3: Target path ends with violation base path
-		// e.g., "internal/foo/bar.go" should match "bar.go" from violations
-		violationBase := filepath.Base(cleanFilePath)
-		if strings.HasSuffix(cleanTarget, string(filepath.Separator)+violationBase) ||
-			cleanTarget == violationBase {
-			return true
-		}
+	tool := linter.ResolveTool(linter.ToolLookup{
+		Name:         "golangci-lint",
+		GoTool:       true,
+		ManagedPaths: []string{managed},
+	})
+	if tool.Source != linter.ToolSourceGoTool && tool.Source != linter.ToolSourcePath {
+		return tool
+	}
+	if isV2(ctx, tool) {
+		return tool
+	}
 
-		// Strategy 4: Extract project-relative path from violation
-		// For paths like "../../../ik/sym-cli/internal/foo.go", extract "internal/foo.go"
-		if l.workDir != "" {
-			// Get the last N path components from violation that might match target
-			parts := strings.Split(cleanFilePath, string(filepath.Separator))
-			for i := len(parts) - 1; i >= 0; i-- {
-				suffix := strings.Join(parts[i:], string(filepath.Separator))
-				if suffix == cleanTarget {
-					return true
-				}
-				// Also check absolute target
-				absTarget := filepath.Join(l.workDir, cleanTarget)
-				absSuffix := filepath.Join(l.workDir, suffix)
-				if absSuffix == absTarget {
-					return true
-				}
-			}
-		}
+	if _, err := os.Stat(managed); err == nil {
+		return linter.ToolCommand{Path: managed, Source: linter.ToolSourceManaged}
 	}
+	return linter.ToolCommand{}
+}
 
-	return false
+// isV2 reports whether a golangci-lint installation is major version 2.
+func isV2(ctx context.Context, tool linter.ToolCommand) bool {
+	name, args := tool.Command("version")
+	out, err := exec.CommandContext(ctx, name, args...).Output()
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(out), "version 2.")
 }
 
 // getGolangciLintPath returns the path to golangci-lint binary.
-func (l *Linter) getGolangciLintPath() string {
+func (l *Linter) getGolangciLintPath(ctx context.Context) string {
 	if l.GolangciLintPath != "" {
 		return l.GolangciLintPath
 	}
 
-	installDir := filepath.Join(l.ToolsDir, fmt.Sprintf("golangci-lint-%s", DefaultVersion))
+	return golangciLintBinary(filepath.Join(l.ToolsDir, fmt.Sprintf("golangci-lint-%s", linter.LockedVersion(ctx, l.Name(), DefaultVersion))))
+}
 
+// golangciLintBinary returns the golangci-lint binary in an install directory.
+func golangciLintBinary(installDir string) string {
 	// Binary name depends on OS
 	binName := "golangci-lint"
 	if runtime.GOOS == "windows" {
@@ -272,11 +263,25 @@ func (l *Linter) getGolangciLintPath() string {
 	return filepath.Join(installDir, binName)
 }
 
+// Artifact returns the golangci-lint release archive for a platform.
+func (l *Linter) Artifact(version, goos, goarch string) (linter.Artifact, error) {
+	if version == "" {
+		version = DefaultVersion
+	}
+	url, _, err := downloadURL(version, goos, goarch)
+	if err != nil {
+		return linter.Artifact{}, err
+	}
+	return linter.Artifact{Tool: l.Name(), Version: version, Name: path.Base(url), URL: url}, nil
+}
+
 // getDownloadURL constructs the download URL based on OS and architecture.
 func (l *Linter) getDownloadURL(version string) (string, string, error) {
-	goos := runtime.GOOS
-	goarch := runtime.GOARCH
+	return downloadURL(version, runtime.GOOS, runtime.GOARCH)
+}
 
+// downloadURL returns the release archive URL and extension for a platform.
+func downloadURL(version, goos, goarch string) (string, string, error) {
 	// Map Go OS/ARCH to golangci-lint naming
 	var osName, archName, ext string
 	switch goos {
@@ -359,43 +364,3 @@ func (l *Linter) extractArchive(ctx context.Context, archivePath, ext, version,
 
 	return nil
 }
-
-// downloadFile downloads a file from URL to destPath.
-func (l *Linter) downloadFile(ctx context.Context, url, destPath string) error {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("download failed: HTTP %d for URL %s", resp.StatusCode, url)
-	}
-
-	// Create temp file
-	tempFile := destPath + ".tmp"
-	out, err := os.Create(tempFile)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = out.Close() }()
-
-	// Copy content
-	if _, err := io.Copy(out, resp.Body); err != nil {
-		_ = os.Remove(tempFile)
-		return err
-	}
-
-	// Rename temp to final
-	if err := os.Rename(tempFile, destPath); err != nil {
-		_ = os.Remove(tempFile)
-		return err
-	}
-
-	return nil
-}
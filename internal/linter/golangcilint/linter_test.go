@@ -2,8 +2,10 @@ package golangcilint
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"testing"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -48,7 +50,7 @@ func TestGetCapabilities(t *testing.T) {
 func TestGetGolangciLintPath(t *testing.T) {
 	l := New("/test/tools")
 
-	path := l.getGolangciLintPath()
+	path := l.getGolangciLintPath(linter.WithToolLock(context.Background(), nil))
 
 	expectedDir := filepath.Join("/test/tools", "golangci-lint-"+DefaultVersion)
 	expectedBin := "golangci-lint"
@@ -58,13 +60,19 @@ func TestGetGolangciLintPath(t *testing.T) {
 	expectedPath := filepath.Join(expectedDir, expectedBin)
 
 	assert.Equal(t, expectedPath, path)
+
+	// The package's lock, not the one found from the working directory, picks the version
+	lock := linter.NewToolLock()
+	lock.Pin(l.Name(), "2.0.0", "", "")
+	path = l.getGolangciLintPath(linter.WithToolLock(context.Background(), lock))
+	assert.Equal(t, filepath.Join("/test/tools", "golangci-lint-2.0.0", expectedBin), path)
 }
 
 func TestGetGolangciLintPath_CustomPath(t *testing.T) {
 	l := New("/test/tools")
 	l.GolangciLintPath = "/custom/path/golangci-lint"
 
-	path := l.getGolangciLintPath()
+	path := l.getGolangciLintPath(context.Background())
 	assert.Equal(t, "/custom/path/golangci-lint", path)
 }
 
@@ -174,3 +182,98 @@ func TestCompileTimeInterfaceCheck(t *testing.T) {
 	var _ linter.Linter = (*Linter)(nil)
 	// If this compiles, the interface is correctly implemented
 }
+
+func TestExecute_ConcurrentFileSets(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	// Fake golangci-lint reporting one issue in each of four files
+	fake := filepath.Join(t.TempDir(), "golangci-lint")
+	script := `#!/bin/sh
+echo '{"Issues":[` +
+		`{"FromLinter":"errcheck","Text":"a","Pos":{"Filename":"a.go","Line":1}},` +
+		`{"FromLinter":"errcheck","Text":"b","Pos":{"Filename":"b.go","Line":1}},` +
+		`{"FromLinter":"errcheck","Text":"c","Pos":{"Filename":"c.go","Line":1}},` +
+		`{"FromLinter":"errcheck","Text":"d","Pos":{"Filename":"d.go","Line":1}}]}'
+exit 1
+`
+	require.NoError(t, os.WriteFile(fake, []byte(script), 0755))
+
+	// One shared instance, as registered in the global registry (run with -race)
+	l := New(t.TempDir())
+	l.GolangciLintPath = fake
+
+	fileSets := [][]string{{"a.go"}, {"b.go", "c.go"}, {"d.go"}, {"a.go", "d.go"}}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		for _, files := range fileSets {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				output, err := l.Execute(context.Background(), []byte("version: \"2\"\n"), files)
+				if !assert.NoError(t, err) {
+					return
+				}
+				violations, err := l.ParseOutput(output)
+				if !assert.NoError(t, err) || !assert.Len(t, violations, len(files)) {
+					return
+				}
+				for j, v := range violations {
+					assert.Equal(t, files[j], v.File)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	// Temp configs are unique per call and removed afterwards
+	entries, err := os.ReadDir(filepath.Join(l.ToolsDir, ".tmp"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestExecute_ProjectDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	// Fake golangci-lint that, like ./..., only sees the module it runs in
+	fake := filepath.Join(t.TempDir(), "golangci-lint")
+	script := `#!/bin/sh
+[ -f go.mod ] || { echo '{"Issues":[]}'; exit 0; }
+echo '{"Issues":[` +
+		`{"FromLinter":"errcheck","Text":"bad","Pos":{"Filename":"bad_1/bad_1.go","Line":1}},` +
+		`{"FromLinter":"errcheck","Text":"other","Pos":{"Filename":"other/other.go","Line":1}}]}'
+exit 1
+`
+	require.NoError(t, os.WriteFile(fake, []byte(script), 0755))
+
+	l := New(t.TempDir())
+	l.GolangciLintPath = fake
+
+	dir := t.TempDir()
+	paths, err := l.ScaffoldExamples(dir, []string{"bad_1", "good_1"}, ".go")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "bad_1", "bad_1.go"),
+		filepath.Join(dir, "good_1", "good_1.go"),
+	}, paths)
+	assert.FileExists(t, filepath.Join(dir, "go.mod"))
+	for _, path := range paths {
+		require.NoError(t, os.WriteFile(path, []byte("package x\n"), 0644))
+	}
+
+	ctx := linter.WithProjectDir(context.Background(), dir)
+	output, err := l.Execute(ctx, []byte("version: \"2\"\n"), paths)
+	require.NoError(t, err)
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, paths[0], violations[0].File)
+
+	// The temp config is removed from the project dir afterwards
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
@@ -0,0 +1,276 @@
+package hadolint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+var codePattern = regexp.MustCompile(`^(DL|SC)[0-9]{4}$`)
+
+// Converter converts rules to hadolint configuration using LLM
+type Converter struct{}
+
+// NewConverter creates a new hadolint converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return "hadolint"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"dockerfile"}
+}
+
+// GetLLMDescription returns a description of hadolint's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Dockerfile linter via hadolint (DL rules plus ShellCheck on RUN instructions)
+  - CAN: Pinned base image tags (DL3006, DL3007 no "latest"), pinned package versions (DL3008 apt, DL3018 apk, DL3013 pip),
+         no sudo (DL3004), USER not root (DL3002), WORKDIR absolute paths (DL3000), COPY vs ADD (DL3020),
+         apt-get cleanup and --no-install-recommends (DL3009, DL3015), JSON form for CMD/ENTRYPOINT (DL3025),
+         trusted registries for FROM (DL3026), MAINTAINER deprecated (DL4000), quoting in RUN (SC2086)
+  - CANNOT: Image size limits, build context contents, runtime configuration, docker-compose files`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use hadolint
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For Dockerfile rules (base images, package pinning, USER, RUN/COPY/ADD best practices) → use hadolint",
+		"For shell scripts outside Dockerfiles → use shellcheck, not hadolint",
+	}
+}
+
+// hadolintRuleData holds hadolint-specific conversion data
+type hadolintRuleData struct {
+	Codes             []string
+	Ignore            []string
+	TrustedRegistries []string
+	Level             string
+}
+
+// ConvertSingleRule converts ONE user rule to hadolint rule codes.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by hadolint (skip),
+//	(nil, error) on actual conversion error.
+//
+// Note: Concurrency is handled by the main converter.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		Codes             []string `json:"codes"`
+		Ignore            []string `json:"ignore"`
+		TrustedRegistries []string `json:"trustedRegistries"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	// Only enabled codes can detect violations; a rule that only disables checks has nothing to report
+	if len(result.Codes) == 0 {
+		return nil, nil
+	}
+	return codesResult(rule, result.Codes, result.Ignore, result.TrustedRegistries)
+}
+
+// ConvertNative enables hadolint rule codes with the .hadolint.yaml
+// settings they need: {"codes": ["DL3007"], "trustedRegistries": ["docker.io"]}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var config struct {
+		Codes             []string `json:"codes"`
+		Ignore            []string `json:"ignore"`
+		TrustedRegistries []string `json:"trustedRegistries"`
+	}
+	if err := json.Unmarshal(native, &config); err != nil {
+		return nil, fmt.Errorf("native config must be an object of codes, ignore and trustedRegistries: %w", err)
+	}
+	if len(config.Codes) == 0 {
+		return nil, fmt.Errorf("native config enables no codes")
+	}
+	return codesResult(rule, config.Codes, config.Ignore, config.TrustedRegistries)
+}
+
+// codesResult validates rule codes and enables them at the rule's severity.
+func codesResult(rule schema.UserRule, codes, ignore, trustedRegistries []string) (*linter.SingleRuleResult, error) {
+	for _, code := range append(append([]string{}, codes...), ignore...) {
+		if !codePattern.MatchString(code) {
+			return nil, fmt.Errorf("invalid hadolint rule code %q", code)
+		}
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: hadolintRuleData{
+			Codes:             codes,
+			Ignore:            ignore,
+			TrustedRegistries: trustedRegistries,
+			Level:             severityToLevel(rule.Severity),
+		},
+		NativeRuleIDs: codes,
+	}, nil
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[hadolintRuleData](data)
+}
+
+// BuildConfig assembles .hadolint.yaml from successful rule conversions.
+// Converted codes are enabled through "override" at the rule's severity;
+// "ignored" codes are removed from the enabled set.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	var cfg Config
+	seen := make(map[string]bool)
+	for _, r := range results {
+		data, ok := r.Data.(hadolintRuleData)
+		if !ok {
+			continue
+		}
+
+		for _, code := range data.Codes {
+			if seen[code] {
+				continue
+			}
+			seen[code] = true
+
+			switch data.Level {
+			case "warning":
+				cfg.Override.Warning = append(cfg.Override.Warning, code)
+			case "info":
+				cfg.Override.Info = append(cfg.Override.Info, code)
+			default:
+				cfg.Override.Error = append(cfg.Override.Error, code)
+			}
+		}
+		cfg.Ignored = appendUnique(cfg.Ignored, data.Ignore...)
+		cfg.TrustedRegistries = appendUnique(cfg.TrustedRegistries, data.TrustedRegistries...)
+	}
+
+	if len(cfg.enabledCodes()) == 0 {
+		return nil, nil
+	}
+
+	content, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal YAML config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: ".hadolint.yaml",
+		Content:  content,
+		Format:   "yaml",
+	}, nil
+}
+
+// buildPrompt builds the hadolint rule conversion prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	prompt := `You are a hadolint expert. Convert the natural language Dockerfile rule to hadolint rule codes.
+
+Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "codes": ["DL3007", ...],
+  "ignore": ["DL3008", ...],
+  "trustedRegistries": ["registry.example.com", ...]
+}
+
+"codes" lists the hadolint rules (DLxxxx, or SCxxxx for shell code in RUN) that detect violations. Only these are reported.
+"ignore" lists rules the convention explicitly allows (disabled even if another rule enables them).
+"trustedRegistries" is only used with DL3026 (FROM images must come from these registries).
+
+Common rules:
+- DL3006 (always tag the image version), DL3007 (do not use "latest")
+- DL3008 (pin apt-get versions), DL3013 (pin pip versions), DL3018 (pin apk versions), DL3016 (pin npm versions)
+- DL3002 (last USER should not be root), DL3004 (do not use sudo), DL3000 (use absolute WORKDIR)
+- DL3009 (delete apt-get lists), DL3015 (use --no-install-recommends), DL3059 (consolidate RUN instructions)
+- DL3020 (use COPY instead of ADD for files), DL3025 (use JSON notation for CMD and ENTRYPOINT)
+- DL3026 (use only trusted registries), DL4000 (MAINTAINER is deprecated), DL4006 (set -o pipefail before RUN with a pipe)
+
+If the rule cannot be expressed with hadolint, return:
+{
+  "codes": [],
+  "ignore": [],
+  "trustedRegistries": []
+}
+
+Examples:
+
+Input: "Never use the latest tag for base images"
+Output:
+{
+  "codes": ["DL3006", "DL3007"],
+  "ignore": [],
+  "trustedRegistries": []
+}
+
+Input: "Base images must come from ghcr.io"
+Output:
+{
+  "codes": ["DL3026"],
+  "ignore": [],
+  "trustedRegistries": ["ghcr.io"]
+}`
+
+	prompt += fmt.Sprintf("\n\nConvert this rule to hadolint rules:\n\n%s", rule.Say)
+	prompt += linter.ParamsPrompt(rule.Params)
+	return prompt
+}
+
+// severityToLevel maps a policy severity to a hadolint override level.
+func severityToLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "warning", "warn":
+		return "warning"
+	case "info":
+		return "info"
+	default:
+		return "error"
+	}
+}
+
+// appendUnique appends values that are not already present.
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, existing := range list {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,135 @@
+package hadolint
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(_ context.Context, prompt string, _ llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) Close() error { return nil }
+
+func TestConvertSingleRule(t *testing.T) {
+	provider := &mockProvider{response: `{"codes": ["DL3026"], "ignore": [], "trustedRegistries": ["ghcr.io"]}`}
+	rule := schema.UserRule{ID: "DOCKER-REGISTRY", Say: "Base images must come from ghcr.io", Severity: "warning"}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("ConvertSingleRule() returned nil result")
+	}
+	if strings.Join(result.NativeRuleIDs, ",") != "DL3026" {
+		t.Errorf("NativeRuleIDs = %v, want [DL3026]", result.NativeRuleIDs)
+	}
+	data := result.Data.(hadolintRuleData)
+	if data.Level != "warning" || strings.Join(data.TrustedRegistries, ",") != "ghcr.io" {
+		t.Errorf("Data = %+v", data)
+	}
+	if !strings.Contains(provider.prompt, rule.Say) {
+		t.Error("prompt should include the rule text")
+	}
+}
+
+func TestConvertSingleRule_Skip(t *testing.T) {
+	for _, response := range []string{
+		`{"codes": [], "ignore": [], "trustedRegistries": []}`,
+		`{"codes": [], "ignore": ["DL3008"]}`,
+	} {
+		result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response})
+		if err != nil || result != nil {
+			t.Errorf("ConvertSingleRule(%q) = %v, %v; want nil, nil", response, result, err)
+		}
+	}
+}
+
+func TestConvertSingleRule_Invalid(t *testing.T) {
+	for _, response := range []string{
+		`not json`,
+		`{"codes": ["no-latest"]}`,
+		`{"codes": ["DL3007"], "ignore": ["apt"]}`,
+	} {
+		_, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response})
+		if err == nil {
+			t.Errorf("ConvertSingleRule(%q) expected error", response)
+		}
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: hadolintRuleData{Codes: []string{"DL3006", "DL3007"}, Level: "error"}},
+		{RuleID: "R2", Data: hadolintRuleData{Codes: []string{"DL3026"}, TrustedRegistries: []string{"ghcr.io"}, Level: "warning"}},
+		{RuleID: "R3", Data: hadolintRuleData{Codes: []string{"DL3015"}, Ignore: []string{"DL3008"}, Level: "info"}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil {
+		t.Fatalf("BuildConfig() error = %v", err)
+	}
+	if config.Filename != ".hadolint.yaml" || config.Format != "yaml" {
+		t.Errorf("config = %s (%s), want .hadolint.yaml (yaml)", config.Filename, config.Format)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(config.Content, &cfg); err != nil {
+		t.Fatalf("config is not valid YAML: %v", err)
+	}
+	if strings.Join(cfg.Override.Error, ",") != "DL3006,DL3007" ||
+		strings.Join(cfg.Override.Warning, ",") != "DL3026" ||
+		strings.Join(cfg.Override.Info, ",") != "DL3015" {
+		t.Errorf("Override = %+v", cfg.Override)
+	}
+	if strings.Join(cfg.Ignored, ",") != "DL3008" || strings.Join(cfg.TrustedRegistries, ",") != "ghcr.io" {
+		t.Errorf("Ignored = %v, TrustedRegistries = %v", cfg.Ignored, cfg.TrustedRegistries)
+	}
+}
+
+func TestBuildConfig_AllIgnored(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: hadolintRuleData{Codes: []string{"DL3008"}, Ignore: []string{"DL3008"}}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil || config != nil {
+		t.Errorf("BuildConfig() = %v, %v; want nil, nil", config, err)
+	}
+}
+
+func TestConvertNative(t *testing.T) {
+	rule := schema.UserRule{ID: "DOCKER-TAG", Say: "Pin base image tags"}
+
+	result, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"codes": ["DL3007"], "trustedRegistries": ["ghcr.io"]}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	data := result.Data.(hadolintRuleData)
+	if strings.Join(data.Codes, ",") != "DL3007" || strings.Join(data.TrustedRegistries, ",") != "ghcr.io" || data.Level != "error" {
+		t.Errorf("Data = %+v", data)
+	}
+
+	for _, native := range []string{`{"codes": []}`, `{"codes": ["latest"]}`, `["DL3007"]`} {
+		if _, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(native)); err == nil {
+			t.Errorf("ConvertNative(%s) expected error", native)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package hadolint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Config is the content of .hadolint.yaml.
+type Config struct {
+	// Ignored lists rule codes that are never reported (disabled rules)
+	Ignored []string `yaml:"ignored,omitempty"`
+
+	// Override assigns severities to rule codes (enabled rules)
+	Override Override `yaml:"override,omitempty"`
+
+	// TrustedRegistries restricts FROM images to these registries (DL3026)
+	TrustedRegistries []string `yaml:"trustedRegistries,omitempty"`
+}
+
+// Override maps hadolint severity levels to rule codes.
+type Override struct {
+	Error   []string `yaml:"error,omitempty"`
+	Warning []string `yaml:"warning,omitempty"`
+	Info    []string `yaml:"info,omitempty"`
+	Style   []string `yaml:"style,omitempty"`
+}
+
+// enabledCodes returns the codes listed in override that are not ignored.
+func (c Config) enabledCodes() map[string]bool {
+	ignored := make(map[string]bool)
+	for _, code := range c.Ignored {
+		ignored[code] = true
+	}
+
+	enabled := make(map[string]bool)
+	for _, list := range [][]string{c.Override.Error, c.Override.Warning, c.Override.Info, c.Override.Style} {
+		for _, code := range list {
+			if !ignored[code] {
+				enabled[code] = true
+			}
+		}
+	}
+	return enabled
+}
+
+// execute runs hadolint with the given config and files.
+func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{
+			Stdout:   "[]",
+			ExitCode: 0,
+		}, nil
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid hadolint config: %w", err)
+	}
+
+	configPath, err := l.writeConfigFile(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write config: %w", err)
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	args := getExecutionArgs(configPath, files)
+
+	// Execute - uses CWD by default
+	output, err := l.executor.Execute(ctx, l.getHadolintCommand(ctx), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// hadolint has no allow-list option, so narrow results to the enabled rules
+	if enabled := cfg.enabledCodes(); len(enabled) > 0 {
+		output.Stdout = filterFindings(output.Stdout, enabled)
+	}
+	return output, nil
+}
+
+// getExecutionArgs returns the hadolint arguments.
+func getExecutionArgs(configPath string, files []string) []string {
+	args := []string{
+		"--config", configPath,
+		"--format", "json",
+		"--no-fail", // Findings are reported through JSON, not the exit code
+		"--no-color",
+	}
+	args = append(args, files...)
+
+	return args
+}
+
+// filterFindings keeps only findings whose code is enabled.
+// Output that is not a JSON findings array is returned unchanged.
+func filterFindings(stdout string, enabled map[string]bool) string {
+	var findings []HadolintFinding
+	if err := json.Unmarshal([]byte(stdout), &findings); err != nil {
+		return stdout
+	}
+
+	filtered := make([]HadolintFinding, 0, len(findings))
+	for _, f := range findings {
+		if enabled[f.Code] {
+			filtered = append(filtered, f)
+		}
+	}
+
+	data, err := json.Marshal(filtered)
+	if err != nil {
+		return stdout
+	}
+	return string(data)
+}
+
+// writeConfigFile writes .hadolint.yaml to a temp file.
+func (l *Linter) writeConfigFile(config []byte) (string, error) {
+	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return "", err
+	}
+
+	tmpFile, err := os.CreateTemp(tmpDir, "hadolint-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tmpFile.Close() }()
+
+	if _, err := tmpFile.Write(config); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+
+	return tmpFile.Name(), nil
+}
@@ -0,0 +1,51 @@
+package hadolint
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestGetExecutionArgs(t *testing.T) {
+	got := strings.Join(getExecutionArgs("/tmp/h.yaml", []string{"Dockerfile", "build/Dockerfile.dev"}), " ")
+	want := "--config /tmp/h.yaml --format json --no-fail --no-color Dockerfile build/Dockerfile.dev"
+	if got != want {
+		t.Errorf("getExecutionArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestEnabledCodes(t *testing.T) {
+	cfg := Config{
+		Ignored: []string{"DL3008"},
+		Override: Override{
+			Error:   []string{"DL3007", "DL3008"},
+			Warning: []string{"DL3006"},
+		},
+	}
+
+	enabled := cfg.enabledCodes()
+	if len(enabled) != 2 || !enabled["DL3007"] || !enabled["DL3006"] {
+		t.Errorf("enabledCodes() = %v, want DL3006 and DL3007", enabled)
+	}
+}
+
+func TestFilterFindings(t *testing.T) {
+	stdout := `[{"code":"DL3007","file":"Dockerfile","line":1,"column":1,"level":"warning","message":"latest"},` +
+		`{"code":"DL3059","file":"Dockerfile","line":4,"column":1,"level":"info","message":"consolidate"}]`
+
+	filtered := filterFindings(stdout, map[string]bool{"DL3007": true})
+	if !strings.Contains(filtered, "DL3007") || strings.Contains(filtered, "DL3059") {
+		t.Errorf("filterFindings() = %s, want only DL3007", filtered)
+	}
+
+	if got := filterFindings("not json", map[string]bool{"DL3007": true}); got != "not json" {
+		t.Errorf("filterFindings() should return non-JSON output unchanged, got %q", got)
+	}
+}
+
+func TestExecute_InvalidConfig(t *testing.T) {
+	l := New(t.TempDir())
+	if _, err := l.Execute(context.Background(), []byte("override: ["), []string{"Dockerfile"}); err == nil {
+		t.Error("Execute() expected error for invalid YAML config")
+	}
+}
@@ -0,0 +1,206 @@
+package hadolint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter           = (*Linter)(nil)
+	_ linter.ArtifactProvider = (*Linter)(nil)
+	_ linter.Locator          = (*Linter)(nil)
+	_ linter.Uninstaller      = (*Linter)(nil)
+)
+
+const (
+	// DefaultVersion is the default hadolint version.
+	DefaultVersion = "2.12.0"
+
+	// GitHubReleaseURL is the base URL for hadolint releases.
+	GitHubReleaseURL = "https://github.com/hadolint/hadolint/releases/download"
+)
+
+// Linter wraps hadolint for Dockerfile validation.
+//
+// hadolint parses Dockerfiles and checks them against best-practice rules
+// (DL codes, e.g., DL3007 for the "latest" tag). Shell code in RUN
+// instructions is checked with an embedded ShellCheck (SC codes).
+//
+// Note: Linter is goroutine-safe and stateless. WorkDir is determined
+// by CWD at execution time, not stored in the linter.
+type Linter struct {
+	// ToolsDir is where hadolint is installed
+	// Default: ~/.sym/tools
+	ToolsDir string
+
+	// executor runs hadolint subprocess
+	executor *linter.SubprocessExecutor
+}
+
+// New creates a new hadolint linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+
+	return &Linter{
+		ToolsDir: toolsDir,
+		executor: linter.NewSubprocessExecutor(),
+	}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "hadolint"
+}
+
+// GetCapabilities returns the hadolint linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:               "hadolint",
+		SupportedLanguages: []string{"dockerfile"},
+		SupportedCategories: []string{
+			"style",
+			"security",
+			"pattern",
+		},
+		Version: DefaultVersion,
+	}
+}
+
+// CheckAvailability checks if hadolint is installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	path := linter.FindTool(l.getLocalPath(ctx), "hadolint")
+	if path == "" {
+		return fmt.Errorf("hadolint not found at %s or in PATH: run Install first", l.getLocalPath(ctx))
+	}
+
+	cmd := exec.CommandContext(ctx, path, "--version")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("hadolint execution failed: %w", err)
+	}
+	return nil
+}
+
+// Install downloads the hadolint binary from GitHub releases.
+// hadolint is released as a single static binary, so no extraction is needed.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if err := linter.EnsureDir(l.ToolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	version := config.ToolVersion(l.Name(), DefaultVersion)
+
+	installDir := filepath.Join(l.ToolsDir, fmt.Sprintf("hadolint-%s", version))
+	binaryPath := filepath.Join(installDir, binaryName())
+	if !config.Force {
+		if _, err := os.Stat(binaryPath); err == nil {
+			return nil // Already installed
+		}
+	}
+
+	art, err := l.Artifact(version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return fmt.Errorf("failed to create installation dir: %w", err)
+	}
+	if err := linter.FetchArtifact(ctx, config, art, binaryPath); err != nil {
+		return fmt.Errorf("failed to download hadolint: %w", err)
+	}
+
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(binaryPath, 0755); err != nil {
+			return fmt.Errorf("failed to make hadolint executable: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Locate reports the hadolint installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	local := l.getLocalPath(ctx)
+	return linter.LocateTool(ctx, linter.PathTool(linter.FindTool(local, "hadolint"), local), "--version")
+}
+
+// Uninstall removes all hadolint versions from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, "hadolint-*")
+}
+
+// Execute runs hadolint with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files)
+}
+
+// ParseOutput converts hadolint JSON output to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(output)
+}
+
+// getLocalPath returns the path to the managed hadolint binary.
+func (l *Linter) getLocalPath(ctx context.Context) string {
+	return filepath.Join(l.ToolsDir, fmt.Sprintf("hadolint-%s", linter.LockedVersion(ctx, l.Name(), DefaultVersion)), binaryName())
+}
+
+// getHadolintCommand returns the hadolint command to use.
+func (l *Linter) getHadolintCommand(ctx context.Context) string {
+	if path := linter.FindTool(l.getLocalPath(ctx), "hadolint"); path != "" {
+		return path
+	}
+	// Fall back to local path (will fail with proper error)
+	return l.getLocalPath(ctx)
+}
+
+// binaryName returns the hadolint executable name for the current OS.
+func binaryName() string {
+	if runtime.GOOS == "windows" {
+		return "hadolint.exe"
+	}
+	return "hadolint"
+}
+
+// Artifact returns the hadolint release binary for a platform.
+func (l *Linter) Artifact(version, goos, goarch string) (linter.Artifact, error) {
+	if version == "" {
+		version = DefaultVersion
+	}
+	url, err := getDownloadURL(version, goos, goarch)
+	if err != nil {
+		return linter.Artifact{}, err
+	}
+	return linter.Artifact{Tool: l.Name(), Version: version, Name: path.Base(url), URL: url}, nil
+}
+
+// getDownloadURL constructs the release URL for an OS and architecture.
+// macOS releases are x86_64 only; Apple Silicon runs them through Rosetta.
+func getDownloadURL(version, goos, goarch string) (string, error) {
+	var name string
+	switch {
+	case goos == "linux" && goarch == "amd64":
+		name = "hadolint-Linux-x86_64"
+	case goos == "linux" && goarch == "arm64":
+		name = "hadolint-Linux-arm64"
+	case goos == "darwin" && (goarch == "amd64" || goarch == "arm64"):
+		name = "hadolint-Darwin-x86_64"
+	case goos == "windows" && goarch == "amd64":
+		name = "hadolint-Windows-x86_64.exe"
+	default:
+		return "", fmt.Errorf("unsupported platform: %s/%s", goos, goarch)
+	}
+
+	return fmt.Sprintf("%s/v%s/%s", GitHubReleaseURL, version, name), nil
+}
@@ -0,0 +1,40 @@
+package hadolint
+
+import (
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	l := New("")
+	if l.ToolsDir == "" {
+		t.Error("ToolsDir should not be empty")
+	}
+	if l.Name() != "hadolint" {
+		t.Errorf("Name() = %q, want hadolint", l.Name())
+	}
+}
+
+func TestGetDownloadURL(t *testing.T) {
+	tests := []struct {
+		goos, goarch string
+		want         string
+		wantErr      bool
+	}{
+		{"linux", "amd64", GitHubReleaseURL + "/v2.12.0/hadolint-Linux-x86_64", false},
+		{"linux", "arm64", GitHubReleaseURL + "/v2.12.0/hadolint-Linux-arm64", false},
+		{"darwin", "arm64", GitHubReleaseURL + "/v2.12.0/hadolint-Darwin-x86_64", false},
+		{"windows", "amd64", GitHubReleaseURL + "/v2.12.0/hadolint-Windows-x86_64.exe", false},
+		{"windows", "arm64", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := getDownloadURL("2.12.0", tt.goos, tt.goarch)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("getDownloadURL(%s/%s) error = %v, wantErr %v", tt.goos, tt.goarch, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("getDownloadURL(%s/%s) = %q, want %q", tt.goos, tt.goarch, got, tt.want)
+		}
+	}
+}
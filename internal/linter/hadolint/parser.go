@@ -0,0 +1,49 @@
+package hadolint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// HadolintFinding represents a single finding from `hadolint --format json`.
+type HadolintFinding struct {
+	Code    string `json:"code"` // DL codes, or SC codes from the embedded ShellCheck
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Level   string `json:"level"` // error, warning, info, style
+	Message string `json:"message"`
+}
+
+// parseOutput converts hadolint JSON output to violations.
+func parseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	stdout := strings.TrimSpace(output.Stdout)
+	if stdout == "" || stdout == "[]" {
+		if output.ExitCode != 0 && output.Stderr != "" {
+			return nil, fmt.Errorf("hadolint error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+
+	var findings []HadolintFinding
+	if err := json.Unmarshal([]byte(stdout), &findings); err != nil {
+		return nil, fmt.Errorf("failed to parse hadolint output: %w", err)
+	}
+
+	violations := make([]linter.Violation, 0, len(findings))
+	for _, f := range findings {
+		violations = append(violations, linter.Violation{
+			File:     f.File,
+			Line:     f.Line,
+			Column:   f.Column,
+			Message:  f.Message,
+			Severity: linter.MapSeverity(f.Level),
+			RuleID:   f.Code,
+		})
+	}
+
+	return violations, nil
+}
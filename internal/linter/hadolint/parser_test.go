@@ -0,0 +1,49 @@
+package hadolint
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func TestParseOutput(t *testing.T) {
+	output := &linter.ToolOutput{
+		Stdout: `[
+			{"code":"DL3007","column":1,"file":"Dockerfile","level":"warning","line":1,"message":"Using latest is prone to errors"},
+			{"code":"SC2086","column":1,"file":"build/Dockerfile.dev","level":"info","line":7,"message":"Double quote to prevent globbing and word splitting."}
+		]`,
+	}
+
+	violations, err := parseOutput(output)
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("parseOutput() returned %d violations, want 2", len(violations))
+	}
+
+	if v := violations[0]; v.File != "Dockerfile" || v.Line != 1 || v.RuleID != "DL3007" || v.Severity != "warning" {
+		t.Errorf("violation[0] = %+v", v)
+	}
+	if v := violations[1]; v.File != "build/Dockerfile.dev" || v.Line != 7 || v.RuleID != "SC2086" || v.Severity != "info" {
+		t.Errorf("violation[1] = %+v", v)
+	}
+}
+
+func TestParseOutput_Empty(t *testing.T) {
+	for _, stdout := range []string{"", "[]"} {
+		violations, err := parseOutput(&linter.ToolOutput{Stdout: stdout})
+		if err != nil || len(violations) != 0 {
+			t.Errorf("parseOutput(%q) = %v, %v; want no violations", stdout, violations, err)
+		}
+	}
+}
+
+func TestParseOutput_Errors(t *testing.T) {
+	if _, err := parseOutput(&linter.ToolOutput{Stderr: "Error parsing config", ExitCode: 1}); err == nil {
+		t.Error("parseOutput() expected error for failed run")
+	}
+	if _, err := parseOutput(&linter.ToolOutput{Stdout: "Dockerfile:1 DL3007 warning"}); err == nil {
+		t.Error("parseOutput() expected error for non-JSON output")
+	}
+}
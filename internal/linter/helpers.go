@@ -1,9 +1,14 @@
 package linter
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -21,20 +26,110 @@ func EnsureDir(path string) error {
 	return os.MkdirAll(path, 0755)
 }
 
-// FindTool locates a tool binary, checking local path first, then global PATH.
-// Returns empty string if not found.
+// FindTool locates a tool binary, checking global PATH first, then the managed
+// local path. Returns empty string if not found.
+// Use ResolveTool for tools that may also be installed in the project.
 func FindTool(localPath, globalName string) string {
+	if path, err := exec.LookPath(globalName); err == nil {
+		return path
+	}
 	if localPath != "" {
 		if _, err := os.Stat(localPath); err == nil {
 			return localPath
 		}
 	}
-	if path, err := exec.LookPath(globalName); err == nil {
-		return path
-	}
 	return ""
 }
 
+// ===== Installation Helpers =====
+
+// ErrToolNotFound is returned by Locate when a tool is not installed.
+var ErrToolNotFound = errors.New("not installed")
+
+var versionPattern = regexp.MustCompile(`\d+\.\d+(\.\d+)?`)
+
+// ParseVersion returns the first dotted version number in s (e.g., "8.57.0"
+// from "v8.57.0"), or "" if there is none.
+func ParseVersion(s string) string {
+	return versionPattern.FindString(s)
+}
+
+// PathTool wraps a path returned by FindTool: Source is ToolSourceManaged if
+// path is one of managedPaths, ToolSourcePath otherwise. Empty path = not found.
+func PathTool(path string, managedPaths ...string) ToolCommand {
+	if path == "" {
+		return ToolCommand{}
+	}
+	for _, managed := range managedPaths {
+		if path == managed {
+			return ToolCommand{Path: path, Source: ToolSourceManaged}
+		}
+	}
+	return ToolCommand{Path: path, Source: ToolSourcePath}
+}
+
+// LocateTool describes a resolved tool, reading its version from the output
+// of versionArgs. Returns ErrToolNotFound if the tool was not found.
+func LocateTool(ctx context.Context, tool ToolCommand, versionArgs ...string) (Installation, error) {
+	if !tool.Found() {
+		return Installation{}, ErrToolNotFound
+	}
+
+	inst := Installation{
+		Path:   strings.Join(append([]string{tool.Path}, tool.Args...), " "),
+		Source: tool.Source,
+	}
+	name, args := tool.Command(versionArgs...)
+	if output, err := NewSubprocessExecutor().Execute(ctx, name, args...); err == nil && output.ExitCode == 0 {
+		inst.Version = ParseVersion(output.Stdout + output.Stderr)
+	}
+	return inst, nil
+}
+
+// RemoveInstalls removes the entries of dir matching the glob patterns
+// (e.g., "pmd-bin-*" for all installed PMD versions).
+func RemoveInstalls(dir string, patterns ...string) error {
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		for _, match := range matches {
+			if err := os.RemoveAll(match); err != nil {
+				return fmt.Errorf("failed to remove %s: %w", match, err)
+			}
+		}
+	}
+	return nil
+}
+
+// NpmUninstall removes npm packages installed in toolsDir. It does nothing
+// if no package is installed there.
+func NpmUninstall(ctx context.Context, toolsDir string, pkgs ...string) error {
+	var installed []string
+	for _, pkg := range pkgs {
+		if _, err := os.Stat(filepath.Join(toolsDir, "node_modules", pkg)); err == nil {
+			installed = append(installed, pkg)
+		}
+	}
+	if len(installed) == 0 {
+		return nil
+	}
+
+	if _, err := exec.LookPath("npm"); err != nil {
+		return fmt.Errorf("npm not found: please install Node.js first")
+	}
+
+	output, err := NewSubprocessExecutor().Run(ctx, "npm", append([]string{"uninstall"}, installed...), WithWorkDir(toolsDir))
+	if err != nil {
+		return fmt.Errorf("npm uninstall failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("npm uninstall failed: %s", strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
 // ===== Config File Helpers =====
 
 // WriteTempConfig writes config content to a temp file in the tools directory.
@@ -59,6 +154,43 @@ func WriteTempConfig(toolsDir string, prefix string, content []byte) (string, er
 	return tmpFile.Name(), nil
 }
 
+// ErrNoRuleIDs is returned by Fixer.RuleConfig when a config cannot be
+// narrowed because the rule has no native rule IDs.
+var ErrNoRuleIDs = errors.New("rule has no native rule IDs")
+
+// KeepJSONRules narrows a JSON config with a "rules" object (ESLint, Stylelint)
+// to the given rule IDs. Other top-level keys (parser, overrides) are kept.
+func KeepJSONRules(config []byte, ruleIDs []string) ([]byte, error) {
+	if len(ruleIDs) == 0 {
+		return nil, ErrNoRuleIDs
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(config, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	var rules map[string]json.RawMessage
+	if err := json.Unmarshal(doc["rules"], &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse config rules: %w", err)
+	}
+
+	kept := make(map[string]json.RawMessage, len(ruleIDs))
+	for _, id := range ruleIDs {
+		if rule, ok := rules[id]; ok {
+			kept[id] = rule
+		}
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("config has none of the rules %s", strings.Join(ruleIDs, ", "))
+	}
+
+	var err error
+	if doc["rules"], err = json.Marshal(kept); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
 // ===== Severity Helpers =====
 
 // MapSeverity normalizes severity strings to standard values.
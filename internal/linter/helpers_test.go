@@ -0,0 +1,93 @@
+package linter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := map[string]string{
+		"v8.57.0\n":                      "8.57.0",
+		"Version 5.3.3":                  "5.3.3",
+		"pylint 3.0.2\nastroid 3.0.1":    "3.0.2",
+		"clippy 0.1.90 (abc 2025-06-01)": "0.1.90",
+		"ruff 0.6":                       "0.6",
+		"unknown":                        "",
+	}
+	for input, want := range tests {
+		if got := ParseVersion(input); got != want {
+			t.Errorf("ParseVersion(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestPathTool(t *testing.T) {
+	if tool := PathTool(""); tool.Found() {
+		t.Error("empty path should not be found")
+	}
+	if tool := PathTool("/tools/hadolint", "/tools/hadolint"); tool.Source != ToolSourceManaged {
+		t.Errorf("source = %q, want managed", tool.Source)
+	}
+	if tool := PathTool("/usr/bin/hadolint", "/tools/hadolint"); tool.Source != ToolSourcePath {
+		t.Errorf("source = %q, want path", tool.Source)
+	}
+}
+
+func TestLocateTool_NotFound(t *testing.T) {
+	if _, err := LocateTool(context.Background(), ToolCommand{}, "--version"); !errors.Is(err, ErrToolNotFound) {
+		t.Errorf("err = %v, want ErrToolNotFound", err)
+	}
+}
+
+func TestRemoveInstalls(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"pmd-bin-7.0.0/bin/pmd", "pmd-bin-7.1.0/bin/pmd", "checkstyle-10.26.1-all.jar"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := RemoveInstalls(dir, "pmd-bin-*"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "checkstyle-10.26.1-all.jar" {
+		t.Errorf("remaining entries = %v", entries)
+	}
+}
+
+func TestKeepJSONRules(t *testing.T) {
+	config := []byte(`{"parser": "x", "rules": {"no-var": "error", "semi": ["error", "always"]}}`)
+
+	narrowed, err := KeepJSONRules(config, []string{"semi", "missing"})
+	if err != nil {
+		t.Fatalf("KeepJSONRules() error = %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(narrowed, &got); err != nil {
+		t.Fatalf("narrowed config is not JSON: %v", err)
+	}
+	rules, _ := got["rules"].(map[string]interface{})
+	if got["parser"] != "x" || len(rules) != 1 || rules["semi"] == nil {
+		t.Errorf("KeepJSONRules() = %s", narrowed)
+	}
+
+	if _, err := KeepJSONRules(config, nil); !errors.Is(err, ErrNoRuleIDs) {
+		t.Errorf("KeepJSONRules(nil) error = %v, want ErrNoRuleIDs", err)
+	}
+	if _, err := KeepJSONRules(config, []string{"eqeqeq"}); err == nil {
+		t.Error("KeepJSONRules() with unknown rule expected error")
+	}
+}
@@ -35,6 +35,54 @@ type Linter interface {
 	ParseOutput(output *ToolOutput) ([]Violation, error)
 }
 
+// Fixer is implemented by linters that can rewrite files to fix their own violations.
+// It is optional: callers type-assert a Linter to Fixer when a rule's Remedy has Autofix set.
+type Fixer interface {
+	// RuleConfig narrows a validation config to the given native rule IDs and
+	// their options, so a fix applies only those rules.
+	RuleConfig(config []byte, ruleIDs []string) ([]byte, error)
+
+	// Fix runs the tool in fix mode with the given config, modifying files in place.
+	Fix(ctx context.Context, config []byte, files []string) (*ToolOutput, error)
+}
+
+// Locator is implemented by linters that can report the installation
+// CheckAvailability would use. It is optional: `sym tools list` type-asserts it.
+type Locator interface {
+	// Locate returns the resolved installation, or ErrToolNotFound.
+	Locate(ctx context.Context) (Installation, error)
+}
+
+// Uninstaller is implemented by linters whose Install places the tool in
+// ToolsDir. Uninstall removes only that Symphony-managed installation, never
+// project-local or global installs. Removing a missing tool is not an error.
+type Uninstaller interface {
+	Uninstall(ctx context.Context) error
+}
+
+// ExampleScaffolder is implemented by linters that only check files inside a
+// project (a Go module, a Cargo crate), so loose example files would never be
+// analysed. It is optional: rule example tests type-assert it and run the
+// linter with WithProjectDir set to the scaffolded project.
+type ExampleScaffolder interface {
+	// ScaffoldExamples creates a project in dir holding one file per example
+	// name (e.g., "bad_1") with extension ext, and returns the file paths in
+	// the order of names. The files are created empty.
+	ScaffoldExamples(dir string, names []string, ext string) ([]string, error)
+}
+
+// Installation describes a located tool.
+type Installation struct {
+	// Path is the executable (or jar) and any leading arguments.
+	Path string `json:"path"`
+
+	// Version is the version the tool reports; empty if unknown.
+	Version string `json:"version,omitempty"`
+
+	// Source is where the tool was found (ToolSource* constants).
+	Source string `json:"source"`
+}
+
 // Capabilities describes what a linter can do.
 type Capabilities struct {
 	// Name is the linter identifier (e.g., "eslint", "checkstyle").
@@ -50,8 +98,23 @@ type Capabilities struct {
 
 	// Version is the tool version (e.g., "8.0.0", "10.12.0").
 	Version string
+
+	// FileInput is how target files reach the tool (FileInput* constants).
+	// Empty = FileInputArgs.
+	FileInput string
+
+	// FileList reports that the tool can also read its target files from a
+	// file list when the context requests it (see WithFileListInput).
+	FileList bool
 }
 
+// File input modes, which decide how large file sets are split into chunks.
+const (
+	FileInputArgs    = "args"    // files are command arguments, bounded by the OS argv limit
+	FileInputList    = "list"    // files are passed outside argv (file list, generated config, in-process)
+	FileInputProject = "project" // the whole project is checked and results filtered; never chunked
+)
+
 // InstallConfig holds tool installation settings.
 type InstallConfig struct {
 	// ToolsDir is where to install the tool.
@@ -64,6 +127,15 @@ type InstallConfig struct {
 
 	// Force reinstalls even if already installed.
 	Force bool
+
+	// Source installs downloaded artifacts from a mirror directory or URL
+	// (populated by `sym tools vendor`) or from a local archive file.
+	// Empty = download from upstream.
+	Source string
+
+	// Lock pins tool versions and artifact digests (.sym/tools.lock).
+	// nil = no verification.
+	Lock *ToolLock
 }
 
 // ToolOutput is the raw output from a tool execution.
@@ -0,0 +1,180 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Rule parameter kinds.
+const (
+	ParamInt    = "int"    // whole number
+	ParamBool   = "bool"   // true or false
+	ParamString = "string" // any text
+	ParamList   = "list"   // array of strings or comma-separated text
+)
+
+// RuleParam describes one parameter of a user rule's params field.
+type RuleParam struct {
+	Name string
+	Kind string
+	Doc  string
+}
+
+// Threshold parameters shared by converters. A rule like "lines must not
+// exceed 120 characters" sets {"max": 120}; the converter writes the value into
+// whichever option of the chosen native rule holds the threshold.
+var (
+	MaxParam = RuleParam{Name: "max", Kind: ParamInt, Doc: "upper bound the rule enforces (length, count, complexity, depth)"}
+	MinParam = RuleParam{Name: "min", Kind: ParamInt, Doc: "lower bound the rule enforces"}
+)
+
+// ParamSchema is implemented by converters that apply a rule's params to the
+// native config they generate, so values are used exactly instead of being
+// re-read from the rule text. It is optional: the main converter validates
+// params against the schema before ConvertSingleRule and only passes params of
+// other converters to their LLM prompt.
+type ParamSchema interface {
+	// RuleParams returns the parameters the converter applies.
+	RuleParams() []RuleParam
+}
+
+// ValidateParams checks params against a parameter schema: every name must be
+// known and every value must match its kind.
+func ValidateParams(params map[string]any, schema []RuleParam) error {
+	known := make(map[string]RuleParam, len(schema))
+	for _, p := range schema {
+		known[p.Name] = p
+	}
+
+	for _, name := range sortedParamNames(params) {
+		p, ok := known[name]
+		if !ok {
+			names := make([]string, 0, len(schema))
+			for _, p := range schema {
+				names = append(names, p.Name)
+			}
+			return fmt.Errorf("unknown param %q (expected one of: %s)", name, strings.Join(names, ", "))
+		}
+		if err := checkParamKind(p, params[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkParamKind validates one param value against its kind.
+func checkParamKind(p RuleParam, value any) error {
+	switch p.Kind {
+	case ParamInt:
+		if _, ok := intValue(value); !ok {
+			return fmt.Errorf("param %s must be a whole number, got %v", p.Name, value)
+		}
+	case ParamBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("param %s must be true or false, got %v", p.Name, value)
+		}
+	case ParamString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("param %s must be a string, got %v", p.Name, value)
+		}
+	case ParamList:
+		if _, ok := ListParam(map[string]any{p.Name: value}, p.Name); !ok {
+			return fmt.Errorf("param %s must be a list of strings, got %v", p.Name, value)
+		}
+	}
+	return nil
+}
+
+// IntParam returns an int param. Whole JSON numbers and numeric strings are accepted.
+func IntParam(params map[string]any, name string) (int, bool) {
+	value, ok := params[name]
+	if !ok {
+		return 0, false
+	}
+	return intValue(value)
+}
+
+// ListParam returns a list param given as an array of strings or as
+// comma-separated text.
+func ListParam(params map[string]any, name string) ([]string, bool) {
+	switch v := params[name].(type) {
+	case string:
+		var values []string
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				values = append(values, s)
+			}
+		}
+		return values, true
+	case []string:
+		return v, true
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+func intValue(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return 0, false
+		}
+		return int(v), true
+	case json.Number:
+		n, err := strconv.Atoi(v.String())
+		return n, err == nil
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// ParamsPrompt describes a rule's params for an LLM prompt, so the model uses
+// the exact values instead of numbers from the rule text. Returns "" without params.
+func ParamsPrompt(params map[string]any) string {
+	if len(params) == 0 {
+		return ""
+	}
+	return "\nParameters (use these exact values): " + FormatParams(params)
+}
+
+// FormatParams formats params as "name: value" pairs sorted by name, with
+// JSON-encoded values: max: 120, ignore: ["vendor"].
+func FormatParams(params map[string]any) string {
+	parts := make([]string, 0, len(params))
+	for _, name := range sortedParamNames(params) {
+		value, err := json.Marshal(params[name])
+		if err != nil {
+			value = []byte(fmt.Sprint(params[name]))
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", name, value))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedParamNames(params map[string]any) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
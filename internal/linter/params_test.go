@@ -0,0 +1,72 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	schema := []RuleParam{
+		MaxParam,
+		{Name: "strict", Kind: ParamBool},
+		{Name: "style", Kind: ParamString},
+		{Name: "ignore", Kind: ParamList},
+	}
+
+	tests := []struct {
+		name    string
+		params  map[string]any
+		wantErr string
+	}{
+		{name: "valid", params: map[string]any{"max": float64(120), "strict": true, "style": "single", "ignore": []any{"vendor"}}},
+		{name: "numeric string", params: map[string]any{"max": "120"}},
+		{name: "comma-separated list", params: map[string]any{"ignore": "vendor,dist"}},
+		{name: "unknown", params: map[string]any{"min": 1}, wantErr: `unknown param "min" (expected one of: max, strict, style, ignore)`},
+		{name: "fraction", params: map[string]any{"max": 1.5}, wantErr: "param max must be a whole number"},
+		{name: "bool kind", params: map[string]any{"strict": "yes"}, wantErr: "param strict must be true or false"},
+		{name: "list items", params: map[string]any{"ignore": []any{1}}, wantErr: "param ignore must be a list of strings"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(tt.params, schema)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateParams() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateParams() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntParam(t *testing.T) {
+	params := map[string]any{"json": float64(80), "text": " 100 ", "fraction": 2.5}
+
+	if n, ok := IntParam(params, "json"); !ok || n != 80 {
+		t.Errorf("IntParam(json) = %d, %v", n, ok)
+	}
+	if n, ok := IntParam(params, "text"); !ok || n != 100 {
+		t.Errorf("IntParam(text) = %d, %v", n, ok)
+	}
+	if _, ok := IntParam(params, "fraction"); ok {
+		t.Error("IntParam(fraction) should fail")
+	}
+	if _, ok := IntParam(params, "missing"); ok {
+		t.Error("IntParam(missing) should fail")
+	}
+}
+
+func TestParamsPrompt(t *testing.T) {
+	if got := ParamsPrompt(nil); got != "" {
+		t.Errorf("ParamsPrompt(nil) = %q", got)
+	}
+	got := ParamsPrompt(map[string]any{"max": float64(120), "ignore": []any{"vendor"}})
+	want := "\nParameters (use these exact values): ignore: [\"vendor\"], max: 120"
+	if got != want {
+		t.Errorf("ParamsPrompt() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,204 @@
+package pattern
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+// supportedLanguages lists the languages the pattern engine routes for.
+// Checks are text-based, so any language with a known file extension works.
+var supportedLanguages = []string{
+	"go", "python", "javascript", "typescript", "jsx", "tsx", "java", "kotlin",
+	"c", "cpp", "csharp", "rust", "ruby", "php", "shell", "swift", "scala", "dockerfile", "css", "scss",
+}
+
+// Converter converts rules to declarative pattern specs using LLM.
+type Converter struct{}
+
+// NewConverter creates a new pattern converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return Name
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return supportedLanguages
+}
+
+// GetLLMDescription returns a description of the pattern engine for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Built-in pattern engine (in-process, no external tool, any language incl. rust, ruby, shell, php)
+  - CAN: Forbidden text/regex patterns (TODO without ticket, console.log, hardcoded URLs), banned identifiers,
+         required license/copyright headers, file naming patterns (snake_case, kebab-case file names),
+         forbidden imports/modules, maximum file length (lines)
+  - CANNOT: Anything needing syntax or type understanding (call arguments, control flow, scopes),
+         formatting, complexity, documentation quality, semantic or business logic checks`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use the pattern engine
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For file naming rules (file names, not identifiers) → use sym-pattern (ESLint/Pylint cannot check file names)",
+		"For required license or copyright headers → use sym-pattern",
+		"For max file length in lines → use sym-pattern",
+		"For banned words/identifiers or forbidden imports in languages without a dedicated linter (rust, ruby, shell, php) → use sym-pattern",
+		"Prefer the language's dedicated linter when it has a native rule for the same check",
+	}
+}
+
+// patternRuleData holds one generated pattern rule
+type patternRuleData struct {
+	Rule Rule
+}
+
+// ConvertSingleRule converts ONE user rule to a pattern rule.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be expressed as a pattern check (skip),
+//	(nil, error) on actual conversion error.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var spec Rule
+	if err := json.Unmarshal([]byte(response), &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+	if spec.Kind == "" {
+		return nil, nil
+	}
+
+	return specResult(rule, spec)
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[patternRuleData](data)
+}
+
+// ConvertNative uses a pattern rule spec as is, e.g.
+// {"kind": "regex", "pattern": "console\\.log\\("}. The id, severity,
+// languages, paths and message come from the user rule like for generated specs.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var spec Rule
+	if err := json.Unmarshal(native, &spec); err != nil {
+		return nil, fmt.Errorf("native config must be a pattern rule: %w", err)
+	}
+	return specResult(rule, spec)
+}
+
+// specResult sets the fields of a spec that come from the policy and validates it.
+func specResult(rule schema.UserRule, spec Rule) (*linter.SingleRuleResult, error) {
+	spec.ID = rule.ID
+	spec.Severity = rule.Severity
+	spec.Languages = rule.Languages
+	if len(rule.Include) > 0 {
+		spec.Include = rule.Include
+	}
+	if len(rule.Exclude) > 0 {
+		spec.Exclude = rule.Exclude
+	}
+	if rule.Message != "" {
+		spec.Message = rule.Message
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          patternRuleData{Rule: spec},
+		NativeRuleIDs: []string{rule.ID},
+	}, nil
+}
+
+// BuildConfig assembles sym-pattern.json from successful conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	cfg := Config{Rules: []Rule{}}
+	for _, r := range results {
+		data, ok := r.Data.(patternRuleData)
+		if !ok {
+			continue
+		}
+		cfg.Rules = append(cfg.Rules, data.Rule)
+	}
+	if len(cfg.Rules) == 0 {
+		return nil, nil
+	}
+
+	content, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: "sym-pattern.json",
+		Content:  content,
+		Format:   "json",
+	}, nil
+}
+
+// buildPrompt builds the pattern spec generation prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	var sb strings.Builder
+
+	sb.WriteString(`You are a code convention expert. Convert the natural language coding rule to ONE declarative pattern check.
+
+Available kinds:
+- "regex": "pattern" is a Go (RE2) regular expression; every line that matches is a violation
+- "banned-identifier": "identifiers" is a list of words that must not appear (matched as whole words)
+- "license-header": "header" is the required header text (without comment markers); it must appear at the top of every file
+- "file-name": "pattern" is a Go regular expression the file name WITHOUT extension must match (e.g., "^[a-z0-9_]+$" for snake_case)
+- "forbidden-import": "imports" is a list of forbidden modules/packages; submodules are also forbidden ("lodash" forbids "lodash/fp")
+- "max-lines": "max" is the maximum number of lines per file
+
+Optional: "include"/"exclude" are file globs (e.g., "**/*_test.go"), "message" explains the violation.
+
+Return ONLY a JSON object (no markdown fences), for example:
+{"kind": "forbidden-import", "imports": ["github.com/pkg/errors"], "message": "Use the standard errors package"}
+{"kind": "file-name", "pattern": "^[a-z0-9]+(-[a-z0-9]+)*$", "message": "File names must be kebab-case"}
+{"kind": "regex", "pattern": "console\\.log\\(", "message": "Remove console.log calls"}
+
+Note: RE2 has no lookahead/lookbehind; write patterns without them.
+If the rule cannot be expressed with these kinds (e.g., it needs syntax, types or semantics), return:
+{"kind": ""}`)
+
+	sb.WriteString(fmt.Sprintf("\n\nConvert this rule:\n\n%s", rule.Say))
+	sb.WriteString(linter.ParamsPrompt(rule.Params))
+	if len(rule.Languages) > 0 {
+		sb.WriteString(fmt.Sprintf("\nLanguages: %s", strings.Join(rule.Languages, ", ")))
+	}
+	if rule.Example != "" {
+		sb.WriteString(fmt.Sprintf("\nExample:\n%s", rule.Example))
+	}
+	return sb.String()
+}
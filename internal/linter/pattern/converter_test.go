@@ -0,0 +1,133 @@
+package pattern
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider is a mock LLM provider for testing
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string {
+	return "mock"
+}
+
+func (m *mockProvider) Close() error {
+	return nil
+}
+
+func TestConverter_ConvertSingleRule(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{
+		ID:        "FILE-1",
+		Say:       "Ruby file names must be snake_case",
+		Languages: []string{"ruby"},
+		Severity:  "warning",
+		Exclude:   []string{"vendor/**"},
+	}
+	provider := &mockProvider{response: "```json\n" + `{"id": "x", "kind": "file-name", "pattern": "^[a-z0-9_]+$", "message": "Use snake_case file names", "severity": "info"}` + "\n```"}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, provider)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "FILE-1", result.RuleID)
+	assert.Equal(t, []string{"FILE-1"}, result.NativeRuleIDs)
+	assert.Contains(t, provider.prompt, "Ruby file names must be snake_case")
+
+	data := result.Data.(patternRuleData)
+	assert.Equal(t, Rule{
+		ID:        "FILE-1",
+		Kind:      KindFileName,
+		Message:   "Use snake_case file names",
+		Severity:  "warning",
+		Languages: []string{"ruby"},
+		Exclude:   []string{"vendor/**"},
+		Pattern:   "^[a-z0-9_]+$",
+	}, data.Rule)
+}
+
+func TestConverter_ConvertSingleRule_Skip(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "R1", Say: "Functions should be small and focused"}
+
+	result, err := c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `{"kind": ""}`})
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestConverter_ConvertSingleRule_Invalid(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "R1", Say: "No TODO without a ticket"}
+
+	_, err := c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `{"kind": "regex", "pattern": "TODO(?!\\()"}`})
+	assert.ErrorContains(t, err, "invalid pattern")
+
+	_, err = c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: `not json`})
+	assert.ErrorContains(t, err, "failed to parse LLM response")
+
+	_, err = c.ConvertSingleRule(context.Background(), rule, nil)
+	assert.Error(t, err)
+}
+
+func TestConverter_BuildConfig(t *testing.T) {
+	c := NewConverter()
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: patternRuleData{Rule: Rule{ID: "R1", Kind: KindMaxLines, Max: 500}}},
+		{RuleID: "R2", Data: patternRuleData{Rule: Rule{ID: "R2", Kind: KindForbiddenImport, Imports: []string{"unsafe"}}}},
+	}
+
+	config, err := c.BuildConfig(results)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, "sym-pattern.json", config.Filename)
+	assert.Equal(t, "json", config.Format)
+
+	// The config round-trips through the engine's parser
+	parsed, err := ParseConfig(config.Content)
+	require.NoError(t, err)
+	require.Len(t, parsed.Rules, 2)
+	assert.Equal(t, 500, parsed.Rules[0].Max)
+	assert.Equal(t, []string{"unsafe"}, parsed.Rules[1].Imports)
+
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(config.Content, &raw))
+	assert.NotContains(t, raw["rules"].([]interface{})[0], "pattern")
+
+	empty, err := c.BuildConfig(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, empty)
+}
+
+func TestConverter_ConvertNative(t *testing.T) {
+	c := NewConverter()
+	rule := schema.UserRule{ID: "LOG-1", Say: "No console.log", Languages: []string{"javascript"}, Severity: "warning"}
+
+	result, err := c.ConvertNative(context.Background(), rule, json.RawMessage(`{"kind": "regex", "pattern": "console\\.log\\(", "message": "Use the logger"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Rule{
+		ID:        "LOG-1",
+		Kind:      KindRegex,
+		Message:   "Use the logger",
+		Severity:  "warning",
+		Languages: []string{"javascript"},
+		Pattern:   `console\.log\(`,
+	}, result.Data.(patternRuleData).Rule)
+
+	_, err = c.ConvertNative(context.Background(), rule, json.RawMessage(`{"kind": "regex"}`))
+	assert.Error(t, err)
+}
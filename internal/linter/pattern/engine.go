@@ -0,0 +1,231 @@
+package pattern
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/util/source"
+)
+
+// licenseHeaderLines is how many lines at the top of a file are searched for a license header.
+const licenseHeaderLines = 30
+
+// compiledRule is a rule with its regular expressions compiled once per run.
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// compile prepares the rule for checking. The rule must be valid.
+func compile(rule Rule) (*compiledRule, error) {
+	cr := &compiledRule{Rule: rule}
+
+	var expr string
+	switch rule.Kind {
+	case KindRegex, KindFileName:
+		expr = rule.Pattern
+	case KindBannedIdentifier:
+		quoted := make([]string, 0, len(rule.Identifiers))
+		for _, id := range rule.Identifiers {
+			quoted = append(quoted, regexp.QuoteMeta(id))
+		}
+		expr = `\b(?:` + strings.Join(quoted, "|") + `)\b`
+	default:
+		return cr, nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("pattern rule %s: %w", rule.ID, err)
+	}
+	cr.re = re
+	return cr, nil
+}
+
+// Check runs the rules against the files and returns the violations.
+// Files that cannot be read (e.g., deleted since the diff was taken) are skipped.
+func Check(cfg *Config, files []string) ([]linter.Violation, error) {
+	rules := make([]*compiledRule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		cr, err := compile(r)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, cr)
+	}
+
+	var violations []linter.Violation
+	for _, file := range files {
+		var content []byte
+		var loaded bool
+		for _, rule := range rules {
+			if !rule.appliesTo(file) {
+				continue
+			}
+			if !loaded {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					break
+				}
+				content, loaded = data, true
+			}
+			violations = append(violations, rule.check(file, content)...)
+		}
+	}
+	return violations, nil
+}
+
+// check runs one rule against one file.
+func (r *compiledRule) check(file string, content []byte) []linter.Violation {
+	switch r.Kind {
+	case KindRegex, KindBannedIdentifier:
+		return r.checkLines(file, content)
+	case KindLicenseHeader:
+		return r.checkLicenseHeader(file, content)
+	case KindFileName:
+		return r.checkFileName(file)
+	case KindForbiddenImport:
+		return r.checkImports(file, content)
+	case KindMaxLines:
+		return r.checkMaxLines(file, content)
+	}
+	return nil
+}
+
+// checkLines reports every line matching the rule's expression.
+func (r *compiledRule) checkLines(file string, content []byte) []linter.Violation {
+	var violations []linter.Violation
+	for i, line := range splitLines(content) {
+		loc := r.re.FindStringIndex(line)
+		if loc == nil {
+			continue
+		}
+		msg := r.Message
+		if msg == "" {
+			msg = fmt.Sprintf("Forbidden pattern: %s", line[loc[0]:loc[1]])
+		}
+		violations = append(violations, r.violation(file, i+1, loc[0]+1, msg))
+	}
+	return violations
+}
+
+// checkLicenseHeader requires the header's lines, in order, near the top of the file.
+// Comment markers are ignored so one header matches "//", "#" and "/* */" styles.
+func (r *compiledRule) checkLicenseHeader(file string, content []byte) []linter.Violation {
+	lines := splitLines(content)
+	if len(lines) > licenseHeaderLines {
+		lines = lines[:licenseHeaderLines]
+	}
+
+	next := 0
+	want := headerLines(r.Header)
+	for _, line := range lines {
+		if next < len(want) && stripComment(line) == want[next] {
+			next++
+		}
+	}
+	if next == len(want) {
+		return nil
+	}
+
+	msg := r.Message
+	if msg == "" {
+		msg = fmt.Sprintf("Missing license header: %s", want[0])
+	}
+	return []linter.Violation{r.violation(file, 1, 1, msg)}
+}
+
+// checkFileName matches the file's base name without its extension.
+func (r *compiledRule) checkFileName(file string) []linter.Violation {
+	base := filepath.Base(file)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	if r.re.MatchString(name) {
+		return nil
+	}
+
+	msg := r.Message
+	if msg == "" {
+		msg = fmt.Sprintf("File name %q does not match %s", base, r.Pattern)
+	}
+	return []linter.Violation{r.violation(file, 1, 1, msg)}
+}
+
+// checkImports reports each import of a forbidden module.
+func (r *compiledRule) checkImports(file string, content []byte) []linter.Violation {
+	var violations []linter.Violation
+	for _, imp := range source.Imports(file, content) {
+		for _, forbidden := range r.Imports {
+			if !source.ImportMatches(imp.Path, forbidden) {
+				continue
+			}
+			msg := r.Message
+			if msg == "" {
+				msg = fmt.Sprintf("Forbidden import: %s", imp.Path)
+			}
+			violations = append(violations, r.violation(file, imp.Line, imp.Column, msg))
+			break
+		}
+	}
+	return violations
+}
+
+// checkMaxLines reports the first line past the limit.
+func (r *compiledRule) checkMaxLines(file string, content []byte) []linter.Violation {
+	n := len(splitLines(content))
+	if n <= r.Max {
+		return nil
+	}
+
+	msg := r.Message
+	if msg == "" {
+		msg = fmt.Sprintf("File has %d lines (max %d)", n, r.Max)
+	}
+	return []linter.Violation{r.violation(file, r.Max+1, 1, msg)}
+}
+
+func (r *compiledRule) violation(file string, line, column int, msg string) linter.Violation {
+	severity := "error"
+	if r.Severity != "" {
+		severity = linter.MapSeverity(r.Severity)
+	}
+	return linter.Violation{
+		File:     file,
+		Line:     line,
+		Column:   column,
+		Message:  msg,
+		Severity: severity,
+		RuleID:   r.ID,
+	}
+}
+
+// splitLines splits content into lines without a trailing empty line.
+func splitLines(content []byte) []string {
+	text := strings.TrimSuffix(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// headerLines returns the non-empty header lines with comment markers removed.
+func headerLines(header string) []string {
+	var lines []string
+	for _, line := range strings.Split(header, "\n") {
+		if text := stripComment(line); text != "" {
+			lines = append(lines, text)
+		}
+	}
+	return lines
+}
+
+// stripComment removes leading and trailing comment markers and whitespace.
+func stripComment(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimLeft(line, "/*#;-<!")
+	line = strings.TrimRight(line, "*/->")
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,189 @@
+package pattern
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFiles creates files under a temp dir and returns their paths by name.
+func writeFiles(t *testing.T, files map[string]string) map[string]string {
+	t.Helper()
+	dir := t.TempDir()
+	paths := make(map[string]string, len(files))
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		paths[name] = path
+	}
+	return paths
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Rule
+		file  string
+		code  string
+		lines []int
+	}{
+		{
+			name:  "regex",
+			rule:  Rule{ID: "R1", Kind: KindRegex, Pattern: `console\.log\(`},
+			file:  "app.js",
+			code:  "const a = 1;\nconsole.log(a);\n",
+			lines: []int{2},
+		},
+		{
+			name:  "banned identifier matches whole words",
+			rule:  Rule{ID: "R2", Kind: KindBannedIdentifier, Identifiers: []string{"foo", "tmp"}},
+			file:  "main.rs",
+			code:  "let foo = 1;\nlet foobar = 2;\nlet tmp = foo;\n",
+			lines: []int{1, 3},
+		},
+		{
+			name: "license header present",
+			rule: Rule{ID: "R3", Kind: KindLicenseHeader, Header: "Copyright Acme Inc.\nSPDX-License-Identifier: MIT"},
+			file: "run.sh",
+			code: "#!/bin/sh\n# Copyright Acme Inc.\n# SPDX-License-Identifier: MIT\necho hi\n",
+		},
+		{
+			name:  "license header missing",
+			rule:  Rule{ID: "R3", Kind: KindLicenseHeader, Header: "// Copyright Acme Inc."},
+			file:  "main.go",
+			code:  "package main\n",
+			lines: []int{1},
+		},
+		{
+			name: "file name matches",
+			rule: Rule{ID: "R4", Kind: KindFileName, Pattern: `^[a-z0-9_]+$`},
+			file: "user_service.rb",
+			code: "",
+		},
+		{
+			name:  "file name violates",
+			rule:  Rule{ID: "R4", Kind: KindFileName, Pattern: `^[a-z0-9_]+$`},
+			file:  "UserService.rb",
+			code:  "",
+			lines: []int{1},
+		},
+		{
+			name:  "forbidden go import",
+			rule:  Rule{ID: "R5", Kind: KindForbiddenImport, Imports: []string{"github.com/pkg/errors"}},
+			file:  "main.go",
+			code:  "package main\n\nimport (\n\t\"fmt\"\n\t\"github.com/pkg/errors\"\n)\n",
+			lines: []int{5},
+		},
+		{
+			name:  "forbidden submodule import",
+			rule:  Rule{ID: "R5", Kind: KindForbiddenImport, Imports: []string{"lodash"}},
+			file:  "app.ts",
+			code:  "import fp from 'lodash/fp';\nimport x from 'lodash-es';\nconst y = require(\"lodash\");\n",
+			lines: []int{1, 3},
+		},
+		{
+			name:  "forbidden python import",
+			rule:  Rule{ID: "R5", Kind: KindForbiddenImport, Imports: []string{"os.path"}},
+			file:  "app.py",
+			code:  "import sys, os.path\nfrom os import path\nfrom os.path import join\n",
+			lines: []int{1, 3},
+		},
+		{
+			name:  "max lines",
+			rule:  Rule{ID: "R6", Kind: KindMaxLines, Max: 2},
+			file:  "index.php",
+			code:  "<?php\n$a = 1;\n$b = 2;\n",
+			lines: []int{3},
+		},
+		{
+			name: "language filter",
+			rule: Rule{ID: "R7", Kind: KindRegex, Pattern: "TODO", Languages: []string{"python"}},
+			file: "main.go",
+			code: "// TODO\n",
+		},
+		{
+			name:  "include glob",
+			rule:  Rule{ID: "R8", Kind: KindRegex, Pattern: "TODO", Include: []string{"**/src/**/*.go"}},
+			file:  "src/a/b.go",
+			code:  "// TODO\n",
+			lines: []int{1},
+		},
+		{
+			name: "exclude glob",
+			rule: Rule{ID: "R8", Kind: KindRegex, Pattern: "TODO", Exclude: []string{"*_test.go"}},
+			file: "a_test.go",
+			code: "// TODO\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths := writeFiles(t, map[string]string{tt.file: tt.code})
+			violations, err := Check(&Config{Rules: []Rule{tt.rule}}, []string{paths[tt.file]})
+			require.NoError(t, err)
+
+			var lines []int
+			for _, v := range violations {
+				assert.Equal(t, tt.rule.ID, v.RuleID)
+				assert.Equal(t, "error", v.Severity)
+				lines = append(lines, v.Line)
+			}
+			assert.Equal(t, tt.lines, lines)
+		})
+	}
+}
+
+func TestCheck_SkipsMissingFiles(t *testing.T) {
+	cfg := &Config{Rules: []Rule{{ID: "R1", Kind: KindMaxLines, Max: 1}}}
+	violations, err := Check(cfg, []string{filepath.Join(t.TempDir(), "deleted.go")})
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestParseConfig_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		errMsg string
+	}{
+		{"unknown kind", `{"rules":[{"id":"R1","kind":"ast"}]}`, `unknown kind "ast"`},
+		{"missing pattern", `{"rules":[{"id":"R1","kind":"regex"}]}`, "pattern is required"},
+		{"invalid regex", `{"rules":[{"id":"R1","kind":"regex","pattern":"(?!x)"}]}`, "invalid pattern"},
+		{"missing max", `{"rules":[{"id":"R1","kind":"max-lines"}]}`, "max must be positive"},
+		{"missing id", `{"rules":[{"kind":"max-lines","max":1}]}`, "id is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.config))
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestLinter_ExecuteAndParse(t *testing.T) {
+	paths := writeFiles(t, map[string]string{"a.rb": "require 'open3'\nputs 1\n"})
+	config := []byte(`{"rules":[{"id":"NO-OPEN3","kind":"forbidden-import","imports":["open3"],"severity":"warning","message":"Use the Shell helper"}]}`)
+
+	l := New()
+	require.NoError(t, l.CheckAvailability(context.Background()))
+
+	output, err := l.Execute(context.Background(), config, []string{paths["a.rb"]})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.ExitCode)
+
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	assert.Equal(t, []linter.Violation{{
+		File: paths["a.rb"], Line: 1, Column: 10, Message: "Use the Shell helper", Severity: "warning", RuleID: "NO-OPEN3",
+	}}, violations)
+
+	_, err = l.Execute(context.Background(), []byte(`{"rules":[{"id":"X","kind":"nope"}]}`), []string{paths["a.rb"]})
+	assert.Error(t, err)
+}
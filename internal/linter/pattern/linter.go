@@ -0,0 +1,108 @@
+package pattern
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Name is the engine name used in code-policy.json.
+const Name = "sym-pattern"
+
+// Compile-time interface checks
+var (
+	_ linter.Linter  = (*Linter)(nil)
+	_ linter.Locator = (*Linter)(nil)
+)
+
+// Linter is the built-in pattern engine.
+//
+// It runs in-process: there is nothing to install, and Execute checks the
+// files directly instead of spawning a tool. The violations are returned as
+// JSON in ToolOutput.Stdout so ParseOutput works like any other linter's.
+type Linter struct{}
+
+// New creates a new pattern linter.
+func New() *Linter {
+	return &Linter{}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return Name
+}
+
+// GetCapabilities returns the pattern engine capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:                Name,
+		SupportedLanguages:  supportedLanguages,
+		SupportedCategories: []string{"pattern", "naming", "length", "security", "custom"},
+		Version:             "builtin",
+		FileInput:           linter.FileInputList, // in-process
+	}
+}
+
+// CheckAvailability always succeeds; the engine is built in.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	return nil
+}
+
+// Install is a no-op; the engine is built in.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	return nil
+}
+
+// Locate reports the built-in engine.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.Installation{Source: linter.ToolSourceBuiltin}, nil
+}
+
+// Execute checks the files against the sym-pattern.json config.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	start := time.Now()
+
+	cfg, err := ParseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := Check(cfg, files)
+	if err != nil {
+		return nil, err
+	}
+	if violations == nil {
+		violations = []linter.Violation{}
+	}
+
+	out, err := json.Marshal(violations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode violations: %w", err)
+	}
+
+	exitCode := 0
+	if len(violations) > 0 {
+		exitCode = 1
+	}
+	return &linter.ToolOutput{
+		Stdout:   string(out),
+		ExitCode: exitCode,
+		Duration: time.Since(start).String(),
+	}, nil
+}
+
+// ParseOutput decodes the violations encoded by Execute.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	if output == nil || output.Stdout == "" {
+		return nil, nil
+	}
+
+	var violations []linter.Violation
+	if err := json.Unmarshal([]byte(output.Stdout), &violations); err != nil {
+		return nil, fmt.Errorf("failed to parse pattern output: %w", err)
+	}
+	return violations, nil
+}
@@ -0,0 +1,13 @@
+package pattern
+
+import (
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func init() {
+	_ = linter.Global().RegisterTool(
+		New(),
+		NewConverter(),
+		"sym-pattern.json",
+	)
+}
@@ -0,0 +1,131 @@
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/util/source"
+)
+
+// Rule kinds supported by the pattern engine.
+const (
+	KindRegex            = "regex"             // Pattern must not match any line
+	KindBannedIdentifier = "banned-identifier" // Identifiers must not appear as whole words
+	KindLicenseHeader    = "license-header"    // Header lines must appear at the top of the file
+	KindFileName         = "file-name"         // File base name (without extension) must match Pattern
+	KindForbiddenImport  = "forbidden-import"  // Imports must not match Imports
+	KindMaxLines         = "max-lines"         // File must have at most Max lines
+)
+
+// kinds lists all rule kinds, in the order they are described to the LLM.
+var kinds = []string{
+	KindRegex, KindBannedIdentifier, KindLicenseHeader,
+	KindFileName, KindForbiddenImport, KindMaxLines,
+}
+
+// Config is the sym-pattern.json config file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is one declarative pattern check.
+type Rule struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"`
+	Message  string `json:"message,omitempty"`
+	Severity string `json:"severity,omitempty"`
+
+	// Languages, Include and Exclude select the files the rule applies to.
+	// Empty Languages and Include match every file.
+	Languages []string `json:"languages,omitempty"`
+	Include   []string `json:"include,omitempty"`
+	Exclude   []string `json:"exclude,omitempty"`
+
+	Pattern     string   `json:"pattern,omitempty"`     // regex, file-name
+	Identifiers []string `json:"identifiers,omitempty"` // banned-identifier
+	Header      string   `json:"header,omitempty"`      // license-header
+	Imports     []string `json:"imports,omitempty"`     // forbidden-import
+	Max         int      `json:"max,omitempty"`         // max-lines
+}
+
+// ParseConfig parses and validates a sym-pattern.json config.
+func ParseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse pattern config: %w", err)
+	}
+	for i := range cfg.Rules {
+		if err := cfg.Rules[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &cfg, nil
+}
+
+// Validate checks that the rule has the fields its kind requires.
+func (r *Rule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("pattern rule: id is required")
+	}
+
+	switch r.Kind {
+	case KindRegex, KindFileName:
+		if r.Pattern == "" {
+			return fmt.Errorf("pattern rule %s: pattern is required for %s", r.ID, r.Kind)
+		}
+		if _, err := regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("pattern rule %s: invalid pattern: %w", r.ID, err)
+		}
+	case KindBannedIdentifier:
+		if len(r.Identifiers) == 0 {
+			return fmt.Errorf("pattern rule %s: identifiers are required for %s", r.ID, r.Kind)
+		}
+	case KindLicenseHeader:
+		if strings.TrimSpace(r.Header) == "" {
+			return fmt.Errorf("pattern rule %s: header is required for %s", r.ID, r.Kind)
+		}
+	case KindForbiddenImport:
+		if len(r.Imports) == 0 {
+			return fmt.Errorf("pattern rule %s: imports are required for %s", r.ID, r.Kind)
+		}
+	case KindMaxLines:
+		if r.Max <= 0 {
+			return fmt.Errorf("pattern rule %s: max must be positive for %s", r.ID, r.Kind)
+		}
+	default:
+		return fmt.Errorf("pattern rule %s: unknown kind %q (expected one of: %s)", r.ID, r.Kind, strings.Join(kinds, ", "))
+	}
+
+	for _, glob := range append(append([]string{}, r.Include...), r.Exclude...) {
+		if _, err := source.GlobRegexp(glob); err != nil {
+			return fmt.Errorf("pattern rule %s: invalid glob %q: %w", r.ID, glob, err)
+		}
+	}
+	return nil
+}
+
+// appliesTo reports whether the rule selects the file.
+func (r *Rule) appliesTo(file string) bool {
+	if len(r.Languages) > 0 {
+		lang := source.DetectLanguage(file)
+		matched := false
+		for _, l := range r.Languages {
+			if strings.EqualFold(l, lang) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	path := filepath.ToSlash(file)
+	if len(r.Include) > 0 && !source.MatchAnyGlob(r.Include, path) {
+		return false
+	}
+	return !source.MatchAnyGlob(r.Exclude, path)
+}
@@ -0,0 +1,225 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+// Converter converts user rules to plugin rules using LLM.
+// The LLM selects a rule from the spec's rule catalog and fills its options.
+type Converter struct {
+	spec *Spec
+}
+
+// NewConverter creates a converter for the given spec.
+func NewConverter(spec *Spec) *Converter {
+	return &Converter{spec: spec}
+}
+
+func (c *Converter) Name() string {
+	return c.spec.Name
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return c.spec.Languages
+}
+
+// GetLLMDescription returns the spec description for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	desc := c.spec.Description
+	if desc == "" {
+		desc = fmt.Sprintf("Custom linter %s", c.spec.Name)
+	}
+	if len(c.spec.Rules) == 0 {
+		return desc
+	}
+
+	ids := make([]string, 0, len(c.spec.Rules))
+	for _, r := range c.spec.Rules {
+		ids = append(ids, r.ID)
+	}
+	return fmt.Sprintf("%s\n  - RULES: %s", desc, strings.Join(ids, ", "))
+}
+
+// GetRoutingHints returns the spec routing hints
+func (c *Converter) GetRoutingHints() []string {
+	return c.spec.RoutingHints
+}
+
+// pluginRuleData holds the selected native rule and its options
+type pluginRuleData struct {
+	Rule    string
+	Options map[string]interface{}
+}
+
+// ConvertSingleRule converts ONE user rule to a plugin rule.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by the plugin (skip),
+//	(nil, error) on actual conversion error.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		Rule    string                 `json:"rule"`
+		Options map[string]interface{} `json:"options"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	if result.Rule == "" {
+		return nil, nil
+	}
+	if len(c.spec.Rules) > 0 && !c.hasRule(result.Rule) {
+		return nil, fmt.Errorf("LLM selected unknown %s rule %q", c.spec.Name, result.Rule)
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: pluginRuleData{
+			Rule:    result.Rule,
+			Options: result.Options,
+		},
+		NativeRuleIDs: []string{result.Rule},
+	}, nil
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[pluginRuleData](data)
+}
+
+// ConvertNative selects a plugin rule with its options: {"<rule id>": {...}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	name, options, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.spec.Rules) > 0 && !c.hasRule(name) {
+		return nil, fmt.Errorf("unknown %s rule %q", c.spec.Name, name)
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: pluginRuleData{
+			Rule:    name,
+			Options: options,
+		},
+		NativeRuleIDs: []string{name},
+	}, nil
+}
+
+// BuildConfig assembles the plugin config: {"rules": {"<rule id>": <options or true>}}.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 || c.spec.ConfigFile == "" {
+		return nil, nil
+	}
+
+	rules := make(map[string]interface{})
+	for _, r := range results {
+		data, ok := r.Data.(pluginRuleData)
+		if !ok {
+			continue
+		}
+		if len(data.Options) > 0 {
+			rules[data.Rule] = data.Options
+		} else {
+			rules[data.Rule] = true
+		}
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	content, err := json.MarshalIndent(map[string]interface{}{"rules": rules}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: c.spec.ConfigFile,
+		Content:  content,
+		Format:   "json",
+	}, nil
+}
+
+// buildPrompt builds the rule selection prompt from the spec's rule catalog
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("You are a %s configuration expert. Convert the natural language coding rule to a %s rule.\n\n", c.spec.Name, c.spec.Name))
+	if c.spec.Description != "" {
+		sb.WriteString(fmt.Sprintf("About %s:\n%s\n\n", c.spec.Name, c.spec.Description))
+	}
+
+	if len(c.spec.Rules) > 0 {
+		sb.WriteString("Available rules:\n")
+		for _, r := range c.spec.Rules {
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", r.ID, r.Description))
+			names := make([]string, 0, len(r.Options))
+			for name := range r.Options {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				sb.WriteString(fmt.Sprintf("    option %s: %s\n", name, r.Options[name]))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(`Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "rule": "rule-id",
+  "options": {"option": "value", ...}
+}
+
+Use "options": null when the rule needs no options.
+If the rule cannot be expressed with the available rules, return:
+{
+  "rule": "",
+  "options": null
+}`)
+
+	sb.WriteString(fmt.Sprintf("\n\nConvert this rule:\n\n%s", rule.Say))
+	sb.WriteString(linter.ParamsPrompt(rule.Params))
+	if rule.Severity != "" {
+		sb.WriteString(fmt.Sprintf("\nSeverity: %s", rule.Severity))
+	}
+	return sb.String()
+}
+
+// hasRule reports whether id is in the spec's rule catalog
+func (c *Converter) hasRule(id string) bool {
+	for _, r := range c.spec.Rules {
+		if r.ID == id {
+			return true
+		}
+	}
+	return false
+}
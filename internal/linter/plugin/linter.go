@@ -0,0 +1,232 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface check
+var _ linter.Linter = (*Linter)(nil)
+
+// Linter runs a tool described by a declarative Spec.
+//
+// Note: Linter is goroutine-safe and stateless. Temp files are created per
+// execution and removed afterwards.
+type Linter struct {
+	spec *Spec
+
+	// ToolsDir is substituted for {toolsDir}
+	// Default: ~/.sym/tools
+	ToolsDir string
+}
+
+// New creates a linter for the given spec.
+func New(spec *Spec, toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+	return &Linter{spec: spec, ToolsDir: toolsDir}
+}
+
+// Spec returns the plugin spec.
+func (l *Linter) Spec() *Spec {
+	return l.spec
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return l.spec.Name
+}
+
+// GetCapabilities returns the capabilities declared by the spec.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	fileInput := linter.FileInputArgs
+	if l.spec.Files == FilesList {
+		fileInput = linter.FileInputList
+	}
+	return linter.Capabilities{
+		Name:                l.spec.Name,
+		SupportedLanguages:  l.spec.Languages,
+		SupportedCategories: l.spec.Categories,
+		Version:             l.spec.Version,
+		FileInput:           fileInput,
+	}
+}
+
+// CheckAvailability runs the availability command (default: <command> --version).
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	check := l.spec.Availability
+	if check == nil {
+		check = &CommandSpec{Command: l.spec.Command, Args: []string{"--version"}}
+	}
+
+	vars := l.vars("")
+	name := l.resolveCommand(expand(check.Command, vars))
+	output, err := linter.NewSubprocessExecutor().Run(ctx, name, expandArgs(check.Args, vars, nil), l.envOptions()...)
+	if err != nil {
+		return fmt.Errorf("%s not found: %w", l.spec.Name, err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("%s availability check failed (exit %d): %s", l.spec.Name, output.ExitCode, strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Install runs the spec's install command.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if l.spec.Install == nil {
+		return fmt.Errorf("%s is not installed and %s declares no install command", l.spec.Name, l.specLocation())
+	}
+
+	toolsDir := config.ToolsDir
+	if toolsDir == "" {
+		toolsDir = l.ToolsDir
+	}
+	if err := linter.EnsureDir(toolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	vars := l.vars(config.Version)
+	vars["{toolsDir}"] = toolsDir
+	name := l.resolveCommand(expand(l.spec.Install.Command, vars))
+	output, err := linter.NewSubprocessExecutor().Run(ctx, name, expandArgs(l.spec.Install.Args, vars, nil), l.envOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed to install %s: %w", l.spec.Name, err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("failed to install %s: %s", l.spec.Name, strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Execute runs the tool with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{}, nil
+	}
+
+	vars := l.vars("")
+	var cleanup []string
+	defer func() {
+		for _, path := range cleanup {
+			_ = os.Remove(path)
+		}
+	}()
+
+	if l.usesPlaceholder("{config}") {
+		if len(config) == 0 {
+			config = []byte("{}")
+		}
+		configPath, err := linter.WriteTempConfig(l.ToolsDir, l.spec.Name, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write config: %w", err)
+		}
+		cleanup = append(cleanup, configPath)
+		vars["{config}"] = configPath
+	}
+
+	if l.spec.Files == FilesList {
+		listPath, err := linter.WriteTempConfig(l.ToolsDir, l.spec.Name+"-files", []byte(strings.Join(files, "\n")+"\n"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to write file list: %w", err)
+		}
+		cleanup = append(cleanup, listPath)
+		vars["{fileList}"] = listPath
+	}
+
+	args := l.spec.Args
+	if l.spec.Files != FilesList && !containsPlaceholder(args, "{files}") {
+		args = append(append([]string{}, args...), "{files}")
+	}
+
+	name := l.resolveCommand(expand(l.spec.Command, vars))
+	return linter.NewSubprocessExecutor().Run(ctx, name, expandArgs(args, vars, files), l.envOptions()...)
+}
+
+// ParseOutput converts tool output to violations using the spec's output parser.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(l.spec, output)
+}
+
+// envOptions returns the executor options that set the spec's environment.
+func (l *Linter) envOptions() []linter.ExecOption {
+	opts := make([]linter.ExecOption, 0, len(l.spec.Env))
+	for k, v := range l.spec.Env {
+		opts = append(opts, linter.WithEnv(k, v))
+	}
+	return opts
+}
+
+// vars returns the placeholder values shared by all commands.
+func (l *Linter) vars(version string) map[string]string {
+	return map[string]string{
+		"{toolsDir}": l.ToolsDir,
+		"{version}":  version,
+	}
+}
+
+// resolveCommand resolves a relative command path against the directory that contains .sym.
+func (l *Linter) resolveCommand(name string) string {
+	if l.spec.baseDir == "" || filepath.IsAbs(name) || !strings.ContainsAny(name, `/\`) {
+		return name
+	}
+	return filepath.Join(l.spec.baseDir, filepath.FromSlash(name))
+}
+
+// usesPlaceholder reports whether the command or its arguments reference placeholder.
+func (l *Linter) usesPlaceholder(placeholder string) bool {
+	if strings.Contains(l.spec.Command, placeholder) {
+		return true
+	}
+	for _, arg := range l.spec.Args {
+		if strings.Contains(arg, placeholder) {
+			return true
+		}
+	}
+	return false
+}
+
+// specLocation describes where the spec was loaded from, for error messages.
+func (l *Linter) specLocation() string {
+	if l.spec.path != "" {
+		return l.spec.path
+	}
+	return "its spec"
+}
+
+// expand substitutes placeholders in s.
+func expand(s string, vars map[string]string) string {
+	for placeholder, value := range vars {
+		s = strings.ReplaceAll(s, placeholder, value)
+	}
+	return s
+}
+
+// expandArgs substitutes placeholders; an argument that is exactly {files} expands to the files.
+func expandArgs(args []string, vars map[string]string, files []string) []string {
+	result := make([]string, 0, len(args)+len(files))
+	for _, arg := range args {
+		if arg == "{files}" {
+			result = append(result, files...)
+			continue
+		}
+		result = append(result, expand(arg, vars))
+	}
+	return result
+}
+
+// containsPlaceholder reports whether any argument is exactly placeholder.
+func containsPlaceholder(args []string, placeholder string) bool {
+	for _, arg := range args {
+		if arg == placeholder {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProvider is a mock LLM provider for testing
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string {
+	return "mock"
+}
+
+func (m *mockProvider) Close() error {
+	return nil
+}
+
+func newShellSpec(script string) *Spec {
+	return &Spec{
+		Name:      "shell-check",
+		Languages: []string{"shell"},
+		Command:   "sh",
+		Args:      []string{"-c", script, "sh"},
+		Output:    OutputSpec{Format: FormatRegex, Pattern: `^(?P<file>[^:]+):(?P<line>\d+): (?P<message>.*)$`},
+	}
+}
+
+func TestLinter_Execute_FilesArgs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	// Files are appended after the arguments when {files} is not used
+	spec := newShellSpec(`for f in "$@"; do echo "$f:1: checked"; done`)
+	l := New(spec, t.TempDir())
+
+	output, err := l.Execute(context.Background(), nil, []string{"a.sh", "b.sh"})
+	require.NoError(t, err)
+
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, "a.sh", violations[0].File)
+	assert.Equal(t, "b.sh", violations[1].File)
+}
+
+func TestLinter_Execute_FileListAndConfig(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	spec := newShellSpec(`while read f; do echo "$f:2: $(cat "$1")"; done < "$2"`)
+	spec.Args = append(spec.Args, "{config}", "{fileList}")
+	spec.Files = FilesList
+	toolsDir := t.TempDir()
+	l := New(spec, toolsDir)
+
+	output, err := l.Execute(context.Background(), []byte(`{"rules":{}}`), []string{"x.sh"})
+	require.NoError(t, err)
+
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, linter.Violation{File: "x.sh", Line: 2, Message: `{"rules":{}}`, Severity: "error"}, violations[0])
+
+	// Temp config and file list are removed after execution
+	entries, err := os.ReadDir(filepath.Join(toolsDir, ".tmp"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLinter_Execute_Concurrent(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	// One registered instance serves concurrent validations. Each call must see
+	// only its own files, config and environment (run with -race).
+	spec := newShellSpec(`while read f; do echo "$f:1: $(cat "$1") $PLUGIN_ENV"; done < "$2"`)
+	spec.Args = append(spec.Args, "{config}", "{fileList}")
+	spec.Files = FilesList
+	spec.Env = map[string]string{"PLUGIN_ENV": "env"}
+	l := New(spec, t.TempDir())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		files := []string{fmt.Sprintf("a%d.sh", i), fmt.Sprintf("b%d.sh", i)}
+		config := fmt.Sprintf("config%d", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			output, err := l.Execute(context.Background(), []byte(config), files)
+			if !assert.NoError(t, err) {
+				return
+			}
+			violations, err := l.ParseOutput(output)
+			if !assert.NoError(t, err) || !assert.Len(t, violations, 2) {
+				return
+			}
+			for j, v := range violations {
+				assert.Equal(t, files[j], v.File)
+				assert.Equal(t, config+" env", v.Message)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLinter_CheckAvailability(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	spec := newShellSpec("")
+	spec.Availability = &CommandSpec{Command: "sh", Args: []string{"-c", "exit 0"}}
+	assert.NoError(t, New(spec, t.TempDir()).CheckAvailability(context.Background()))
+
+	spec.Availability = &CommandSpec{Command: "sh", Args: []string{"-c", "exit 3"}}
+	assert.Error(t, New(spec, t.TempDir()).CheckAvailability(context.Background()))
+
+	spec.Availability = &CommandSpec{Command: "sym-plugin-test-missing-command"}
+	assert.Error(t, New(spec, t.TempDir()).CheckAvailability(context.Background()))
+}
+
+func TestLinter_InstallWithoutCommand(t *testing.T) {
+	err := New(newShellSpec(""), t.TempDir()).Install(context.Background(), linter.InstallConfig{})
+	assert.ErrorContains(t, err, "declares no install command")
+}
+
+func TestConverter_ConvertSingleRule(t *testing.T) {
+	spec := &Spec{
+		Name:       "company-lint",
+		Languages:  []string{"go"},
+		ConfigFile: "company-lint.json",
+		Rules: []RuleSpec{
+			{ID: "no-todo", Description: "Forbid TODO comments"},
+			{ID: "max-params", Description: "Limit function parameters", Options: map[string]string{"max": "maximum parameter count"}},
+		},
+	}
+	c := NewConverter(spec)
+
+	provider := &mockProvider{response: `{"rule": "max-params", "options": {"max": 4}}`}
+	result, err := c.ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "At most 4 parameters"}, provider)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "R1", result.RuleID)
+	assert.Equal(t, []string{"max-params"}, result.NativeRuleIDs)
+	assert.Contains(t, provider.prompt, "max-params: Limit function parameters")
+	assert.Contains(t, provider.prompt, "option max: maximum parameter count")
+
+	// Rules that cannot be expressed are skipped
+	skipped, err := c.ConvertSingleRule(context.Background(), schema.UserRule{ID: "R2"}, &mockProvider{response: `{"rule": "", "options": null}`})
+	require.NoError(t, err)
+	assert.Nil(t, skipped)
+
+	// Rules outside the catalog are an error
+	_, err = c.ConvertSingleRule(context.Background(), schema.UserRule{ID: "R3"}, &mockProvider{response: `{"rule": "made-up"}`})
+	assert.ErrorContains(t, err, "unknown company-lint rule")
+
+	todo, err := c.ConvertSingleRule(context.Background(), schema.UserRule{ID: "R4"}, &mockProvider{response: "```json\n{\"rule\": \"no-todo\", \"options\": null}\n```"})
+	require.NoError(t, err)
+
+	config, err := c.BuildConfig([]*linter.SingleRuleResult{result, todo})
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, "company-lint.json", config.Filename)
+	assert.JSONEq(t, `{"rules": {"max-params": {"max": 4}, "no-todo": true}}`, string(config.Content))
+}
+
+func TestConverter_GetLLMDescription(t *testing.T) {
+	c := NewConverter(&Spec{Name: "company-lint", Description: "In-house Go checks", Rules: []RuleSpec{{ID: "no-todo"}, {ID: "max-params"}}})
+	assert.Equal(t, "In-house Go checks\n  - RULES: no-todo, max-params", c.GetLLMDescription())
+}
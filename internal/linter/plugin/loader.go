@@ -0,0 +1,152 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// DirName is the directory in .sym that holds plugin specs.
+const DirName = "linters"
+
+// registries caches the plugin registry of each .sym directory, so a package's
+// specs are loaded once and reloaded only when a spec file changes.
+var (
+	registriesMu sync.Mutex
+	registries   = make(map[registryKey]*scopedRegistry)
+)
+
+type registryKey struct {
+	base   *linter.Registry
+	symDir string
+}
+
+type scopedRegistry struct {
+	fingerprint string
+	registry    *linter.Registry
+	names       []string
+	err         error
+}
+
+// LoadDir loads every *.json spec in dir, sorted by filename.
+// A missing directory yields no specs. baseDir is the directory that contains .sym,
+// used to resolve relative command paths.
+func LoadDir(dir, baseDir string) ([]*Spec, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	specs := make([]*Spec, 0, len(names))
+	for _, name := range names {
+		spec, err := LoadSpec(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		spec.baseDir = baseDir
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// NewRegistry returns the registry for the package whose .sym directory is symDir:
+// the tools of base plus the plugins in symDir/linters, which are visible only
+// through the returned registry. It also returns the plugin names.
+//
+// Registries are cached per directory and rebuilt when a spec file is added,
+// edited or removed, so long-running processes (LSP, watch, MCP) pick up edits.
+//
+// A spec whose name is already taken by a tool of base or by a plugin from
+// another file is an error; the remaining specs are still registered. On any
+// error the returned registry is still usable.
+func NewRegistry(base *linter.Registry, symDir string) (*linter.Registry, []string, error) {
+	if abs, err := filepath.Abs(symDir); err == nil {
+		symDir = abs
+	}
+	dir := filepath.Join(symDir, DirName)
+	key := registryKey{base: base, symDir: symDir}
+	fingerprint := specFingerprint(dir)
+
+	registriesMu.Lock()
+	defer registriesMu.Unlock()
+
+	if cached, ok := registries[key]; ok && cached.fingerprint == fingerprint {
+		return cached.registry, cached.names, cached.err
+	}
+
+	scoped := &scopedRegistry{fingerprint: fingerprint, registry: linter.NewRegistry(base)}
+	scoped.names, scoped.err = register(scoped.registry, base, dir, filepath.Dir(symDir))
+	registries[key] = scoped
+	return scoped.registry, scoped.names, scoped.err
+}
+
+// register loads the specs in dir into registry and returns the registered names.
+func register(registry, base *linter.Registry, dir, baseDir string) ([]string, error) {
+	specs, err := LoadDir(dir, baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	var conflicts []string
+	defined := make(map[string]string)
+	for _, spec := range specs {
+		if prev, ok := defined[spec.Name]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s (%s, already defined in %s)", spec.Name, spec.path, prev))
+			continue
+		}
+		if _, err := base.GetLinter(spec.Name); err == nil {
+			conflicts = append(conflicts, fmt.Sprintf("%s (%s, built-in linter)", spec.Name, spec.path))
+			continue
+		}
+
+		if err := registry.RegisterTool(New(spec, linter.DefaultToolsDir()), NewConverter(spec), spec.ConfigFile); err != nil {
+			return names, fmt.Errorf("failed to register linter %s: %w", spec.Name, err)
+		}
+		defined[spec.Name] = spec.path
+		names = append(names, spec.Name)
+	}
+
+	if len(conflicts) > 0 {
+		return names, fmt.Errorf("linter name conflict: %s", strings.Join(conflicts, "; "))
+	}
+	return names, nil
+}
+
+// specFingerprint hashes the names and contents of the spec files in dir.
+// Unreadable files hash as empty; LoadDir reports them when the registry is built.
+func specFingerprint(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		content, _ := os.ReadFile(filepath.Join(dir, entry.Name()))
+		fmt.Fprintf(h, "%s\x00%d\x00", entry.Name(), len(content))
+		h.Write(content)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
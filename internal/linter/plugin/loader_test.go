@@ -0,0 +1,180 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `{
+  "name": "%s",
+  "description": "In-house checks",
+  "languages": ["go"],
+  "configFile": "%s.json",
+  "command": "scripts/check.sh",
+  "args": ["--config", "{config}", "{files}"],
+  "output": {"format": "sarif"}
+}`
+
+func writeSpec(t *testing.T, symDir, file, name string) {
+	t.Helper()
+	dir := filepath.Join(symDir, DirName)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	content := []byte(fmt.Sprintf(testSpec, name, name))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, file), content, 0644))
+}
+
+func TestParseSpec_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{"missing name", `{"command": "x", "languages": ["go"], "output": {"format": "sarif"}}`, "name is required"},
+		{"missing command", `{"name": "x", "languages": ["go"], "output": {"format": "sarif"}}`, "command is required"},
+		{"missing languages", `{"name": "x", "command": "x", "output": {"format": "sarif"}}`, "language"},
+		{"unknown format", `{"name": "x", "command": "x", "languages": ["go"], "output": {"format": "xml"}}`, "unknown output format"},
+		{"json without mapping", `{"name": "x", "command": "x", "languages": ["go"], "output": {"format": "json"}}`, "json.file"},
+		{"regex without groups", `{"name": "x", "command": "x", "languages": ["go"], "output": {"format": "regex", "pattern": "(.*)"}}`, "named groups"},
+		{"invalid regex", `{"name": "x", "command": "x", "languages": ["go"], "output": {"format": "regex", "pattern": "("}}`, "invalid output pattern"},
+		{"unknown files mode", `{"name": "x", "command": "x", "languages": ["go"], "files": "stdin", "output": {"format": "sarif"}}`, "unknown files mode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSpec([]byte(tt.spec))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	symDir := filepath.Join(t.TempDir(), ".sym")
+	writeSpec(t, symDir, "b.json", "plugin-b")
+	writeSpec(t, symDir, "a.json", "plugin-a")
+	require.NoError(t, os.WriteFile(filepath.Join(symDir, DirName, "README.md"), []byte("notes"), 0644))
+
+	specs, err := LoadDir(filepath.Join(symDir, DirName), filepath.Dir(symDir))
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, "plugin-a", specs[0].Name)
+	assert.Equal(t, "plugin-b", specs[1].Name)
+
+	// Relative commands resolve against the directory containing .sym
+	l := New(specs[0], t.TempDir())
+	assert.Equal(t, filepath.Join(filepath.Dir(symDir), "scripts", "check.sh"), l.resolveCommand(specs[0].Command))
+}
+
+func TestLoadDir_Missing(t *testing.T) {
+	specs, err := LoadDir(filepath.Join(t.TempDir(), "missing"), "")
+	assert.NoError(t, err)
+	assert.Empty(t, specs)
+}
+
+func TestNewRegistry(t *testing.T) {
+	base := linter.NewRegistry(nil)
+	symDir := filepath.Join(t.TempDir(), ".sym")
+	writeSpec(t, symDir, "plugin.json", "registry-test-plugin")
+
+	registry, names, err := NewRegistry(base, symDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"registry-test-plugin"}, names)
+
+	l, err := registry.GetLinter("registry-test-plugin")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go"}, l.GetCapabilities().SupportedLanguages)
+	conv, ok := registry.GetConverter("registry-test-plugin")
+	require.True(t, ok)
+	assert.Equal(t, "registry-test-plugin", conv.Name())
+	assert.Equal(t, "registry-test-plugin.json", registry.GetConfigFile("registry-test-plugin"))
+
+	// Plugins are not added to the base registry
+	_, err = base.GetLinter("registry-test-plugin")
+	assert.Error(t, err)
+
+	// Loading the same directory again reuses the registry
+	again, _, err := NewRegistry(base, symDir)
+	require.NoError(t, err)
+	assert.Same(t, registry, again)
+}
+
+func TestNewRegistry_ReloadsEditedSpecs(t *testing.T) {
+	base := linter.NewRegistry(nil)
+	symDir := filepath.Join(t.TempDir(), ".sym")
+	writeSpec(t, symDir, "plugin.json", "reload-test-plugin")
+
+	registry, _, err := NewRegistry(base, symDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"go": {"reload-test-plugin"}}, registry.BuildLanguageMapping())
+
+	// Edit the spec: the new languages take effect
+	edited := strings.Replace(fmt.Sprintf(testSpec, "reload-test-plugin", "reload-test-plugin"), `["go"]`, `["python"]`, 1)
+	require.NoError(t, os.WriteFile(filepath.Join(symDir, DirName, "plugin.json"), []byte(edited), 0644))
+	registry, _, err = NewRegistry(base, symDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"python": {"reload-test-plugin"}}, registry.BuildLanguageMapping())
+
+	// Remove the spec: the plugin is gone
+	require.NoError(t, os.Remove(filepath.Join(symDir, DirName, "plugin.json")))
+	registry, names, err := NewRegistry(base, symDir)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+	_, err = registry.GetLinter("reload-test-plugin")
+	assert.Error(t, err)
+}
+
+func TestNewRegistry_ScopedToPackage(t *testing.T) {
+	base := linter.NewRegistry(nil)
+	root := t.TempDir()
+	webSymDir := filepath.Join(root, "web", ".sym")
+	apiSymDir := filepath.Join(root, "api", ".sym")
+	writeSpec(t, webSymDir, "plugin.json", "web-plugin")
+	writeSpec(t, apiSymDir, "plugin.json", "api-plugin")
+
+	web, _, err := NewRegistry(base, webSymDir)
+	require.NoError(t, err)
+	api, _, err := NewRegistry(base, apiSymDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"web-plugin"}, web.GetAllToolNames())
+	assert.Equal(t, []string{"api-plugin"}, api.GetAllToolNames())
+
+	// The same name in two packages is not a conflict: each package uses its own spec
+	writeSpec(t, apiSymDir, "plugin.json", "web-plugin")
+	api, names, err := NewRegistry(base, apiSymDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-plugin"}, names)
+	l, err := api.GetLinter("web-plugin")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "api", "scripts", "check.sh"), l.(*Linter).resolveCommand("scripts/check.sh"))
+}
+
+func TestNewRegistry_Conflicts(t *testing.T) {
+	base := linter.NewRegistry(nil)
+	builtinDir := filepath.Join(t.TempDir(), ".sym")
+	writeSpec(t, builtinDir, "builtin.json", "builtin-tool")
+	builtins, err := LoadDir(filepath.Join(builtinDir, DirName), "")
+	require.NoError(t, err)
+	require.NoError(t, base.RegisterTool(New(builtins[0], t.TempDir()), nil, ""))
+
+	symDir := filepath.Join(t.TempDir(), ".sym")
+	writeSpec(t, symDir, "a.json", "dup-plugin")
+	writeSpec(t, symDir, "b.json", "dup-plugin")
+	writeSpec(t, symDir, "c.json", "builtin-tool")
+	writeSpec(t, symDir, "d.json", "ok-plugin")
+
+	registry, names, err := NewRegistry(base, symDir)
+	assert.ErrorContains(t, err, "already defined")
+	assert.ErrorContains(t, err, "built-in linter")
+
+	// The remaining specs are still registered
+	assert.Equal(t, []string{"dup-plugin", "ok-plugin"}, names)
+	_, err = registry.GetLinter("ok-plugin")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,217 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/sarif"
+)
+
+// parseOutput converts tool output to violations according to the spec.
+func parseOutput(spec *Spec, output *linter.ToolOutput) ([]linter.Violation, error) {
+	text := output.Stdout
+	if spec.outputStream() == "stderr" {
+		text = output.Stderr
+	}
+
+	if strings.TrimSpace(text) == "" {
+		// A failing tool with no output is an error, not a clean run
+		if output.ExitCode != 0 && output.Stderr != "" {
+			return nil, fmt.Errorf("%s error: %s", spec.Name, strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+
+	var violations []linter.Violation
+	var err error
+	switch spec.Output.Format {
+	case FormatJSON:
+		violations, err = parseJSON(spec, text)
+	case FormatSARIF:
+		violations, err = parseSARIF(spec, text)
+	case FormatRegex:
+		violations, err = parseRegex(spec, text)
+	default:
+		err = fmt.Errorf("unknown output format %q", spec.Output.Format)
+	}
+	if err != nil {
+		if output.Stderr != "" && spec.outputStream() == "stdout" {
+			return nil, fmt.Errorf("%s error: %s", spec.Name, strings.TrimSpace(output.Stderr))
+		}
+		return nil, fmt.Errorf("failed to parse %s output: %w", spec.Name, err)
+	}
+	return violations, nil
+}
+
+// parseJSON maps a JSON document to violations using the spec's dot paths.
+func parseJSON(spec *Spec, text string) ([]linter.Violation, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(text), &doc); err != nil {
+		return nil, err
+	}
+
+	m := spec.Output.JSON
+	results, ok := lookupPath(doc, m.Results)
+	if !ok || results == nil {
+		return nil, nil
+	}
+	items, ok := results.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%q is not an array", m.Results)
+	}
+
+	violations := make([]linter.Violation, 0, len(items))
+	for _, item := range items {
+		violations = append(violations, linter.Violation{
+			File:     pathString(item, m.File),
+			Line:     pathInt(item, m.Line),
+			Column:   pathInt(item, m.Column),
+			Message:  pathString(item, m.Message),
+			Severity: spec.mapSeverity(pathString(item, m.Severity)),
+			RuleID:   pathString(item, m.RuleID),
+		})
+	}
+	return violations, nil
+}
+
+// parseSARIF converts SARIF runs[].results[] to violations.
+// Levels go through the spec's severity map first, then the sarif package's mapping.
+func parseSARIF(spec *Spec, text string) ([]linter.Violation, error) {
+	return sarif.ParseWithSeverity([]byte(text), spec.mapSARIFLevel)
+}
+
+// parseRegex matches each output line against the spec's pattern.
+func parseRegex(spec *Spec, text string) ([]linter.Violation, error) {
+	re, err := regexp.Compile(spec.Output.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	group := func(match []string, name string) string {
+		if i := re.SubexpIndex(name); i >= 0 && i < len(match) {
+			return strings.TrimSpace(match[i])
+		}
+		return ""
+	}
+
+	var violations []linter.Violation
+	for _, line := range strings.Split(text, "\n") {
+		match := re.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if match == nil {
+			continue
+		}
+		lineNum, _ := strconv.Atoi(group(match, "line"))
+		column, _ := strconv.Atoi(group(match, "column"))
+		violations = append(violations, linter.Violation{
+			File:     group(match, "file"),
+			Line:     lineNum,
+			Column:   column,
+			Message:  group(match, "message"),
+			Severity: spec.mapSeverity(group(match, "severity")),
+			RuleID:   group(match, "rule"),
+		})
+	}
+	return violations, nil
+}
+
+// mapSeverity applies the spec's severity map, then the standard normalization.
+func (s *Spec) mapSeverity(native string) string {
+	if native == "" {
+		if s.Output.DefaultSeverity != "" {
+			return linter.MapSeverity(s.Output.DefaultSeverity)
+		}
+		return "error"
+	}
+	if mapped, ok := s.SeverityMap[native]; ok {
+		return linter.MapSeverity(mapped)
+	}
+	if mapped, ok := s.SeverityMap[strings.ToLower(native)]; ok {
+		return linter.MapSeverity(mapped)
+	}
+	return linter.MapSeverity(native)
+}
+
+// mapSARIFLevel applies the spec's severity map to a SARIF level, then the
+// standard SARIF level mapping (a missing level is "warning").
+func (s *Spec) mapSARIFLevel(level string) string {
+	if level != "" {
+		if mapped, ok := s.SeverityMap[level]; ok {
+			return linter.MapSeverity(mapped)
+		}
+		if mapped, ok := s.SeverityMap[strings.ToLower(level)]; ok {
+			return linter.MapSeverity(mapped)
+		}
+	}
+	return sarif.LevelToSeverity(level)
+}
+
+// lookupPath resolves a dot path in a decoded JSON value.
+// Numeric segments index arrays; an empty path returns the value itself.
+func lookupPath(value interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return value, true
+	}
+	current := value
+	for _, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, ok := node[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			current = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// pathString returns the value at path as a string ("" when unset).
+func pathString(value interface{}, path string) string {
+	if path == "" {
+		return ""
+	}
+	v, ok := lookupPath(value, path)
+	if !ok || v == nil {
+		return ""
+	}
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	default:
+		return fmt.Sprint(s)
+	}
+}
+
+// pathInt returns the value at path as an int (0 when unset or not numeric).
+func pathInt(value interface{}, path string) int {
+	if path == "" {
+		return 0
+	}
+	v, ok := lookupPath(value, path)
+	if !ok {
+		return 0
+	}
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	default:
+		return 0
+	}
+}
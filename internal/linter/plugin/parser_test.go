@@ -0,0 +1,156 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutput_JSON(t *testing.T) {
+	spec := &Spec{
+		Name: "company-lint",
+		Output: OutputSpec{
+			Format: FormatJSON,
+			JSON: &JSONMapping{
+				Results:  "report.issues",
+				File:     "location.path",
+				Line:     "location.lines.0",
+				Column:   "location.column",
+				Message:  "text",
+				RuleID:   "check",
+				Severity: "level",
+			},
+		},
+		SeverityMap: map[string]string{"HIGH": "error", "LOW": "warning"},
+	}
+
+	output := &linter.ToolOutput{
+		Stdout: `{"report": {"issues": [
+			{"check": "no-todo", "text": "TODO left", "level": "LOW", "location": {"path": "a.go", "lines": [3, 4], "column": "7"}},
+			{"check": "no-secret", "text": "secret", "level": "HIGH", "location": {"path": "b.go", "lines": [10]}}
+		]}}`,
+		ExitCode: 1,
+	}
+
+	violations, err := parseOutput(spec, output)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, linter.Violation{File: "a.go", Line: 3, Column: 7, Message: "TODO left", Severity: "warning", RuleID: "no-todo"}, violations[0])
+	assert.Equal(t, linter.Violation{File: "b.go", Line: 10, Message: "secret", Severity: "error", RuleID: "no-secret"}, violations[1])
+}
+
+func TestParseOutput_JSONTopLevelArray(t *testing.T) {
+	spec := &Spec{
+		Name: "company-lint",
+		Output: OutputSpec{
+			Format:          FormatJSON,
+			JSON:            &JSONMapping{File: "file", Line: "line", Message: "msg"},
+			DefaultSeverity: "warning",
+		},
+	}
+
+	violations, err := parseOutput(spec, &linter.ToolOutput{Stdout: `[{"file": "x.py", "line": 2, "msg": "bad"}]`})
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "x.py", violations[0].File)
+	assert.Equal(t, 2, violations[0].Line)
+	assert.Equal(t, "warning", violations[0].Severity)
+}
+
+func TestParseOutput_SARIF(t *testing.T) {
+	spec := &Spec{Name: "scanner", Output: OutputSpec{Format: FormatSARIF}}
+
+	output := &linter.ToolOutput{Stdout: `{
+		"version": "2.1.0",
+		"runs": [{"results": [
+			{"ruleId": "G101", "level": "warning", "message": {"text": "hardcoded credentials"},
+			 "locations": [{"physicalLocation": {"artifactLocation": {"uri": "file://src/main.go"}, "region": {"startLine": 12, "startColumn": 2}}}]},
+			{"ruleId": "G104", "level": "note", "message": {"text": "unchecked error"}}
+		]}]
+	}`}
+
+	violations, err := parseOutput(spec, output)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, linter.Violation{File: "src/main.go", Line: 12, Column: 2, Message: "hardcoded credentials", Severity: "warning", RuleID: "G101"}, violations[0])
+	assert.Equal(t, "info", violations[1].Severity)
+}
+
+func TestParseOutput_SARIFSeverityMap(t *testing.T) {
+	spec := &Spec{
+		Name:        "scanner",
+		SeverityMap: map[string]string{"warning": "error", "note": "warning"},
+		Output:      OutputSpec{Format: FormatSARIF},
+	}
+
+	output := &linter.ToolOutput{Stdout: `{
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {"name": "scanner", "rules": [{"id": "G104", "defaultConfiguration": {"level": "note"}}]}},
+			"results": [
+				{"ruleId": "G101", "level": "warning", "message": {"text": "hardcoded credentials"}},
+				{"ruleId": "G104", "ruleIndex": 0, "message": {"text": "unchecked error"}},
+				{"ruleId": "G201", "level": "error", "message": {"text": "sql injection"}},
+				{"ruleId": "G301", "message": {"text": "no level"}}
+			]
+		}]
+	}`}
+
+	violations, err := parseOutput(spec, output)
+	require.NoError(t, err)
+	require.Len(t, violations, 4)
+	assert.Equal(t, "error", violations[0].Severity, "result level is mapped")
+	assert.Equal(t, "warning", violations[1].Severity, "rule default level is mapped")
+	assert.Equal(t, "error", violations[2].Severity, "unmapped levels use the SARIF mapping")
+	assert.Equal(t, "warning", violations[3].Severity, "a missing level is a warning")
+}
+
+func TestParseOutput_Regex(t *testing.T) {
+	spec := &Spec{
+		Name:   "checker",
+		Output: OutputSpec{Format: FormatRegex, Pattern: `^(?P<file>[^:]+):(?P<line>\d+):(?P<column>\d+): (?P<severity>\w+) \[(?P<rule>[\w-]+)\] (?P<message>.*)$`},
+	}
+
+	output := &linter.ToolOutput{Stdout: "checking 2 files\nsrc/a.ts:4:1: warn [no-console] console.log is not allowed\nsrc/b.ts:9:5: error [max-depth] too deep\n"}
+
+	violations, err := parseOutput(spec, output)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, linter.Violation{File: "src/a.ts", Line: 4, Column: 1, Message: "console.log is not allowed", Severity: "warning", RuleID: "no-console"}, violations[0])
+	assert.Equal(t, "error", violations[1].Severity)
+}
+
+func TestParseOutput_Stderr(t *testing.T) {
+	spec := &Spec{
+		Name:   "checker",
+		Output: OutputSpec{Stream: "stderr", Format: FormatRegex, Pattern: `^(?P<file>\S+) (?P<message>.+)$`},
+	}
+
+	violations, err := parseOutput(spec, &linter.ToolOutput{Stdout: "ignored", Stderr: "a.sh missing header\n", ExitCode: 1})
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "a.sh", violations[0].File)
+	assert.Equal(t, "error", violations[0].Severity)
+}
+
+func TestParseOutput_Errors(t *testing.T) {
+	spec := &Spec{Name: "company-lint", Output: OutputSpec{Format: FormatJSON, JSON: &JSONMapping{File: "f", Message: "m"}}}
+
+	t.Run("empty output with failing exit", func(t *testing.T) {
+		_, err := parseOutput(spec, &linter.ToolOutput{Stderr: "config invalid", ExitCode: 2})
+		assert.ErrorContains(t, err, "config invalid")
+	})
+
+	t.Run("empty output", func(t *testing.T) {
+		violations, err := parseOutput(spec, &linter.ToolOutput{})
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := parseOutput(spec, &linter.ToolOutput{Stdout: "not json"})
+		assert.ErrorContains(t, err, "failed to parse company-lint output")
+	})
+}
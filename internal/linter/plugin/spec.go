@@ -0,0 +1,221 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// File passing modes of Spec.Files.
+const (
+	// FilesArgs passes the files as command arguments ({files} placeholder, or appended).
+	FilesArgs = "args"
+	// FilesList writes the files, one per line, to a temp file passed via {fileList}.
+	FilesList = "list"
+)
+
+// Output formats of OutputSpec.Format.
+const (
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+	FormatRegex = "regex"
+)
+
+// Spec is a declarative linter adapter loaded from .sym/linters/<name>.json.
+//
+// Command, Args, Availability and Install arguments support placeholders:
+//   - {config}: path of a temp file holding the generated config (see ConfigFile)
+//   - {files}: the target files, expanded to one argument each (must be a whole argument)
+//   - {fileList}: path of a temp file listing the target files (Files = "list")
+//   - {toolsDir}: the sym tools directory (~/.sym/tools)
+//   - {version}: the requested install version
+type Spec struct {
+	// Name is the engine name used in code-policy.json (e.g., "company-lint").
+	Name string `json:"name"`
+
+	// Description tells the LLM router what the tool can and cannot check.
+	Description string `json:"description"`
+
+	// Languages lists the languages the tool validates (e.g., ["go", "python"]).
+	Languages []string `json:"languages"`
+
+	// Categories lists rule categories the tool handles (e.g., ["security"]).
+	Categories []string `json:"categories,omitempty"`
+
+	// Version is the tool version shown in capabilities.
+	Version string `json:"version,omitempty"`
+
+	// ConfigFile is the generated config filename in .sym (optional).
+	// The config is JSON: {"rules": {"<rule id>": <options or true>}}.
+	ConfigFile string `json:"configFile,omitempty"`
+
+	// Command is the executable; a relative path containing a slash is resolved
+	// against the directory that contains .sym.
+	Command string `json:"command"`
+
+	// Args are the command arguments. Without a {files} or {fileList} placeholder
+	// the files are appended.
+	Args []string `json:"args,omitempty"`
+
+	// Files is how target files are passed: "args" (default) or "list".
+	Files string `json:"files,omitempty"`
+
+	// Env holds additional environment variables.
+	Env map[string]string `json:"env,omitempty"`
+
+	// Availability is the command that checks the tool is installed.
+	// Default: <command> --version
+	Availability *CommandSpec `json:"availability,omitempty"`
+
+	// Install is the command that installs the tool (optional).
+	Install *CommandSpec `json:"install,omitempty"`
+
+	// Output describes how to parse the tool output.
+	Output OutputSpec `json:"output"`
+
+	// SeverityMap maps native severities to "error", "warning" or "info".
+	// Unmapped severities are normalized with linter.MapSeverity.
+	SeverityMap map[string]string `json:"severityMap,omitempty"`
+
+	// Rules is the catalog of native rules the LLM converter may select.
+	Rules []RuleSpec `json:"rules,omitempty"`
+
+	// RoutingHints are included in the LLM routing prompt.
+	RoutingHints []string `json:"routingHints,omitempty"`
+
+	// baseDir is the directory that contains .sym (set by the loader).
+	baseDir string
+	// path is the spec file path (set by the loader).
+	path string
+}
+
+// CommandSpec is a command with arguments.
+type CommandSpec struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args,omitempty"`
+}
+
+// OutputSpec describes the tool output.
+type OutputSpec struct {
+	// Stream is "stdout" (default) or "stderr".
+	Stream string `json:"stream,omitempty"`
+
+	// Format is "json", "sarif" or "regex".
+	Format string `json:"format"`
+
+	// JSON maps violation fields to dot paths (format "json").
+	JSON *JSONMapping `json:"json,omitempty"`
+
+	// Pattern is a line regex with named groups file, line, column, message,
+	// rule and severity (format "regex").
+	Pattern string `json:"pattern,omitempty"`
+
+	// DefaultSeverity is used when the output has no severity. Default: "error"
+	DefaultSeverity string `json:"defaultSeverity,omitempty"`
+}
+
+// JSONMapping maps violation fields to dot paths such as "location.start.line".
+// Numeric segments index arrays (e.g., "locations.0.path").
+type JSONMapping struct {
+	// Results is the path of the violation array; empty means the document itself.
+	Results  string `json:"results,omitempty"`
+	File     string `json:"file"`
+	Line     string `json:"line,omitempty"`
+	Column   string `json:"column,omitempty"`
+	Message  string `json:"message"`
+	RuleID   string `json:"ruleId,omitempty"`
+	Severity string `json:"severity,omitempty"`
+}
+
+// RuleSpec is a native rule the LLM converter may select.
+type RuleSpec struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	// Options documents the rule options for the LLM (e.g., {"max": "maximum line count"}).
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// ParseSpec parses and validates a plugin spec.
+func ParseSpec(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse linter spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// LoadSpec reads and validates a plugin spec file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read linter spec: %w", err)
+	}
+	spec, err := ParseSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	spec.path = path
+	return spec, nil
+}
+
+// Validate checks required fields and the output parser definition.
+func (s *Spec) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("linter spec: name is required")
+	}
+	if s.Command == "" {
+		return fmt.Errorf("linter %s: command is required", s.Name)
+	}
+	if len(s.Languages) == 0 {
+		return fmt.Errorf("linter %s: at least one language is required", s.Name)
+	}
+
+	switch s.Files {
+	case "", FilesArgs, FilesList:
+	default:
+		return fmt.Errorf("linter %s: unknown files mode %q (use %q or %q)", s.Name, s.Files, FilesArgs, FilesList)
+	}
+
+	switch s.Output.Stream {
+	case "", "stdout", "stderr":
+	default:
+		return fmt.Errorf("linter %s: unknown output stream %q", s.Name, s.Output.Stream)
+	}
+
+	switch s.Output.Format {
+	case FormatJSON:
+		if s.Output.JSON == nil || s.Output.JSON.File == "" || s.Output.JSON.Message == "" {
+			return fmt.Errorf("linter %s: json output requires json.file and json.message paths", s.Name)
+		}
+	case FormatSARIF:
+	case FormatRegex:
+		re, err := regexp.Compile(s.Output.Pattern)
+		if err != nil {
+			return fmt.Errorf("linter %s: invalid output pattern: %w", s.Name, err)
+		}
+		if re.SubexpIndex("file") < 0 || re.SubexpIndex("message") < 0 {
+			return fmt.Errorf("linter %s: output pattern requires named groups file and message", s.Name)
+		}
+	default:
+		return fmt.Errorf("linter %s: unknown output format %q (use json, sarif or regex)", s.Name, s.Output.Format)
+	}
+
+	for _, r := range s.Rules {
+		if r.ID == "" {
+			return fmt.Errorf("linter %s: rule id is required", s.Name)
+		}
+	}
+	return nil
+}
+
+// outputStream returns the configured output stream.
+func (s *Spec) outputStream() string {
+	if s.Output.Stream == "" {
+		return "stdout"
+	}
+	return s.Output.Stream
+}
@@ -92,6 +92,41 @@ func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule,
 	}, nil
 }
 
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[*pmdRule](data)
+}
+
+// ConvertNative references a PMD rule by its category path, with an optional
+// priority (1-5, default 3): {"category/java/bestpractices.xml/UnusedLocalVariable": {"priority": 2}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	ref, raw, err := linter.SingleNativeRule(native)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(ref, "category/java/") {
+		return nil, fmt.Errorf("invalid PMD rule reference %q (expected category/java/<category>.xml/<Rule>)", ref)
+	}
+
+	var options struct {
+		Priority int `json:"priority"`
+	}
+	if err := json.Unmarshal(raw, &options); err != nil {
+		return nil, fmt.Errorf("invalid options for %s: %w", ref, err)
+	}
+	if options.Priority == 0 {
+		options.Priority = 3
+	}
+	if options.Priority < 1 || options.Priority > 5 {
+		return nil, fmt.Errorf("invalid PMD priority %d (expected 1-5)", options.Priority)
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data:   &pmdRule{Ref: ref, Priority: options.Priority},
+	}, nil
+}
+
 // BuildConfig assembles PMD XML configuration from successful rule conversions.
 func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
 	if len(results) == 0 {
@@ -181,6 +216,7 @@ If the rule cannot be mapped to a valid PMD rule, return:
 IMPORTANT: Return ONLY the JSON object. Do NOT include description, message, or any other fields.`
 
 	userPrompt := fmt.Sprintf("Convert this Java rule to PMD rule reference:\n\n%s", rule.Say)
+	userPrompt += linter.ParamsPrompt(rule.Params)
 
 	// Call LLM
 	prompt := systemPrompt + "\n\n" + userPrompt
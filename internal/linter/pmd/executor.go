@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -30,16 +29,26 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 	defer func() { _ = os.Remove(rulesetFile) }()
 
 	// Build command
-	pmdPath := l.getPMDPath()
+	pmdPath := l.getPMDPath(ctx)
 
 	// PMD command format: pmd check -d <files> -R <ruleset> -f json
-	args := []string{
-		"check",
-		"-d", strings.Join(files, ","), // Comma-separated file list
+	args := []string{"check"}
+	if linter.FileListInput(ctx) {
+		// Read the files from a list instead of one huge -d argument
+		listFile, err := linter.WriteTempConfig(l.ToolsDir, "pmd-files", []byte(strings.Join(files, "\n")+"\n"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to write file list: %w", err)
+		}
+		defer func() { _ = os.Remove(listFile) }()
+		args = append(args, "--file-list", listFile)
+	} else {
+		args = append(args, "-d", strings.Join(files, ",")) // Comma-separated file list
+	}
+	args = append(args,
 		"-R", rulesetFile,
 		"-f", "json", // JSON output format
 		"--no-cache", // Disable cache for consistent results
-	}
+	)
 
 	// Execute (uses CWD by default)
 	start := time.Now()
@@ -78,12 +87,17 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 
 // createTempRuleset creates a temporary ruleset file.
 func (l *Linter) createTempRuleset(config []byte) (string, error) {
-	// Create temp file in tools directory
-	tempFile := filepath.Join(l.ToolsDir, "pmd-ruleset-temp.xml")
+	// Create a unique temp file in tools directory so concurrent runs do not share it
+	tempFile, err := os.CreateTemp(l.ToolsDir, "pmd-ruleset-*.xml")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tempFile.Close() }()
 
-	if err := os.WriteFile(tempFile, config, 0644); err != nil {
+	if _, err := tempFile.Write(config); err != nil {
+		_ = os.Remove(tempFile.Name())
 		return "", err
 	}
 
-	return tempFile, nil
+	return tempFile.Name(), nil
 }
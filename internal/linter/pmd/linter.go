@@ -3,8 +3,6 @@ package pmd
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,8 +11,13 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter           = (*Linter)(nil)
+	_ linter.ArtifactProvider = (*Linter)(nil)
+	_ linter.Locator          = (*Linter)(nil)
+	_ linter.Uninstaller      = (*Linter)(nil)
+)
 
 const (
 	// DefaultVersion is the default PMD version.
@@ -73,12 +76,13 @@ func (l *Linter) GetCapabilities() linter.Capabilities {
 		SupportedLanguages:  []string{"java"},
 		SupportedCategories: []string{"pattern", "complexity", "performance", "security", "error_handling", "ast"},
 		Version:             DefaultVersion,
+		FileList:            true, // --file-list
 	}
 }
 
 // CheckAvailability checks if PMD is available.
 func (l *Linter) CheckAvailability(ctx context.Context) error {
-	pmdPath := l.getPMDPath()
+	pmdPath := l.getPMDPath(ctx)
 
 	// Check if PMD binary exists
 	if _, err := os.Stat(pmdPath); os.IsNotExist(err) {
@@ -102,17 +106,11 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Determine version
-	version := config.Version
-	if version == "" {
-		version = DefaultVersion
-	}
-
-	// PMD distribution filename
-	distName := fmt.Sprintf("pmd-dist-%s-bin.zip", version)
-	url := fmt.Sprintf("%s/pmd_releases%%2F%s/%s", GitHubReleaseURL, version, distName)
+	version := config.ToolVersion(l.Name(), DefaultVersion)
+	art, _ := l.Artifact(version, "", "") // platform independent
 
 	// Destination paths
-	zipPath := filepath.Join(l.ToolsDir, distName)
+	zipPath := filepath.Join(l.ToolsDir, art.Name)
 	extractDir := filepath.Join(l.ToolsDir, fmt.Sprintf("pmd-bin-%s", version))
 
 	// Check if already exists
@@ -122,8 +120,8 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 		}
 	}
 
-	// Download
-	if err := l.downloadFile(ctx, url, zipPath); err != nil {
+	// Download (or copy from the tools source) and verify against tools.lock
+	if err := linter.FetchArtifact(ctx, config, art, zipPath); err != nil {
 		return fmt.Errorf("failed to download PMD: %w", err)
 	}
 	defer func() { _ = os.Remove(zipPath) }()
@@ -136,7 +134,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Make PMD binary executable
-	pmdBin := l.getPMDPath()
+	pmdBin := pmdBinary(extractDir)
 	if err := os.Chmod(pmdBin, 0755); err != nil {
 		return fmt.Errorf("failed to make PMD executable: %w", err)
 	}
@@ -144,6 +142,24 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	return nil
 }
 
+// Locate reports the managed PMD installation.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	pmdPath := l.getPMDPath(ctx)
+	if _, err := os.Stat(pmdPath); err != nil {
+		return linter.Installation{}, linter.ErrToolNotFound
+	}
+	return linter.Installation{
+		Path:    pmdPath,
+		Version: linter.LockedVersion(ctx, l.Name(), DefaultVersion),
+		Source:  linter.ToolSourceManaged,
+	}, nil
+}
+
+// Uninstall removes all PMD versions from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, "pmd-bin-*", "pmd-dist-*-bin.zip")
+}
+
 // Execute runs PMD with the given config and files.
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
 	return l.execute(ctx, config, files)
@@ -154,14 +170,31 @@ func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, err
 	return parseOutput(output)
 }
 
+// Artifact returns the PMD distribution. It is platform independent.
+func (l *Linter) Artifact(version, goos, goarch string) (linter.Artifact, error) {
+	if version == "" {
+		version = DefaultVersion
+	}
+	distName := fmt.Sprintf("pmd-dist-%s-bin.zip", version)
+	return linter.Artifact{
+		Tool:    l.Name(),
+		Version: version,
+		Name:    distName,
+		URL:     fmt.Sprintf("%s/pmd_releases%%2F%s/%s", GitHubReleaseURL, version, distName),
+	}, nil
+}
+
 // getPMDPath returns the path to PMD binary.
-func (l *Linter) getPMDPath() string {
+func (l *Linter) getPMDPath(ctx context.Context) string {
 	if l.PMDPath != "" {
 		return l.PMDPath
 	}
 
-	pmdDir := filepath.Join(l.ToolsDir, fmt.Sprintf("pmd-bin-%s", DefaultVersion))
+	return pmdBinary(filepath.Join(l.ToolsDir, fmt.Sprintf("pmd-bin-%s", linter.LockedVersion(ctx, l.Name(), DefaultVersion))))
+}
 
+// pmdBinary returns the PMD launcher in an extracted distribution.
+func pmdBinary(pmdDir string) string {
 	// PMD binary name depends on OS
 	binName := "pmd"
 	if runtime.GOOS == "windows" {
@@ -170,43 +203,3 @@ func (l *Linter) getPMDPath() string {
 
 	return filepath.Join(pmdDir, "bin", binName)
 }
-
-// downloadFile downloads a file from URL to destPath.
-func (l *Linter) downloadFile(ctx context.Context, url, destPath string) error {
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("download failed: HTTP %d", resp.StatusCode)
-	}
-
-	// Create temp file
-	tempFile := destPath + ".tmp"
-	out, err := os.Create(tempFile)
-	if err != nil {
-		return err
-	}
-	defer func() { _ = out.Close() }()
-
-	// Copy content
-	if _, err := io.Copy(out, resp.Body); err != nil {
-		_ = os.Remove(tempFile)
-		return err
-	}
-
-	// Rename temp to final
-	if err := os.Rename(tempFile, destPath); err != nil {
-		_ = os.Remove(tempFile)
-		return err
-	}
-
-	return nil
-}
@@ -80,6 +80,29 @@ func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule,
 	}, nil
 }
 
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[prettierRuleData](data)
+}
+
+// ConvertNative uses native Prettier options as is: {"singleQuote": true, "printWidth": 120}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var options map[string]interface{}
+	if err := json.Unmarshal(native, &options); err != nil {
+		return nil, fmt.Errorf("native config must be an object of Prettier options: %w", err)
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("native config has no options")
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: prettierRuleData{
+			Options: options,
+		},
+	}, nil
+}
+
 // BuildConfig assembles Prettier configuration from successful rule conversions.
 func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
 	if len(results) == 0 {
@@ -167,6 +190,7 @@ Output:
 }`
 
 	userPrompt := fmt.Sprintf("Convert this rule to Prettier configuration:\n\n%s", rule.Say)
+	userPrompt += linter.ParamsPrompt(rule.Params)
 
 	// Call LLM
 	prompt := systemPrompt + "\n\n" + userPrompt
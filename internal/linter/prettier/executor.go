@@ -2,10 +2,13 @@ package prettier
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
@@ -16,6 +19,13 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string, mod
 		return &linter.ToolOutput{ExitCode: 0}, nil
 	}
 
+	if linter.ProjectConfigMerge(ctx) {
+		var err error
+		if config, err = mergeProjectConfig(config); err != nil {
+			return nil, fmt.Errorf("failed to merge project config: %w", err)
+		}
+	}
+
 	// Write config to temp file
 	configPath, err := l.writeConfigFile(config)
 	if err != nil {
@@ -41,8 +51,6 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string, mod
 	args = append(args, files...)
 
 	// Execute
-	// Reset WorkDir to use CWD (Install() may have set it to ToolsDir)
-	l.executor.WorkDir = ""
 	output, err := l.executor.Execute(ctx, prettierCmd, args...)
 
 	// Prettier returns non-zero exit code if files need formatting (in --check mode)
@@ -54,14 +62,55 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string, mod
 	return output, nil
 }
 
+// resolvePrettier locates Prettier: project node_modules, PATH, then tools dir.
+func (l *Linter) resolvePrettier() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "prettier",
+		Node:         true,
+		ManagedPaths: []string{l.getPrettierPath()},
+	})
+}
+
 func (l *Linter) getPrettierCommand() string {
-	localPath := l.getPrettierPath()
-	if _, err := os.Stat(localPath); err == nil {
-		return localPath
+	if tool := l.resolvePrettier(); tool.Found() {
+		return tool.Path
 	}
 	return "prettier"
 }
 
+// mergeProjectConfig layers the generated options on top of the project's
+// Prettier config (.prettierrc, .prettierrc.json, .prettierrc.yaml).
+// Generated options win. The config is returned unchanged if there is none.
+func mergeProjectConfig(config []byte) ([]byte, error) {
+	path := linter.FindProjectFile(".prettierrc", ".prettierrc.json", ".prettierrc.yaml", ".prettierrc.yml")
+	if path == "" {
+		return config, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// YAML is a superset of JSON, so one decoder handles every format
+	var merged map[string]interface{}
+	if err := yaml.Unmarshal(data, &merged); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if merged == nil {
+		merged = make(map[string]interface{})
+	}
+
+	var generated map[string]interface{}
+	if err := json.Unmarshal(config, &generated); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	for key, value := range generated {
+		merged[key] = value
+	}
+
+	return json.Marshal(merged)
+}
+
 func (l *Linter) writeConfigFile(config []byte) (string, error) {
 	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
 	if err := os.MkdirAll(tmpDir, 0755); err != nil {
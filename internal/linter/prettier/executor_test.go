@@ -123,3 +123,29 @@ func TestExecute_Integration(t *testing.T) {
 		t.Skip("Skipping: Prettier not available in this environment")
 	}
 }
+
+func TestMergeProjectConfig(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(root)
+
+	// No project config: unchanged
+	config := []byte(`{"semi":false}`)
+	merged, err := mergeProjectConfig(config)
+	if err != nil || string(merged) != string(config) {
+		t.Errorf("mergeProjectConfig() = %s, %v; want unchanged", merged, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, ".prettierrc"), []byte("semi: true\ntabWidth: 4\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	merged, err = mergeProjectConfig(config)
+	if err != nil {
+		t.Fatalf("mergeProjectConfig() error = %v", err)
+	}
+	if string(merged) != `{"semi":false,"tabWidth":4}` {
+		t.Errorf("mergeProjectConfig() = %s, want generated options over project options", merged)
+	}
+}
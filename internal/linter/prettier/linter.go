@@ -11,8 +11,13 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Fixer       = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
 
 // Linter wraps Prettier for code formatting.
 //
@@ -58,17 +63,11 @@ func (l *Linter) GetCapabilities() linter.Capabilities {
 
 // CheckAvailability checks if Prettier is installed.
 func (l *Linter) CheckAvailability(ctx context.Context) error {
-	prettierPath := l.getPrettierPath()
-	if _, err := os.Stat(prettierPath); err == nil {
-		return nil
-	}
-
-	cmd := exec.CommandContext(ctx, "prettier", "--version")
-	if err := cmd.Run(); err == nil {
+	if l.resolvePrettier().Found() {
 		return nil
 	}
 
-	return fmt.Errorf("prettier not found")
+	return fmt.Errorf("prettier not found (checked: project node_modules, global PATH and %s)", l.getPrettierPath())
 }
 
 // Install installs Prettier via npm.
@@ -81,10 +80,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 		return fmt.Errorf("npm not found: please install Node.js first")
 	}
 
-	version := config.Version
-	if version == "" {
-		version = "^3.0.0"
-	}
+	version := config.ToolVersion(l.Name(), "^3.0.0")
 
 	// Init package.json if needed
 	packageJSON := filepath.Join(l.ToolsDir, "package.json")
@@ -94,11 +90,20 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 		}
 	}
 
-	l.executor.WorkDir = l.ToolsDir
-	_, err := l.executor.Execute(ctx, "npm", "install", fmt.Sprintf("prettier@%s", version))
+	_, err := l.executor.Run(ctx, "npm", []string{"install", fmt.Sprintf("prettier@%s", version)}, linter.WithWorkDir(l.ToolsDir))
 	return err
 }
 
+// Locate reports the Prettier installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolvePrettier(), "--version")
+}
+
+// Uninstall removes Prettier from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.NpmUninstall(ctx, l.ToolsDir, "prettier")
+}
+
 // Execute runs Prettier with the given config and files.
 // mode: "check" or "write"
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
@@ -110,6 +115,18 @@ func (l *Linter) ExecuteWithMode(ctx context.Context, config []byte, files []str
 	return l.execute(ctx, config, files, mode)
 }
 
+// RuleConfig returns the config unchanged. Prettier options are not separate
+// rules: --write reformats whole files, and dropping the other options would
+// reformat them with Prettier's defaults instead.
+func (l *Linter) RuleConfig(config []byte, _ []string) ([]byte, error) {
+	return config, nil
+}
+
+// Fix rewrites files in place with Prettier (--write mode).
+func (l *Linter) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	return l.execute(ctx, config, files, "write")
+}
+
 // ParseOutput converts Prettier output to violations.
 func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
 	return parseOutput(output)
@@ -0,0 +1,276 @@
+package linter
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Tool sources, in resolution order.
+const (
+	ToolSourceProject = "project" // <project>/node_modules/.bin
+	ToolSourceVenv    = "venv"    // $VIRTUAL_ENV, <project>/.venv or <project>/venv
+	ToolSourceGoTool  = "go-tool" // tool directive in go.mod, run via `go tool`
+	ToolSourcePath    = "path"    // global PATH
+	ToolSourceManaged = "managed" // installed by Symphony into ~/.sym/tools
+	ToolSourceBuiltin = "builtin" // in-process engine, nothing to install
+)
+
+// ToolLookup describes where a tool may be installed.
+type ToolLookup struct {
+	// Name is the executable name (e.g., "eslint", "ruff")
+	Name string
+
+	// Node looks in the project's node_modules/.bin
+	Node bool
+
+	// Python looks in the active or project-local virtualenv
+	Python bool
+
+	// GoTool looks for a go.mod tool directive whose package ends in Name
+	GoTool bool
+
+	// ManagedPaths are the locations Symphony installs the tool to, in order
+	ManagedPaths []string
+}
+
+// ToolCommand is a resolved tool invocation.
+type ToolCommand struct {
+	// Path is the executable to run (empty if the tool was not found)
+	Path string
+
+	// Args are leading arguments (e.g., "tool", "<pkg>" for go tool)
+	Args []string
+
+	// Source is where the tool was found (ToolSource* constants)
+	Source string
+}
+
+// Found reports whether the tool was located.
+func (t ToolCommand) Found() bool {
+	return t.Path != ""
+}
+
+// Command returns the executable and its arguments followed by args.
+func (t ToolCommand) Command(args ...string) (string, []string) {
+	full := make([]string, 0, len(t.Args)+len(args))
+	full = append(full, t.Args...)
+	full = append(full, args...)
+	return t.Path, full
+}
+
+// ResolveTool locates a tool from the current working directory.
+// See ResolveToolFrom for the resolution order.
+func ResolveTool(lookup ToolLookup) ToolCommand {
+	dir, err := os.Getwd()
+	if err != nil {
+		dir = "."
+	}
+	return ResolveToolFrom(dir, lookup)
+}
+
+// ResolveToolFrom locates a tool, preferring the project's own installation so
+// that its plugins and pinned version are used:
+//
+//  1. project node_modules/.bin (Node tools)
+//  2. virtualenv (Python tools) or go.mod tool directive (Go tools)
+//  3. global PATH
+//  4. Symphony-managed installation
+//
+// Project directories are searched from dir up to the repository root.
+// Returns a zero ToolCommand if the tool is not found.
+func ResolveToolFrom(dir string, lookup ToolLookup) ToolCommand {
+	dirs := ProjectDirs(dir)
+
+	if lookup.Node {
+		for _, d := range dirs {
+			if p := executableIn(filepath.Join(d, "node_modules", ".bin"), lookup.Name); p != "" {
+				return ToolCommand{Path: p, Source: ToolSourceProject}
+			}
+		}
+	}
+
+	if lookup.Python {
+		for _, venv := range venvDirs(dirs) {
+			if p := executableIn(venvBinDir(venv), lookup.Name); p != "" {
+				return ToolCommand{Path: p, Source: ToolSourceVenv}
+			}
+		}
+	}
+
+	if lookup.GoTool {
+		if pkg := goModTool(dirs, lookup.Name); pkg != "" {
+			if goPath, err := exec.LookPath("go"); err == nil {
+				return ToolCommand{Path: goPath, Args: []string{"tool", pkg}, Source: ToolSourceGoTool}
+			}
+		}
+	}
+
+	if p, err := exec.LookPath(lookup.Name); err == nil {
+		return ToolCommand{Path: p, Source: ToolSourcePath}
+	}
+
+	for _, p := range lookup.ManagedPaths {
+		if _, err := os.Stat(p); err == nil {
+			return ToolCommand{Path: p, Source: ToolSourceManaged}
+		}
+	}
+
+	return ToolCommand{}
+}
+
+// ProjectDirs returns dir and its parents up to the repository root
+// (the first directory containing .git) or the filesystem root.
+func ProjectDirs(dir string) []string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return []string{dir}
+	}
+
+	var dirs []string
+	for {
+		dirs = append(dirs, abs)
+		if _, err := os.Stat(filepath.Join(abs, ".git")); err == nil {
+			break
+		}
+		parent := filepath.Dir(abs)
+		if parent == abs {
+			break
+		}
+		abs = parent
+	}
+	return dirs
+}
+
+// FindProjectFile returns the path of the first of names found in the current
+// directory or its parents up to the repository root. Returns "" if none exist.
+func FindProjectFile(names ...string) string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for _, d := range ProjectDirs(dir) {
+		for _, name := range names {
+			p := filepath.Join(d, name)
+			if info, err := os.Stat(p); err == nil && !info.IsDir() {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+// executableIn returns the path of name in binDir, or "" if absent.
+func executableIn(binDir, name string) string {
+	candidates := []string{name}
+	if runtime.GOOS == "windows" {
+		candidates = []string{name + ".cmd", name + ".exe", name}
+	}
+	for _, c := range candidates {
+		p := filepath.Join(binDir, c)
+		if info, err := os.Stat(p); err == nil && !info.IsDir() {
+			return p
+		}
+	}
+	return ""
+}
+
+// venvDirs returns candidate virtualenvs: the active one first, then
+// .venv and venv in each project directory.
+func venvDirs(dirs []string) []string {
+	var venvs []string
+	if active := os.Getenv("VIRTUAL_ENV"); active != "" {
+		venvs = append(venvs, active)
+	}
+	for _, d := range dirs {
+		venvs = append(venvs, filepath.Join(d, ".venv"), filepath.Join(d, "venv"))
+	}
+	return venvs
+}
+
+// venvBinDir returns the executables directory of a virtualenv.
+func venvBinDir(venv string) string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(venv, "Scripts")
+	}
+	return filepath.Join(venv, "bin")
+}
+
+// goModTool returns the package of a go.mod tool directive whose last path
+// element is name, searching the nearest go.mod. Returns "" if none.
+func goModTool(dirs []string, name string) string {
+	for _, d := range dirs {
+		f, err := os.Open(filepath.Join(d, "go.mod"))
+		if err != nil {
+			continue
+		}
+		defer func() { _ = f.Close() }()
+
+		inBlock := false
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if i := strings.Index(line, "//"); i >= 0 {
+				line = strings.TrimSpace(line[:i])
+			}
+
+			var pkg string
+			switch {
+			case inBlock && line == ")":
+				inBlock = false
+			case inBlock:
+				pkg = line
+			case line == "tool (":
+				inBlock = true
+			case strings.HasPrefix(line, "tool "):
+				pkg = strings.TrimSpace(strings.TrimPrefix(line, "tool "))
+			}
+			if pkg != "" && path.Base(pkg) == name {
+				return pkg
+			}
+		}
+		// Only the nearest module applies
+		return ""
+	}
+	return ""
+}
+
+// ===== Project Config Merge =====
+
+type mergeProjectConfigKey struct{}
+
+// WithProjectConfigMerge returns a context asking linters to layer the
+// Symphony-generated config on top of the project's existing config instead
+// of replacing it, so project plugins and parser settings keep working.
+// Linters without merge support ignore it.
+func WithProjectConfigMerge(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mergeProjectConfigKey{}, true)
+}
+
+// ProjectConfigMerge reports whether ctx requests project config merging.
+func ProjectConfigMerge(ctx context.Context) bool {
+	merge, _ := ctx.Value(mergeProjectConfigKey{}).(bool)
+	return merge
+}
+
+// ===== Project Dir =====
+
+type projectDirKey struct{}
+
+// WithProjectDir returns a context asking linters that check a whole project
+// (e.g., golangci-lint's ./...) to run in dir instead of the current directory.
+// Other linters ignore it.
+func WithProjectDir(ctx context.Context, dir string) context.Context {
+	return context.WithValue(ctx, projectDirKey{}, dir)
+}
+
+// ProjectDir returns the project directory set by WithProjectDir, or "".
+func ProjectDir(ctx context.Context) string {
+	dir, _ := ctx.Value(projectDirKey{}).(string)
+	return dir
+}
@@ -0,0 +1,141 @@
+package linter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// newProject creates a repository root with a nested working directory.
+func newProject(t *testing.T) (root, sub string) {
+	t.Helper()
+	root = t.TempDir()
+	sub = filepath.Join(root, "packages", "web")
+	for _, dir := range []string{filepath.Join(root, ".git"), sub} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root, sub
+}
+
+func writeExecutable(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveToolFrom_Order(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses POSIX executable names")
+	}
+	root, sub := newProject(t)
+	t.Setenv("PATH", t.TempDir())
+	t.Setenv("VIRTUAL_ENV", "")
+
+	managed := filepath.Join(t.TempDir(), "node_modules", ".bin", "sym-test-tool")
+	writeExecutable(t, managed)
+	lookup := ToolLookup{Name: "sym-test-tool", Node: true, Python: true, ManagedPaths: []string{managed}}
+
+	if got := ResolveToolFrom(sub, lookup); got.Source != ToolSourceManaged || got.Path != managed {
+		t.Errorf("managed only: got %+v", got)
+	}
+
+	pathDir := t.TempDir()
+	writeExecutable(t, filepath.Join(pathDir, "sym-test-tool"))
+	t.Setenv("PATH", pathDir)
+	if got := ResolveToolFrom(sub, lookup); got.Source != ToolSourcePath {
+		t.Errorf("PATH should win over managed: got %+v", got)
+	}
+
+	venvTool := filepath.Join(root, ".venv", "bin", "sym-test-tool")
+	writeExecutable(t, venvTool)
+	if got := ResolveToolFrom(sub, lookup); got.Source != ToolSourceVenv || got.Path != venvTool {
+		t.Errorf("venv should win over PATH: got %+v", got)
+	}
+
+	nodeTool := filepath.Join(root, "node_modules", ".bin", "sym-test-tool")
+	writeExecutable(t, nodeTool)
+	if got := ResolveToolFrom(sub, lookup); got.Source != ToolSourceProject || got.Path != nodeTool {
+		t.Errorf("project node_modules should win: got %+v", got)
+	}
+}
+
+func TestResolveToolFrom_NotFound(t *testing.T) {
+	_, sub := newProject(t)
+	t.Setenv("PATH", t.TempDir())
+
+	got := ResolveToolFrom(sub, ToolLookup{Name: "sym-missing-tool", Node: true, ManagedPaths: []string{"/nonexistent/tool"}})
+	if got.Found() {
+		t.Errorf("ResolveToolFrom() = %+v, want not found", got)
+	}
+}
+
+func TestGoModTool(t *testing.T) {
+	root, sub := newProject(t)
+	goMod := `module example.com/app
+
+go 1.24
+
+tool github.com/golangci/golangci-lint/v2/cmd/golangci-lint
+
+tool (
+	golang.org/x/tools/cmd/stringer // codegen
+)
+`
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte(goMod), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dirs := ProjectDirs(sub)
+	if got := goModTool(dirs, "golangci-lint"); got != "github.com/golangci/golangci-lint/v2/cmd/golangci-lint" {
+		t.Errorf("goModTool(golangci-lint) = %q", got)
+	}
+	if got := goModTool(dirs, "stringer"); got != "golang.org/x/tools/cmd/stringer" {
+		t.Errorf("goModTool(stringer) = %q", got)
+	}
+	if got := goModTool(dirs, "staticcheck"); got != "" {
+		t.Errorf("goModTool(staticcheck) = %q, want empty", got)
+	}
+}
+
+func TestToolCommand_Command(t *testing.T) {
+	tool := ToolCommand{Path: "go", Args: []string{"tool", "example.com/lint"}}
+	name, args := tool.Command("run", "./...")
+	if name != "go" || len(args) != 4 || args[0] != "tool" || args[3] != "./..." {
+		t.Errorf("Command() = %s %v", name, args)
+	}
+}
+
+func TestFindProjectFile(t *testing.T) {
+	root, sub := newProject(t)
+	if err := os.WriteFile(filepath.Join(root, "tsconfig.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(sub)
+
+	want, _ := filepath.EvalSymlinks(filepath.Join(root, "tsconfig.json"))
+	got, _ := filepath.EvalSymlinks(FindProjectFile("tsconfig.json"))
+	if got != want {
+		t.Errorf("FindProjectFile() = %q, want %q", got, want)
+	}
+	if got := FindProjectFile(".eslintrc.json"); got != "" {
+		t.Errorf("FindProjectFile() = %q, want empty", got)
+	}
+}
+
+func TestProjectConfigMerge(t *testing.T) {
+	ctx := context.Background()
+	if ProjectConfigMerge(ctx) {
+		t.Error("merge should be off by default")
+	}
+	if !ProjectConfigMerge(WithProjectConfigMerge(ctx)) {
+		t.Error("merge should be on after WithProjectConfigMerge")
+	}
+}
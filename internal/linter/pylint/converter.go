@@ -47,6 +47,7 @@ func (c *Converter) GetRoutingHints() []string {
 		"For Python code quality (docstrings, complexity, unused vars) → use pylint",
 		"For Python style (line length, import order) → use pylint",
 		"For Python error handling (bare except, broad except) → use pylint",
+		"If ruff is available and the rule has a Ruff rule code → use ruff instead of pylint",
 	}
 }
 
@@ -86,6 +87,28 @@ func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule,
 	}, nil
 }
 
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[pylintRuleData](data)
+}
+
+// ConvertNative enables a Pylint message by symbol with its pylintrc options:
+// {"line-too-long": {"max-line-length": 120}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	symbol, options, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: pylintRuleData{
+			Symbol:  symbol,
+			Options: options,
+		},
+	}, nil
+}
+
 // BuildConfig assembles Pylint configuration from successful rule conversions.
 func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
 	if len(results) == 0 {
@@ -197,6 +220,7 @@ Output:
 }`
 
 	userPrompt := fmt.Sprintf("Convert this rule to Pylint configuration:\n\n%s", rule.Say)
+	userPrompt += linter.ParamsPrompt(rule.Params)
 	if rule.Severity != "" {
 		userPrompt += fmt.Sprintf("\nSeverity: %s", rule.Severity)
 	}
@@ -230,7 +254,47 @@ Output:
 		return "", nil, nil
 	}
 
-	return result.Symbol, result.Options, nil
+	return result.Symbol, applyParams(result.Symbol, result.Options, rule.Params), nil
+}
+
+// RuleParams returns the threshold params applied to Pylint's bounded messages.
+func (c *Converter) RuleParams() []linter.RuleParam {
+	return []linter.RuleParam{linter.MaxParam, linter.MinParam}
+}
+
+// thresholdOptions maps rule params to the pylintrc options bounding each message.
+var thresholdOptions = map[string]map[string]string{
+	"line-too-long":                {"max": "max-line-length"},
+	"too-few-public-methods":       {"min": "min-public-methods"},
+	"too-many-arguments":           {"max": "max-args"},
+	"too-many-boolean-expressions": {"max": "max-bool-expr"},
+	"too-many-branches":            {"max": "max-branches"},
+	"too-many-instance-attributes": {"max": "max-attributes"},
+	"too-many-lines":               {"max": "max-module-lines"},
+	"too-many-locals":              {"max": "max-locals"},
+	"too-many-nested-blocks":       {"max": "max-nested-blocks"},
+	"too-many-public-methods":      {"max": "max-public-methods"},
+	"too-many-return-statements":   {"max": "max-returns"},
+	"too-many-statements":          {"max": "max-statements"},
+}
+
+// applyParams writes threshold params into the options of a bounded message,
+// replacing the values the LLM chose.
+func applyParams(symbol string, options map[string]interface{}, params map[string]any) map[string]interface{} {
+	bounds, ok := thresholdOptions[symbol]
+	if !ok || len(params) == 0 {
+		return options
+	}
+	result := make(map[string]interface{}, len(options)+len(bounds))
+	for key, value := range options {
+		result[key] = value
+	}
+	for param, option := range bounds {
+		if n, ok := linter.IntParam(params, param); ok {
+			result[option] = n
+		}
+	}
+	return result
 }
 
 // generatePylintRC generates .pylintrc content in INI format
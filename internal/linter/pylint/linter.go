@@ -12,8 +12,12 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
 
 // Linter wraps Pylint for Python static analysis.
 //
@@ -77,19 +81,11 @@ func (l *Linter) GetCapabilities() linter.Capabilities {
 
 // CheckAvailability checks if Pylint is installed.
 func (l *Linter) CheckAvailability(ctx context.Context) error {
-	// Try local installation first (virtualenv)
-	pylintPath := l.getPylintPath()
-	if _, err := os.Stat(pylintPath); err == nil {
-		return nil // Found in tools dir
-	}
-
-	// Try global installation
-	cmd := exec.CommandContext(ctx, "pylint", "--version")
-	if err := cmd.Run(); err == nil {
-		return nil // Found globally
+	if l.resolvePylint().Found() {
+		return nil
 	}
 
-	return fmt.Errorf("pylint not found (checked: %s and global PATH)", pylintPath)
+	return fmt.Errorf("pylint not found (checked: project virtualenv, global PATH and %s)", l.getPylintPath())
 }
 
 // Install installs Pylint via pip in a virtualenv.
@@ -163,10 +159,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Determine version
-	version := config.Version
-	if version == "" {
-		version = ">=3.0.0"
-	}
+	version := config.ToolVersion(l.Name(), ">=3.0.0")
 
 	// Install Pylint in virtualenv
 	output, err := l.executor.Execute(ctx, pipPath, "install", fmt.Sprintf("pylint%s", version))
@@ -180,6 +173,16 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	return nil
 }
 
+// Locate reports the Pylint installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolvePylint(), "--version")
+}
+
+// Uninstall removes the Pylint virtualenv from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, filepath.Base(l.getVenvPath()))
+}
+
 // Execute runs Pylint with the given config and files.
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
 	// Implementation in executor.go
@@ -240,17 +243,20 @@ func (l *Linter) getPylintCommand() string {
 		return l.PylintPath
 	}
 
-	// Try local installation first (virtualenv)
-	localPath := l.getPylintPath()
-	if _, err := os.Stat(localPath); err == nil {
-		return localPath
-	}
-
-	// Try global pylint
-	if _, err := exec.LookPath("pylint"); err == nil {
-		return "pylint"
+	if tool := l.resolvePylint(); tool.Found() {
+		return tool.Path
 	}
 
 	// Fall back to local path (will fail with proper error)
-	return localPath
+	return l.getPylintPath()
+}
+
+// resolvePylint locates Pylint: project virtualenv, PATH, then tools dir.
+// The project's virtualenv is preferred so Pylint can import its dependencies.
+func (l *Linter) resolvePylint() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "pylint",
+		Python:       true,
+		ManagedPaths: []string{l.getPylintPath()},
+	})
 }
@@ -33,6 +33,9 @@ type ToolRegistration struct {
 type Registry struct {
 	mu    sync.RWMutex
 	tools map[string]*ToolRegistration
+
+	// parent is consulted for tools not registered here (nil for the global registry)
+	parent *Registry
 }
 
 var (
@@ -50,6 +53,17 @@ func Global() *Registry {
 	return globalRegistry
 }
 
+// NewRegistry returns an empty registry layered over parent: tools registered
+// with it are visible only through it, and every other lookup falls through to
+// parent. It lets a package add its own tools (e.g., linter plugins) without
+// changing what other packages in the same process see.
+func NewRegistry(parent *Registry) *Registry {
+	return &Registry{
+		tools:  make(map[string]*ToolRegistration),
+		parent: parent,
+	}
+}
+
 // RegisterTool registers a tool with linter, converter, and config file.
 func (r *Registry) RegisterTool(
 	l Linter,
@@ -66,7 +80,7 @@ func (r *Registry) RegisterTool(
 	defer r.mu.Unlock()
 
 	// Warn on duplicate registration (init order issues)
-	if _, exists := r.tools[name]; exists {
+	if _, exists := r.lookup(name); exists {
 		log.Printf("warning: linter already registered: %s (ignoring duplicate)", name)
 		return nil
 	}
@@ -80,12 +94,46 @@ func (r *Registry) RegisterTool(
 	return nil
 }
 
+// lookup returns the registration for name, falling back to the parent.
+// The caller must hold r.mu.
+func (r *Registry) lookup(name string) (*ToolRegistration, bool) {
+	if reg, ok := r.tools[name]; ok {
+		return reg, true
+	}
+	if r.parent != nil {
+		r.parent.mu.RLock()
+		defer r.parent.mu.RUnlock()
+		return r.parent.lookup(name)
+	}
+	return nil, false
+}
+
+// all returns every registration by name, including the parent's.
+// The caller must hold r.mu.
+func (r *Registry) all() map[string]*ToolRegistration {
+	if r.parent == nil {
+		return r.tools
+	}
+
+	r.parent.mu.RLock()
+	tools := make(map[string]*ToolRegistration)
+	for name, reg := range r.parent.all() {
+		tools[name] = reg
+	}
+	r.parent.mu.RUnlock()
+
+	for name, reg := range r.tools {
+		tools[name] = reg
+	}
+	return tools
+}
+
 // GetLinter finds a linter by tool name (e.g., "eslint", "prettier", "tsc").
 func (r *Registry) GetLinter(toolName string) (Linter, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if reg, ok := r.tools[toolName]; ok {
+	if reg, ok := r.lookup(toolName); ok {
 		return reg.Linter, nil
 	}
 
@@ -97,7 +145,7 @@ func (r *Registry) GetConverter(name string) (Converter, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if reg, ok := r.tools[name]; ok && reg.Converter != nil {
+	if reg, ok := r.lookup(name); ok && reg.Converter != nil {
 		return reg.Converter, true
 	}
 	return nil, false
@@ -108,7 +156,7 @@ func (r *Registry) GetConfigFile(name string) string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if reg, ok := r.tools[name]; ok {
+	if reg, ok := r.lookup(name); ok {
 		return reg.ConfigFile
 	}
 	return ""
@@ -120,7 +168,7 @@ func (r *Registry) BuildLanguageMapping() map[string][]string {
 	defer r.mu.RUnlock()
 
 	mapping := make(map[string][]string)
-	for name, reg := range r.tools {
+	for name, reg := range r.all() {
 		caps := reg.Linter.GetCapabilities()
 		for _, lang := range caps.SupportedLanguages {
 			mapping[lang] = append(mapping[lang], name)
@@ -134,8 +182,9 @@ func (r *Registry) GetAllToolNames() []string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	names := make([]string, 0, len(r.tools))
-	for name := range r.tools {
+	tools := r.all()
+	names := make([]string, 0, len(tools))
+	for name := range tools {
 		names = append(names, name)
 	}
 	return names
@@ -146,8 +195,9 @@ func (r *Registry) GetAllConfigFiles() []string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	files := make([]string, 0, len(r.tools))
-	for _, reg := range r.tools {
+	tools := r.all()
+	files := make([]string, 0, len(tools))
+	for _, reg := range tools {
 		if reg.ConfigFile != "" {
 			files = append(files, reg.ConfigFile)
 		}
@@ -160,8 +210,9 @@ func (r *Registry) GetAllConverters() []Converter {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	converters := make([]Converter, 0, len(r.tools))
-	for _, reg := range r.tools {
+	tools := r.all()
+	converters := make([]Converter, 0, len(tools))
+	for _, reg := range tools {
 		if reg.Converter != nil {
 			converters = append(converters, reg.Converter)
 		}
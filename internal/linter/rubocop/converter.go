@@ -0,0 +1,298 @@
+package rubocop
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+var (
+	copNamePattern = regexp.MustCompile(`^([A-Z][A-Za-z]+)/[A-Z][A-Za-z0-9]+$`)
+	optionPattern  = regexp.MustCompile(`^[A-Z][A-Za-z0-9]+$`)
+)
+
+// builtinDepartments are the cop departments shipped with the rubocop gem.
+// Extension departments (Rails, RSpec, Performance, ...) need extra gems.
+var builtinDepartments = map[string]bool{
+	"Bundler":   true,
+	"Gemspec":   true,
+	"Layout":    true,
+	"Lint":      true,
+	"Metrics":   true,
+	"Migration": true,
+	"Naming":    true,
+	"Security":  true,
+	"Style":     true,
+}
+
+// Converter converts rules to RuboCop configuration using LLM
+type Converter struct{}
+
+// NewConverter creates a new RuboCop converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return "rubocop"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"ruby", "rb"}
+}
+
+// GetLLMDescription returns a description of RuboCop's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `ONLY built-in RuboCop cops for Ruby (Naming/*, Style/*, Layout/*, Metrics/*, Lint/*, Security/*)
+  - CAN: Method/variable/constant/file naming (Naming/MethodName, Naming/FileName), line and method length (Layout/LineLength, Metrics/MethodLength),
+         parameter lists and complexity (Metrics/ParameterLists, Metrics/CyclomaticComplexity), string literal style, frozen string literal comment,
+         eval and unsafe loading (Security/Eval, Security/YAMLLoad), debugger calls (Lint/Debugger), rescue style
+  - CANNOT: Rails/RSpec-specific cops (extensions not installed), business logic, cross-file architecture`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use RuboCop
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For Ruby naming, style, length or complexity rules → use rubocop",
+		"For Ruby security checks (eval, YAML.load, open) → use rubocop",
+		"For Rails- or RSpec-specific conventions → use llm-validator (RuboCop extensions are not installed)",
+	}
+}
+
+// rubocopRuleData holds RuboCop-specific conversion data
+type rubocopRuleData struct {
+	Cop     string
+	Options map[string]interface{}
+}
+
+// ConvertSingleRule converts ONE user rule to a RuboCop cop configuration.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by RuboCop (skip),
+//	(nil, error) on actual conversion error.
+//
+// Note: Concurrency is handled by the main converter.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		Cop     string                 `json:"cop"`
+		Options map[string]interface{} `json:"options"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	if result.Cop == "" {
+		return nil, nil
+	}
+	return copResult(rule, result.Cop, applyParams(result.Cop, result.Options, rule.Params))
+}
+
+// RuleParams returns the max param, applied to cops with a Max option.
+func (c *Converter) RuleParams() []linter.RuleParam {
+	return []linter.RuleParam{linter.MaxParam}
+}
+
+// boundedCops are the built-in cops whose Max option holds their bound.
+var boundedCops = map[string]bool{
+	"Layout/LineLength":            true,
+	"Metrics/AbcSize":              true,
+	"Metrics/BlockLength":          true,
+	"Metrics/BlockNesting":         true,
+	"Metrics/ClassLength":          true,
+	"Metrics/CyclomaticComplexity": true,
+	"Metrics/MethodLength":         true,
+	"Metrics/ModuleLength":         true,
+	"Metrics/ParameterLists":       true,
+	"Metrics/PerceivedComplexity":  true,
+}
+
+// applyParams sets Max of a bounded cop from the max param, replacing the
+// value the LLM chose.
+func applyParams(cop string, options map[string]interface{}, params map[string]any) map[string]interface{} {
+	n, ok := linter.IntParam(params, linter.MaxParam.Name)
+	if !ok || !boundedCops[cop] {
+		return options
+	}
+	result := make(map[string]interface{}, len(options)+1)
+	for key, value := range options {
+		result[key] = value
+	}
+	result["Max"] = n
+	return result
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[rubocopRuleData](data)
+}
+
+// ConvertNative enables a built-in cop with its .rubocop.yml options:
+// {"Style/StringLiterals": {"EnforcedStyle": "single_quotes"}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	cop, options, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+	return copResult(rule, cop, options)
+}
+
+// copResult validates a cop and its options and enables it at the rule's severity.
+func copResult(rule schema.UserRule, cop string, copOptions map[string]interface{}) (*linter.SingleRuleResult, error) {
+	m := copNamePattern.FindStringSubmatch(cop)
+	if m == nil {
+		return nil, fmt.Errorf("invalid RuboCop cop name %q", cop)
+	}
+	if !builtinDepartments[m[1]] {
+		return nil, fmt.Errorf("RuboCop department %q requires an extension gem", m[1])
+	}
+
+	options := make(map[string]interface{}, len(copOptions)+2)
+	for key, value := range copOptions {
+		if !optionPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid RuboCop option %q", key)
+		}
+		options[key] = value
+	}
+	options["Enabled"] = true
+	options["Severity"] = mapPolicySeverity(rule.Severity)
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: rubocopRuleData{
+			Cop:     cop,
+			Options: options,
+		},
+		NativeRuleIDs: []string{cop},
+	}, nil
+}
+
+// BuildConfig assembles .rubocop.yml from successful rule conversions.
+// All cops are disabled by default, so only converted cops run.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	config := make(map[string]interface{})
+	for _, r := range results {
+		data, ok := r.Data.(rubocopRuleData)
+		if !ok {
+			continue
+		}
+		existing, _ := config[data.Cop].(map[string]interface{})
+		if existing == nil {
+			existing = make(map[string]interface{})
+		}
+		for key, value := range data.Options {
+			existing[key] = value
+		}
+		config[data.Cop] = existing
+	}
+
+	if len(config) == 0 {
+		return nil, nil
+	}
+
+	config["AllCops"] = map[string]interface{}{
+		"DisabledByDefault": true,
+		"NewCops":           "disable",
+		"SuggestExtensions": false,
+	}
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal YAML config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: ".rubocop.yml",
+		Content:  append([]byte("# Generated by Symphony CLI\n"), content...),
+		Format:   "yaml",
+	}, nil
+}
+
+// buildPrompt builds the RuboCop rule conversion prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	prompt := `You are a RuboCop configuration expert. Convert the natural language Ruby coding rule to ONE built-in RuboCop cop.
+
+Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "cop": "Department/CopName",
+  "options": {"OptionName": value, ...}
+}
+
+Use only departments shipped with the rubocop gem: Bundler, Gemspec, Layout, Lint, Metrics, Migration, Naming, Security, Style.
+Options use RuboCop's PascalCase keys (e.g., "Max", "EnforcedStyle", "AllowedPatterns"). Do NOT set "Enabled" or "Severity".
+
+Common cops:
+- Naming: Naming/MethodName, Naming/VariableName, Naming/ConstantName, Naming/FileName, Naming/PredicateName
+- Length: Layout/LineLength (Max), Metrics/MethodLength (Max), Metrics/ClassLength (Max), Metrics/ParameterLists (Max)
+- Complexity: Metrics/CyclomaticComplexity (Max), Metrics/AbcSize (Max), Metrics/BlockNesting (Max)
+- Style: Style/StringLiterals (EnforcedStyle: single_quotes|double_quotes), Style/FrozenStringLiteralComment,
+  Style/Documentation, Style/GlobalVars, Style/RescueStandardError
+- Lint/Security: Lint/Debugger, Lint/SuppressedException, Security/Eval, Security/YAMLLoad, Security/Open
+
+If the rule cannot be expressed with a built-in cop, return:
+{
+  "cop": "",
+  "options": null
+}
+
+Examples:
+
+Input: "Methods must not exceed 20 lines"
+Output:
+{
+  "cop": "Metrics/MethodLength",
+  "options": {"Max": 20}
+}
+
+Input: "Use single quotes for strings without interpolation"
+Output:
+{
+  "cop": "Style/StringLiterals",
+  "options": {"EnforcedStyle": "single_quotes"}
+}`
+
+	prompt += fmt.Sprintf("\n\nConvert this rule to RuboCop configuration:\n\n%s", rule.Say)
+	prompt += linter.ParamsPrompt(rule.Params)
+	return prompt
+}
+
+// mapPolicySeverity maps a policy severity to a RuboCop severity.
+func mapPolicySeverity(severity string) string {
+	switch strings.ToLower(severity) {
+	case "warning", "warn":
+		return "warning"
+	case "info":
+		return "info"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,145 @@
+package rubocop
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(_ context.Context, prompt string, _ llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) Close() error { return nil }
+
+func TestConvertSingleRule(t *testing.T) {
+	provider := &mockProvider{response: `{"cop": "Metrics/MethodLength", "options": {"Max": 20}}`}
+	rule := schema.UserRule{ID: "RB-LEN", Say: "Methods must not exceed 20 lines", Severity: "warning"}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("ConvertSingleRule() returned nil result")
+	}
+	if len(result.NativeRuleIDs) != 1 || result.NativeRuleIDs[0] != "Metrics/MethodLength" {
+		t.Errorf("NativeRuleIDs = %v", result.NativeRuleIDs)
+	}
+	options := result.Data.(rubocopRuleData).Options
+	if options["Max"] != float64(20) || options["Enabled"] != true || options["Severity"] != "warning" {
+		t.Errorf("Options = %v", options)
+	}
+	if !strings.Contains(provider.prompt, rule.Say) {
+		t.Error("prompt should include the rule text")
+	}
+}
+
+func TestConvertSingleRule_SkipAndInvalid(t *testing.T) {
+	result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"},
+		&mockProvider{response: `{"cop": "", "options": null}`})
+	if err != nil || result != nil {
+		t.Errorf("ConvertSingleRule() = %v, %v; want nil, nil", result, err)
+	}
+
+	for _, response := range []string{
+		`not json`,
+		`{"cop": "method-length"}`,
+		`{"cop": "Rails/FindBy"}`,
+		`{"cop": "Metrics/MethodLength", "options": {"max": 20}}`,
+	} {
+		if _, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response}); err == nil {
+			t.Errorf("ConvertSingleRule(%q) expected error", response)
+		}
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: rubocopRuleData{Cop: "Metrics/MethodLength", Options: map[string]interface{}{"Max": 20, "Enabled": true, "Severity": "error"}}},
+		{RuleID: "R2", Data: rubocopRuleData{Cop: "Naming/MethodName", Options: map[string]interface{}{"Enabled": true, "Severity": "warning"}}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil {
+		t.Fatalf("BuildConfig() error = %v", err)
+	}
+	if config.Filename != ".rubocop.yml" || config.Format != "yaml" {
+		t.Errorf("config = %s (%s)", config.Filename, config.Format)
+	}
+	if !strings.HasPrefix(string(config.Content), "# Generated by Symphony CLI\n") {
+		t.Error("config should start with the generated header")
+	}
+
+	var parsed map[string]map[string]interface{}
+	if err := yaml.Unmarshal(config.Content, &parsed); err != nil {
+		t.Fatalf("config is not valid YAML: %v", err)
+	}
+	if parsed["AllCops"]["DisabledByDefault"] != true {
+		t.Errorf("AllCops = %v, want DisabledByDefault", parsed["AllCops"])
+	}
+	if parsed["Metrics/MethodLength"]["Max"] != 20 || parsed["Naming/MethodName"]["Severity"] != "warning" {
+		t.Errorf("cops = %v", parsed)
+	}
+}
+
+func TestBuildConfig_Empty(t *testing.T) {
+	config, err := NewConverter().BuildConfig(nil)
+	if err != nil || config != nil {
+		t.Errorf("BuildConfig(nil) = %v, %v; want nil, nil", config, err)
+	}
+}
+
+func TestConvertNative(t *testing.T) {
+	rule := schema.UserRule{ID: "RB-QUOTES", Say: "Use single quotes", Severity: "warning"}
+
+	result, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"Style/StringLiterals": {"EnforcedStyle": "single_quotes"}}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	if len(result.NativeRuleIDs) != 1 || result.NativeRuleIDs[0] != "Style/StringLiterals" {
+		t.Errorf("NativeRuleIDs = %v", result.NativeRuleIDs)
+	}
+	options := result.Data.(rubocopRuleData).Options
+	if options["EnforcedStyle"] != "single_quotes" || options["Enabled"] != true || options["Severity"] != "warning" {
+		t.Errorf("Options = %v", options)
+	}
+
+	for _, native := range []string{`{"Rails/Output": null}`, `{"StringLiterals": null}`, `{"Style/StringLiterals": {"bad key": 1}}`} {
+		if _, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(native)); err == nil {
+			t.Errorf("ConvertNative(%s) expected error", native)
+		}
+	}
+}
+
+func TestConvertSingleRule_Params(t *testing.T) {
+	provider := &mockProvider{response: `{"cop": "Metrics/MethodLength", "options": {"Max": 20, "CountComments": false}}`}
+	rule := schema.UserRule{ID: "RB-LEN", Say: "Methods must be short", Params: map[string]any{"max": float64(15)}}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	options := result.Data.(rubocopRuleData).Options
+	if options["Max"] != 15 || options["CountComments"] != false {
+		t.Errorf("Options = %v", options)
+	}
+	if !strings.Contains(provider.prompt, "Parameters (use these exact values): max: 15") {
+		t.Error("prompt should include the rule params")
+	}
+}
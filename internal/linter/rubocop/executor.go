@@ -0,0 +1,131 @@
+package rubocop
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// execute runs RuboCop with the given config, files and extra flags.
+func (l *Linter) execute(ctx context.Context, config []byte, files []string, extraArgs ...string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{
+			Stdout:   `{"files":[]}`,
+			ExitCode: 0,
+		}, nil
+	}
+
+	// Write config to temp file
+	configPath, err := l.writeConfigFile(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write config: %w", err)
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	args := getExecutionArgs(configPath, files, extraArgs...)
+
+	// Only the managed install needs the private GEM_HOME; a RuboCop on PATH
+	// resolves its own gems. Uses CWD by default.
+	var opts []linter.ExecOption
+	tool := l.resolveRuboCop()
+	if !tool.Found() || tool.Source == linter.ToolSourceManaged {
+		opts = l.gemEnv()
+	}
+	return linter.NewSubprocessExecutor().Run(ctx, l.getRuboCopCommand(), args, opts...)
+}
+
+// getExecutionArgs returns the RuboCop arguments.
+func getExecutionArgs(configPath string, files []string, extraArgs ...string) []string {
+	args := []string{
+		"--config", configPath, // Only the generated .rubocop.yml applies
+		"--format", "json",
+		"--force-exclusion",
+		"--cache", "false",
+	}
+	args = append(args, extraArgs...)
+	args = append(args, "--")
+	args = append(args, files...)
+
+	return args
+}
+
+// resolveRuboCop locates RuboCop: PATH, then tools dir.
+func (l *Linter) resolveRuboCop() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "rubocop",
+		ManagedPaths: []string{l.getRuboCopPath()},
+	})
+}
+
+// getRuboCopCommand returns the RuboCop command to use.
+func (l *Linter) getRuboCopCommand() string {
+	if tool := l.resolveRuboCop(); tool.Found() {
+		return tool.Path
+	}
+
+	// Fall back to local path (will fail with proper error)
+	return l.getRuboCopPath()
+}
+
+// writeConfigFile writes .rubocop.yml to a temp file.
+func (l *Linter) writeConfigFile(config []byte) (string, error) {
+	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return "", err
+	}
+
+	tmpFile, err := os.CreateTemp(tmpDir, "rubocop-*.yml")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tmpFile.Close() }()
+
+	if _, err := tmpFile.Write(config); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+
+	return tmpFile.Name(), nil
+}
+
+// keepCops narrows a .rubocop.yml config to the given cops. Keys without a
+// department ("AllCops", "inherit_from") are not cops and are kept.
+func keepCops(config []byte, cops []string) ([]byte, error) {
+	if len(cops) == 0 {
+		return nil, linter.ErrNoRuleIDs
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(config, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	keep := make(map[string]bool, len(cops))
+	for _, cop := range cops {
+		keep[cop] = true
+	}
+	found := false
+	for key := range doc {
+		switch {
+		case keep[key]:
+			found = true
+		case strings.Contains(key, "/"):
+			delete(doc, key)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("config has none of the cops %s", strings.Join(cops, ", "))
+	}
+
+	content, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal YAML config: %w", err)
+	}
+	return content, nil
+}
@@ -0,0 +1,61 @@
+package rubocop
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func TestGetExecutionArgs(t *testing.T) {
+	got := strings.Join(getExecutionArgs("/tmp/rubocop.yml", []string{"app/a.rb", "-b.rb"}, "--autocorrect"), " ")
+	want := "--config /tmp/rubocop.yml --format json --force-exclusion --cache false --autocorrect -- app/a.rb -b.rb"
+	if got != want {
+		t.Errorf("getExecutionArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestGemEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+	l := New("/opt/tools")
+	output, err := linter.NewSubprocessExecutor().Run(context.Background(), "sh", []string{"-c", "echo $GEM_HOME:$GEM_PATH"}, l.gemEnv()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(output.Stdout); got != "/opt/tools/rubocop:/opt/tools/rubocop" {
+		t.Errorf("GEM_HOME:GEM_PATH = %q, want private GEM_HOME/GEM_PATH", got)
+	}
+}
+
+func TestExecute_NoFiles(t *testing.T) {
+	l := New(t.TempDir())
+	output, err := l.Execute(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	violations, err := l.ParseOutput(output)
+	if err != nil || len(violations) != 0 {
+		t.Errorf("ParseOutput() = %v, %v; want no violations", violations, err)
+	}
+}
+
+func TestKeepCops(t *testing.T) {
+	config := []byte("# Generated by Symphony CLI\nAllCops:\n  DisabledByDefault: true\nLayout/LineLength:\n  Enabled: true\n  Max: 100\nStyle/StringLiterals:\n  Enabled: true\n")
+
+	narrowed, err := keepCops(config, []string{"Layout/LineLength"})
+	if err != nil {
+		t.Fatalf("keepCops() error = %v", err)
+	}
+	got := string(narrowed)
+	if strings.Contains(got, "Style/StringLiterals") || !strings.Contains(got, "Layout/LineLength") || !strings.Contains(got, "AllCops") {
+		t.Errorf("keepCops() =\n%s", got)
+	}
+
+	if _, err := keepCops(config, []string{"Naming/MethodName"}); err == nil {
+		t.Error("keepCops() with unknown cop expected error")
+	}
+}
@@ -0,0 +1,179 @@
+package rubocop
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Fixer       = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
+
+// DefaultVersion is the default RuboCop version requirement.
+const DefaultVersion = "~> 1.60"
+
+// Linter wraps RuboCop for Ruby validation.
+//
+// RuboCop organizes checks ("cops") by department:
+// - Naming: method, variable, constant and file names
+// - Style/Layout: idioms, string literals, line length
+// - Metrics: method length, ABC size, parameter lists
+// - Lint/Security: suspicious constructs, eval, unsafe YAML/JSON loading
+//
+// Note: Linter is goroutine-safe and stateless. WorkDir is determined
+// by CWD at execution time, not stored in the linter.
+type Linter struct {
+	// ToolsDir is where RuboCop gems are installed (ToolsDir/rubocop)
+	// Default: ~/.sym/tools
+	ToolsDir string
+}
+
+// New creates a new RuboCop linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+
+	return &Linter{
+		ToolsDir: toolsDir,
+	}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "rubocop"
+}
+
+// GetCapabilities returns the RuboCop linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:               "rubocop",
+		SupportedLanguages: []string{"ruby", "rb"},
+		SupportedCategories: []string{
+			"naming",
+			"style",
+			"length",
+			"complexity",
+			"security",
+			"pattern",
+		},
+		Version: DefaultVersion,
+	}
+}
+
+// CheckAvailability checks if RuboCop is installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	if l.resolveRuboCop().Found() {
+		return nil
+	}
+
+	return fmt.Errorf("rubocop not found (checked: %s and global PATH)", l.getRuboCopPath())
+}
+
+// Install installs RuboCop with gem into a private GEM_HOME in ToolsDir.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if err := linter.EnsureDir(l.ToolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	if _, err := exec.LookPath("gem"); err != nil {
+		return fmt.Errorf("gem not found: please install Ruby first")
+	}
+
+	version := config.ToolVersion(l.Name(), DefaultVersion)
+
+	if !config.Force {
+		if _, err := os.Stat(l.getRuboCopPath()); err == nil {
+			return nil // Already installed
+		}
+	}
+
+	gemHome := l.getGemHome()
+	output, err := linter.NewSubprocessExecutor().Run(ctx, "gem", []string{"install", "rubocop",
+		"--version", version,
+		"--install-dir", gemHome,
+		"--bindir", filepath.Join(gemHome, "bin"),
+		"--no-document",
+	}, l.gemEnv()...)
+	if err != nil {
+		return fmt.Errorf("gem install failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("gem install failed: %s", strings.TrimSpace(output.Stderr))
+	}
+
+	return nil
+}
+
+// Locate reports the RuboCop installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	tool := l.resolveRuboCop()
+	if tool.Source != linter.ToolSourceManaged {
+		return linter.LocateTool(ctx, tool, "--version")
+	}
+
+	// The managed binstub needs the private GEM_HOME
+	inst := linter.Installation{Path: tool.Path, Source: tool.Source}
+	if output, err := linter.NewSubprocessExecutor().Run(ctx, tool.Path, []string{"--version"}, l.gemEnv()...); err == nil && output.ExitCode == 0 {
+		inst.Version = linter.ParseVersion(output.Stdout)
+	}
+	return inst, nil
+}
+
+// Uninstall removes the private GEM_HOME from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, filepath.Base(l.getGemHome()))
+}
+
+// Execute runs RuboCop with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files)
+}
+
+// RuleConfig keeps only the given cops of the .rubocop.yml config, along with
+// AllCops and other non-cop keys.
+func (l *Linter) RuleConfig(config []byte, ruleIDs []string) ([]byte, error) {
+	// Implementation in executor.go
+	return keepCops(config, ruleIDs)
+}
+
+// Fix runs RuboCop with --autocorrect (safe corrections only), rewriting files in place.
+func (l *Linter) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files, "--autocorrect")
+}
+
+// ParseOutput converts RuboCop JSON output to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(output)
+}
+
+// getGemHome returns the private GEM_HOME for RuboCop.
+func (l *Linter) getGemHome() string {
+	return filepath.Join(l.ToolsDir, "rubocop")
+}
+
+// getRuboCopPath returns the path to the local RuboCop binstub.
+func (l *Linter) getRuboCopPath() string {
+	return filepath.Join(l.getGemHome(), "bin", "rubocop")
+}
+
+// gemEnv returns the executor options that resolve gems from the private GEM_HOME.
+func (l *Linter) gemEnv() []linter.ExecOption {
+	return []linter.ExecOption{
+		linter.WithEnv("GEM_HOME", l.getGemHome()),
+		linter.WithEnv("GEM_PATH", l.getGemHome()),
+	}
+}
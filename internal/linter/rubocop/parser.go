@@ -0,0 +1,88 @@
+package rubocop
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// RuboCopOutput represents RuboCop JSON formatter output.
+type RuboCopOutput struct {
+	Files []RuboCopFile `json:"files"`
+}
+
+// RuboCopFile represents results for a single file.
+type RuboCopFile struct {
+	Path     string           `json:"path"`
+	Offenses []RuboCopOffense `json:"offenses"`
+}
+
+// RuboCopOffense represents a single offense.
+type RuboCopOffense struct {
+	Severity    string          `json:"severity"` // info, refactor, convention, warning, error, fatal
+	Message     string          `json:"message"`
+	CopName     string          `json:"cop_name"`
+	Corrected   bool            `json:"corrected"`
+	Correctable bool            `json:"correctable"`
+	Location    RuboCopLocation `json:"location"`
+}
+
+// RuboCopLocation is the position of an offense.
+type RuboCopLocation struct {
+	StartLine   int `json:"start_line"`
+	StartColumn int `json:"start_column"`
+}
+
+// parseOutput converts RuboCop JSON output to violations.
+// Exit code 1 means offenses were found; 2 means RuboCop itself failed.
+func parseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	stdout := strings.TrimSpace(output.Stdout)
+	if stdout == "" {
+		if output.ExitCode >= 2 {
+			return nil, fmt.Errorf("rubocop error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+
+	var result RuboCopOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		if output.Stderr != "" {
+			return nil, fmt.Errorf("rubocop error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, fmt.Errorf("failed to parse RuboCop output: %w", err)
+	}
+
+	var violations []linter.Violation
+	for _, file := range result.Files {
+		for _, o := range file.Offenses {
+			// Offenses fixed by --autocorrect are no longer present
+			if o.Corrected {
+				continue
+			}
+			violations = append(violations, linter.Violation{
+				File:     file.Path,
+				Line:     o.Location.StartLine,
+				Column:   o.Location.StartColumn,
+				Message:  strings.TrimPrefix(o.Message, o.CopName+": "),
+				Severity: mapSeverity(o.Severity),
+				RuleID:   o.CopName,
+			})
+		}
+	}
+
+	return violations, nil
+}
+
+// mapSeverity maps RuboCop severities to standard values.
+func mapSeverity(severity string) string {
+	switch severity {
+	case "error", "fatal":
+		return "error"
+	case "warning":
+		return "warning"
+	default:
+		return "info" // info, refactor, convention
+	}
+}
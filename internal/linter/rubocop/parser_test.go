@@ -0,0 +1,58 @@
+package rubocop
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func TestParseOutput(t *testing.T) {
+	output := &linter.ToolOutput{
+		Stdout: `{
+			"metadata": {"rubocop_version": "1.60.2"},
+			"files": [
+				{"path": "app/models/user.rb", "offenses": [
+					{"severity": "convention", "message": "Naming/MethodName: Use snake_case for method names.", "cop_name": "Naming/MethodName", "corrected": false, "correctable": false, "location": {"start_line": 3, "start_column": 7, "line": 3, "column": 7}},
+					{"severity": "warning", "message": "Remove debugger entry point ` + "`binding.pry`" + `.", "cop_name": "Lint/Debugger", "corrected": true, "correctable": true, "location": {"start_line": 8, "start_column": 5}},
+					{"severity": "error", "message": "The use of ` + "`eval`" + ` is a serious security risk.", "cop_name": "Security/Eval", "corrected": false, "correctable": false, "location": {"start_line": 12, "start_column": 5}}
+				]},
+				{"path": "lib/clean.rb", "offenses": []}
+			],
+			"summary": {"offense_count": 3, "target_file_count": 2, "inspected_file_count": 2}
+		}`,
+		ExitCode: 1,
+	}
+
+	violations, err := parseOutput(output)
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("parseOutput() returned %d violations, want 2 (corrected offenses skipped)", len(violations))
+	}
+
+	v := violations[0]
+	if v.File != "app/models/user.rb" || v.Line != 3 || v.Column != 7 || v.RuleID != "Naming/MethodName" || v.Severity != "info" {
+		t.Errorf("violation[0] = %+v", v)
+	}
+	if v.Message != "Use snake_case for method names." {
+		t.Errorf("Message = %q, cop prefix should be stripped", v.Message)
+	}
+	if violations[1].RuleID != "Security/Eval" || violations[1].Severity != "error" {
+		t.Errorf("violation[1] = %+v", violations[1])
+	}
+}
+
+func TestParseOutput_Errors(t *testing.T) {
+	if _, err := parseOutput(&linter.ToolOutput{Stderr: "Error: configuration for Foo/Bar cop found", ExitCode: 2}); err == nil {
+		t.Error("parseOutput() expected error for exit code 2")
+	}
+	if _, err := parseOutput(&linter.ToolOutput{Stdout: "not json", ExitCode: 1}); err == nil {
+		t.Error("parseOutput() expected error for invalid JSON")
+	}
+
+	violations, err := parseOutput(&linter.ToolOutput{})
+	if err != nil || len(violations) != 0 {
+		t.Errorf("parseOutput(empty) = %v, %v; want no violations", violations, err)
+	}
+}
@@ -0,0 +1,13 @@
+package rubocop
+
+import (
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func init() {
+	_ = linter.Global().RegisterTool(
+		New(linter.DefaultToolsDir()),
+		NewConverter(),
+		".rubocop.yml",
+	)
+}
@@ -0,0 +1,322 @@
+package ruff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+var (
+	codePattern       = regexp.MustCompile(`^[A-Z]{1,4}[0-9]{0,4}$`)
+	settingKeyPattern = regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)*$`)
+)
+
+// Converter converts rules to Ruff configuration using LLM
+type Converter struct{}
+
+// NewConverter creates a new Ruff converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return "ruff"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"python", "py"}
+}
+
+// GetLLMDescription returns a description of Ruff's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Fast Python linter via Ruff (pycodestyle, pyflakes, isort, pep8-naming, pydocstyle, flake8-* plugins, part of Pylint)
+  - CAN: Naming conventions (N8xx), line length (E501), import sorting (I001) and banned imports/APIs (TID251),
+         docstring requirements and style (D1xx, conventions google/numpy/pep257), unused imports/variables (F401, F841),
+         bare/broad except (E722, BLE001), mutable defaults (B006), print statements (T201), quotes style (Q000),
+         McCabe complexity (C901), argument/branch/statement limits (PLR0913, PLR0912, PLR0915), security checks (S*)
+  - CANNOT: Cross-module type inference, duplicate code detection, business logic, runtime behavior`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide between Ruff and Pylint
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For Python rules that have a Ruff rule code (pycodestyle, pyflakes, isort, pep8-naming, pydocstyle, flake8-*) → prefer ruff (much faster than pylint)",
+		"For Python import order or banned imports/APIs → use ruff",
+		"For Python checks Ruff does not implement (duplicate-code, inference-based checks like no-member) → use pylint",
+		"Never assign the same Python rule to both ruff and pylint",
+	}
+}
+
+// ruffRuleData holds Ruff-specific conversion data
+type ruffRuleData struct {
+	Code     string
+	Settings map[string]interface{}
+}
+
+// ConvertSingleRule converts ONE user rule to a Ruff rule.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by Ruff (skip),
+//	(nil, error) on actual conversion error.
+//
+// Note: Concurrency is handled by the main converter.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		Code     string                 `json:"code"`
+		Settings map[string]interface{} `json:"settings"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	if result.Code == "" {
+		return nil, nil
+	}
+	return codeResult(rule, result.Code, result.Settings)
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[ruffRuleData](data)
+}
+
+// ConvertNative selects a Ruff rule code with its ruff.toml settings:
+// {"E501": {"line-length": 120}}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	code, settings, err := linter.SingleNativeRuleOptions(native)
+	if err != nil {
+		return nil, err
+	}
+	return codeResult(rule, code, settings)
+}
+
+// codeResult validates a rule code and its settings.
+func codeResult(rule schema.UserRule, code string, settings map[string]interface{}) (*linter.SingleRuleResult, error) {
+	if !codePattern.MatchString(code) {
+		return nil, fmt.Errorf("invalid Ruff rule code %q", code)
+	}
+	for key := range settings {
+		if !settingKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid Ruff setting %q", key)
+		}
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: ruffRuleData{
+			Code:     code,
+			Settings: settings,
+		},
+		NativeRuleIDs: []string{code},
+	}, nil
+}
+
+// BuildConfig assembles ruff.toml from successful rule conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	var codes []string
+	seen := make(map[string]bool)
+	settings := make(map[string]interface{})
+
+	for _, r := range results {
+		data, ok := r.Data.(ruffRuleData)
+		if !ok {
+			continue
+		}
+		if !seen[data.Code] {
+			seen[data.Code] = true
+			codes = append(codes, data.Code)
+		}
+		for key, value := range data.Settings {
+			settings[key] = value
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	return &linter.LinterConfig{
+		Filename: "ruff.toml",
+		Content:  []byte(generateRuffTOML(codes, settings)),
+		Format:   "toml",
+	}, nil
+}
+
+// buildPrompt builds the Ruff rule conversion prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	prompt := `You are a Ruff configuration expert. Convert the natural language Python coding rule to ONE Ruff rule.
+
+Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "code": "RUFF_RULE_CODE",
+  "settings": {"dotted.setting.key": value, ...}
+}
+
+Settings use ruff.toml keys: top-level keys (e.g., "line-length") or "lint.<section>.<key>"
+(e.g., "lint.mccabe.max-complexity", "lint.pylint.max-args", "lint.pydocstyle.convention").
+Use "settings": null when the rule needs no settings.
+
+Common Ruff rules:
+- Naming: N801 (class names), N802 (function names), N803 (argument names), N806 (variable names), N815/N816 (mixedCase)
+- Length: E501 (line too long) with "line-length"
+- Imports: I001 (unsorted imports), F401 (unused import), TID251 (banned API) with "lint.flake8-tidy-imports.banned-api": {"module": {"msg": "..."}}
+- Docstrings: D100-D107 (missing docstrings), with "lint.pydocstyle.convention": "google" | "numpy" | "pep257"
+- Errors: E722 (bare except), BLE001 (blind except), B006 (mutable default argument), F841 (unused variable)
+- Complexity: C901 with "lint.mccabe.max-complexity", PLR0913 with "lint.pylint.max-args",
+  PLR0912 with "lint.pylint.max-branches", PLR0915 with "lint.pylint.max-statements"
+- Other: T201 (print), Q000 (quotes) with "lint.flake8-quotes.inline-quotes", S307 (eval), S105 (hardcoded password)
+
+If the rule cannot be expressed in Ruff, return:
+{
+  "code": "",
+  "settings": null
+}
+
+Examples:
+
+Input: "Functions should have at most 5 arguments"
+Output:
+{
+  "code": "PLR0913",
+  "settings": {"lint.pylint.max-args": 5}
+}
+
+Input: "Lines must not exceed 100 characters"
+Output:
+{
+  "code": "E501",
+  "settings": {"line-length": 100}
+}`
+
+	prompt += fmt.Sprintf("\n\nConvert this rule to Ruff configuration:\n\n%s", rule.Say)
+	prompt += linter.ParamsPrompt(rule.Params)
+	if rule.Severity != "" {
+		prompt += fmt.Sprintf("\nSeverity: %s", rule.Severity)
+	}
+	return prompt
+}
+
+// generateRuffTOML generates ruff.toml content.
+// Dotted setting keys are grouped into TOML tables by their prefix.
+func generateRuffTOML(codes []string, settings map[string]interface{}) string {
+	tables := make(map[string]map[string]interface{})
+	for key, value := range settings {
+		table, name := "", key
+		if i := strings.LastIndex(key, "."); i >= 0 {
+			table, name = key[:i], key[i+1:]
+		}
+		if tables[table] == nil {
+			tables[table] = make(map[string]interface{})
+		}
+		tables[table][name] = value
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Generated by Symphony CLI\n")
+	writeTOMLKeys(&sb, tables[""])
+
+	// Ruff only reports selected rules, so select exactly the converted codes
+	if tables["lint"] == nil {
+		tables["lint"] = make(map[string]interface{})
+	}
+	tables["lint"]["select"] = stringsToInterfaces(codes)
+
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("\n[%s]\n", name))
+		writeTOMLKeys(&sb, tables[name])
+	}
+
+	return sb.String()
+}
+
+// writeTOMLKeys writes key = value lines in sorted key order.
+func writeTOMLKeys(sb *strings.Builder, values map[string]interface{}) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("%s = %s\n", key, tomlValue(values[key])))
+	}
+}
+
+// tomlValue formats a JSON-decoded value as a TOML value.
+func tomlValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, tomlValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]string, 0, len(v))
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%s = %s", strconv.Quote(key), tomlValue(v[key])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	out := make([]interface{}, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
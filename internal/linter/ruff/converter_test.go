@@ -0,0 +1,145 @@
+package ruff
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(_ context.Context, prompt string, _ llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) Close() error { return nil }
+
+func TestConvertSingleRule(t *testing.T) {
+	provider := &mockProvider{response: "```json\n{\"code\": \"PLR0913\", \"settings\": {\"lint.pylint.max-args\": 5}}\n```"}
+	rule := schema.UserRule{ID: "PY-ARGS", Say: "Functions should have at most 5 arguments", Severity: "warning"}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("ConvertSingleRule() returned nil result")
+	}
+	if result.RuleID != "PY-ARGS" {
+		t.Errorf("RuleID = %q, want PY-ARGS", result.RuleID)
+	}
+	if len(result.NativeRuleIDs) != 1 || result.NativeRuleIDs[0] != "PLR0913" {
+		t.Errorf("NativeRuleIDs = %v, want [PLR0913]", result.NativeRuleIDs)
+	}
+	if !strings.Contains(provider.prompt, rule.Say) || !strings.Contains(provider.prompt, "Severity: warning") {
+		t.Error("prompt should include the rule text and severity")
+	}
+}
+
+func TestConvertSingleRule_Skip(t *testing.T) {
+	provider := &mockProvider{response: `{"code": "", "settings": null}`}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "Use the repository pattern"}, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result != nil {
+		t.Errorf("ConvertSingleRule() = %+v, want nil", result)
+	}
+}
+
+func TestConvertSingleRule_Invalid(t *testing.T) {
+	tests := []string{
+		`not json`,
+		`{"code": "line-too-long"}`,
+		`{"code": "E501", "settings": {"Line Length": 100}}`,
+	}
+	for _, response := range tests {
+		_, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response})
+		if err == nil {
+			t.Errorf("ConvertSingleRule(%q) expected error", response)
+		}
+	}
+
+	if _, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1"}, nil); err == nil {
+		t.Error("ConvertSingleRule() expected error without provider")
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: ruffRuleData{Code: "E501", Settings: map[string]interface{}{"line-length": float64(100)}}},
+		{RuleID: "R2", Data: ruffRuleData{Code: "C901", Settings: map[string]interface{}{"lint.mccabe.max-complexity": float64(10)}}},
+		{RuleID: "R3", Data: ruffRuleData{Code: "D103", Settings: map[string]interface{}{"lint.pydocstyle.convention": "google"}}},
+		{RuleID: "R4", Data: ruffRuleData{Code: "TID251", Settings: map[string]interface{}{
+			"lint.flake8-tidy-imports.banned-api": map[string]interface{}{
+				"requests": map[string]interface{}{"msg": "Use httpx"},
+			},
+		}}},
+		{RuleID: "R5", Data: ruffRuleData{Code: "E501"}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil {
+		t.Fatalf("BuildConfig() error = %v", err)
+	}
+	if config.Filename != "ruff.toml" || config.Format != "toml" {
+		t.Errorf("config = %s (%s), want ruff.toml (toml)", config.Filename, config.Format)
+	}
+
+	want := `# Generated by Symphony CLI
+line-length = 100
+
+[lint]
+select = ["E501", "C901", "D103", "TID251"]
+
+[lint.flake8-tidy-imports]
+banned-api = {"requests" = {"msg" = "Use httpx"}}
+
+[lint.mccabe]
+max-complexity = 10
+
+[lint.pydocstyle]
+convention = "google"
+`
+	if got := string(config.Content); got != want {
+		t.Errorf("BuildConfig() content =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestBuildConfig_Empty(t *testing.T) {
+	config, err := NewConverter().BuildConfig(nil)
+	if err != nil || config != nil {
+		t.Errorf("BuildConfig(nil) = %v, %v; want nil, nil", config, err)
+	}
+}
+
+func TestConvertNative(t *testing.T) {
+	rule := schema.UserRule{ID: "PY-LEN", Say: "Lines must not exceed 120 characters"}
+
+	result, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"E501": {"line-length": 120}}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	data := result.Data.(ruffRuleData)
+	if data.Code != "E501" || data.Settings["line-length"] != float64(120) {
+		t.Errorf("Data = %+v", data)
+	}
+
+	for _, native := range []string{`{"line-too-long": null}`, `{"E501": {"Line Length": 1}}`} {
+		if _, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(native)); err == nil {
+			t.Errorf("ConvertNative(%s) expected error", native)
+		}
+	}
+}
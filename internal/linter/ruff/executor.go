@@ -0,0 +1,126 @@
+package ruff
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// execute runs `ruff check` with the given config, files and extra flags.
+func (l *Linter) execute(ctx context.Context, config []byte, files []string, extraArgs ...string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{
+			Stdout:   "[]",
+			ExitCode: 0,
+		}, nil
+	}
+
+	if linter.ProjectConfigMerge(ctx) {
+		config = extendProjectConfig(config)
+	}
+
+	// Write config to temp file
+	configPath, err := l.writeConfigFile(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write config: %w", err)
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	args := getExecutionArgs(configPath, files, extraArgs...)
+
+	// Execute - uses CWD by default
+	return l.executor.Execute(ctx, l.getRuffCommand(), args...)
+}
+
+// getExecutionArgs returns the arguments for `ruff check`.
+func getExecutionArgs(configPath string, files []string, extraArgs ...string) []string {
+	args := []string{
+		"check",
+		"--output-format=json",
+		"--no-cache",
+		"--config", configPath, // Only the generated ruff.toml applies
+	}
+	args = append(args, extraArgs...)
+	args = append(args, "--")
+	args = append(args, files...)
+
+	return args
+}
+
+// extendProjectConfig makes the generated config extend the project's Ruff
+// config, so its per-file-ignores, src paths and plugin settings apply.
+// The generated `[lint] select` still decides which rules are reported.
+func extendProjectConfig(config []byte) []byte {
+	path := findProjectConfig()
+	if path == "" {
+		return config
+	}
+	return append([]byte(fmt.Sprintf("extend = %s\n", strconv.Quote(path))), config...)
+}
+
+// findProjectConfig returns the project's Ruff config: ruff.toml, .ruff.toml
+// or a pyproject.toml with a [tool.ruff] table. Returns "" if none.
+func findProjectConfig() string {
+	if path := linter.FindProjectFile("ruff.toml", ".ruff.toml"); path != "" {
+		return path
+	}
+	path := linter.FindProjectFile("pyproject.toml")
+	if path == "" {
+		return ""
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || !bytes.Contains(data, []byte("[tool.ruff")) {
+		return ""
+	}
+	return path
+}
+
+// writeConfigFile writes ruff.toml to a temp file.
+// Ruff detects the config format from the extension, so the file ends in .toml.
+func (l *Linter) writeConfigFile(config []byte) (string, error) {
+	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return "", err
+	}
+
+	tmpFile, err := os.CreateTemp(tmpDir, "ruff-*.toml")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tmpFile.Close() }()
+
+	if _, err := tmpFile.Write(config); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+
+	return tmpFile.Name(), nil
+}
+
+// selectRules replaces the `[lint] select` list of a generated ruff.toml with codes.
+func selectRules(config []byte, codes []string) ([]byte, error) {
+	if len(codes) == 0 {
+		return nil, linter.ErrNoRuleIDs
+	}
+
+	lines := strings.Split(string(config), "\n")
+	table := ""
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			table = strings.Trim(trimmed, "[]")
+			continue
+		}
+		if table == "lint" && strings.HasPrefix(trimmed, "select =") {
+			lines[i] = "select = " + tomlValue(stringsToInterfaces(codes))
+			return []byte(strings.Join(lines, "\n")), nil
+		}
+	}
+	return nil, fmt.Errorf("config has no [lint] select list")
+}
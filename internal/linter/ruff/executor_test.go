@@ -0,0 +1,107 @@
+package ruff
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetExecutionArgs(t *testing.T) {
+	args := getExecutionArgs("/tmp/ruff.toml", []string{"a.py", "-b.py"}, "--fix")
+	got := strings.Join(args, " ")
+	want := "check --output-format=json --no-cache --config /tmp/ruff.toml --fix -- a.py -b.py"
+	if got != want {
+		t.Errorf("getExecutionArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestWriteConfigFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	l := New(tmpDir)
+	config := []byte("line-length = 100\n\n[lint]\nselect = [\"E501\"]\n")
+
+	configPath, err := l.writeConfigFile(config)
+	if err != nil {
+		t.Fatalf("writeConfigFile() error = %v", err)
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	if filepath.Ext(configPath) != ".toml" {
+		t.Errorf("config path %q should end with .toml", configPath)
+	}
+	if !strings.HasPrefix(configPath, filepath.Join(tmpDir, ".tmp")) {
+		t.Errorf("config path %q should be under ToolsDir/.tmp", configPath)
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config file: %v", err)
+	}
+	if string(content) != string(config) {
+		t.Errorf("config content = %q, want %q", content, config)
+	}
+}
+
+func TestExecute_NoFiles(t *testing.T) {
+	l := New(t.TempDir())
+
+	output, err := l.Execute(context.Background(), []byte(""), nil)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Stdout != "[]" || output.ExitCode != 0 {
+		t.Errorf("Execute() = %+v, want empty result", output)
+	}
+}
+
+func TestExtendProjectConfig(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(root)
+
+	config := []byte("[lint]\nselect = [\"E501\"]\n")
+	pyproject := filepath.Join(root, "pyproject.toml")
+
+	// pyproject.toml without [tool.ruff] is not a Ruff config
+	if err := os.WriteFile(pyproject, []byte("[project]\nname = \"app\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := extendProjectConfig(config); string(got) != string(config) {
+		t.Errorf("extendProjectConfig() = %q, want unchanged", got)
+	}
+
+	if err := os.WriteFile(pyproject, []byte("[tool.ruff]\nsrc = [\"src\"]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got := string(extendProjectConfig(config))
+	if !strings.HasPrefix(got, "extend = ") || !strings.Contains(got, "pyproject.toml") || !strings.HasSuffix(got, string(config)) {
+		t.Errorf("extendProjectConfig() = %q, want extend of pyproject.toml", got)
+	}
+}
+
+func TestSelectRules(t *testing.T) {
+	config := generateRuffTOML([]string{"E501", "T201"}, map[string]interface{}{"line-length": float64(100)})
+
+	narrowed, err := selectRules([]byte(config), []string{"T201"})
+	if err != nil {
+		t.Fatalf("selectRules() error = %v", err)
+	}
+	got := string(narrowed)
+	if !strings.Contains(got, "[lint]\nselect = [\"T201\"]\n") {
+		t.Errorf("select not narrowed:\n%s", got)
+	}
+	if !strings.Contains(got, "line-length = 100") {
+		t.Errorf("settings should be kept:\n%s", got)
+	}
+
+	if _, err := selectRules([]byte("line-length = 100\n"), []string{"T201"}); err == nil {
+		t.Error("selectRules() without [lint] select expected error")
+	}
+	if _, err := selectRules([]byte(config), nil); err == nil {
+		t.Error("selectRules() without codes expected error")
+	}
+}
@@ -0,0 +1,259 @@
+package ruff
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Fixer       = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
+
+// Linter wraps Ruff for Python validation.
+//
+// Ruff is a fast Python linter that reimplements pycodestyle, pyflakes,
+// isort, pep8-naming, pydocstyle, flake8-* plugins and part of Pylint:
+// - Style rules: E/W (pycodestyle), I (isort), Q (quotes)
+// - Naming rules: N (pep8-naming)
+// - Documentation rules: D (pydocstyle)
+// - Bug patterns: F (pyflakes), B (bugbear), S (bandit)
+//
+// Note: Linter is goroutine-safe and stateless. WorkDir is determined
+// by CWD at execution time, not stored in the linter.
+type Linter struct {
+	// ToolsDir is where Ruff is installed
+	// Default: ~/.sym/tools
+	ToolsDir string
+
+	// executor runs Ruff subprocess
+	executor *linter.SubprocessExecutor
+}
+
+// New creates a new Ruff linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+
+	return &Linter{
+		ToolsDir: toolsDir,
+		executor: linter.NewSubprocessExecutor(),
+	}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "ruff"
+}
+
+// GetCapabilities returns the Ruff linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:               "ruff",
+		SupportedLanguages: []string{"python", "py"},
+		SupportedCategories: []string{
+			"naming",
+			"style",
+			"documentation",
+			"error_handling",
+			"complexity",
+			"pattern",
+			"security",
+			"imports",
+		},
+		Version: ">=0.5.0",
+	}
+}
+
+// CheckAvailability checks if Ruff is installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	if l.resolveRuff().Found() {
+		return nil
+	}
+
+	return fmt.Errorf("ruff not found (checked: project virtualenv, global PATH and %s)", strings.Join(l.localPaths(), ", "))
+}
+
+// Install installs Ruff into the tools directory.
+// pip in a dedicated virtualenv is tried first; pipx is the fallback when
+// the venv module is missing (e.g., python3-venv not installed).
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if err := linter.EnsureDir(l.ToolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	spec := "ruff"
+	if version := config.ToolVersion(l.Name(), ""); version != "" {
+		spec = "ruff==" + version
+	}
+
+	venvErr := l.installWithPip(ctx, spec, config.Force)
+	if venvErr == nil {
+		return nil
+	}
+
+	if _, err := exec.LookPath("pipx"); err != nil {
+		return venvErr
+	}
+	if err := l.installWithPipx(ctx, spec, config.Force); err != nil {
+		return fmt.Errorf("%v; pipx fallback failed: %w", venvErr, err)
+	}
+	return nil
+}
+
+// Locate reports the Ruff installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolveRuff(), "--version")
+}
+
+// Uninstall removes the Ruff virtualenv and pipx installation from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir,
+		filepath.Base(l.getVenvPath()),
+		filepath.Join("pipx", "venvs", "ruff"),
+		filepath.Join("bin", "ruff*"),
+	)
+}
+
+// installWithPip installs Ruff in ToolsDir/ruff-venv.
+func (l *Linter) installWithPip(ctx context.Context, spec string, force bool) error {
+	pythonCmd := getPythonCommand()
+	if _, err := exec.LookPath(pythonCmd); err != nil {
+		return fmt.Errorf("python not found: please install Python 3.8+ first")
+	}
+
+	venvPath := l.getVenvPath()
+	if force {
+		if err := os.RemoveAll(venvPath); err != nil {
+			return fmt.Errorf("failed to remove venv: %w", err)
+		}
+	}
+
+	if _, err := os.Stat(l.venvBin("pip")); os.IsNotExist(err) {
+		_ = os.RemoveAll(venvPath)
+		output, err := l.executor.Execute(ctx, pythonCmd, "-m", "venv", venvPath)
+		if err != nil {
+			return fmt.Errorf("failed to create virtualenv: %w", err)
+		}
+		if output.ExitCode != 0 {
+			errMsg := output.Stderr
+			if errMsg == "" {
+				errMsg = output.Stdout
+			}
+			return fmt.Errorf("failed to create virtualenv: %s", strings.TrimSpace(errMsg))
+		}
+	}
+
+	output, err := l.executor.Execute(ctx, l.venvBin("pip"), "install", spec)
+	if err != nil {
+		return fmt.Errorf("pip install failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("pip install failed: %s", output.Stderr)
+	}
+	return nil
+}
+
+// installWithPipx installs Ruff with pipx, keeping its files in ToolsDir.
+func (l *Linter) installWithPipx(ctx context.Context, spec string, force bool) error {
+	args := []string{"install", spec}
+	if force {
+		args = append(args, "--force")
+	}
+	output, err := l.executor.Run(ctx, "pipx", args,
+		linter.WithEnv("PIPX_HOME", filepath.Join(l.ToolsDir, "pipx")),
+		linter.WithEnv("PIPX_BIN_DIR", filepath.Join(l.ToolsDir, "bin")),
+	)
+	if err != nil {
+		return err
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("%s", strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Execute runs Ruff with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files)
+}
+
+// RuleConfig selects only the given rule codes in the generated ruff.toml.
+// Settings of other rules are kept; they have no effect on unselected rules.
+func (l *Linter) RuleConfig(config []byte, ruleIDs []string) ([]byte, error) {
+	// Implementation in executor.go
+	return selectRules(config, ruleIDs)
+}
+
+// Fix runs `ruff check --fix`, rewriting files in place.
+func (l *Linter) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files, "--fix")
+}
+
+// ParseOutput converts Ruff JSON output to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(output)
+}
+
+// getVenvPath returns the path to the Ruff virtualenv.
+func (l *Linter) getVenvPath() string {
+	return filepath.Join(l.ToolsDir, "ruff-venv")
+}
+
+// venvBin returns the path to an executable in the virtualenv.
+func (l *Linter) venvBin(name string) string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(l.getVenvPath(), "Scripts", name+".exe")
+	}
+	return filepath.Join(l.getVenvPath(), "bin", name)
+}
+
+// localPaths returns the managed Ruff locations: virtualenv, then pipx bin dir.
+func (l *Linter) localPaths() []string {
+	pipxBin := filepath.Join(l.ToolsDir, "bin", "ruff")
+	if runtime.GOOS == "windows" {
+		pipxBin += ".exe"
+	}
+	return []string{l.venvBin("ruff"), pipxBin}
+}
+
+// resolveRuff locates Ruff: project virtualenv, PATH, then tools dir.
+func (l *Linter) resolveRuff() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "ruff",
+		Python:       true,
+		ManagedPaths: l.localPaths(),
+	})
+}
+
+// getRuffCommand returns the Ruff command to use.
+func (l *Linter) getRuffCommand() string {
+	if tool := l.resolveRuff(); tool.Found() {
+		return tool.Path
+	}
+
+	// Fall back to venv path (will fail with proper error)
+	return l.venvBin("ruff")
+}
+
+// getPythonCommand returns the Python command to use.
+func getPythonCommand() string {
+	if _, err := exec.LookPath("python3"); err == nil {
+		return "python3"
+	}
+	return "python"
+}
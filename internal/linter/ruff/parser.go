@@ -0,0 +1,78 @@
+package ruff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// RuffLocation is a position in a Ruff diagnostic.
+type RuffLocation struct {
+	Row    int `json:"row"`
+	Column int `json:"column"`
+}
+
+// RuffDiagnostic represents a single diagnostic from `ruff check --output-format=json`.
+type RuffDiagnostic struct {
+	Code        *string      `json:"code"`     // Rule code (e.g., "E501"); null for syntax errors
+	Message     string       `json:"message"`  // Human-readable message
+	Filename    string       `json:"filename"` // Absolute file path
+	Location    RuffLocation `json:"location"`
+	EndLocation RuffLocation `json:"end_location"`
+	URL         string       `json:"url"`
+}
+
+// parseOutput converts Ruff JSON output to violations.
+func parseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	stdout := strings.TrimSpace(output.Stdout)
+	if stdout == "" || stdout == "[]" {
+		// Exit code 2 means Ruff itself failed (bad config, invalid arguments)
+		if output.ExitCode >= 2 && output.Stderr != "" {
+			return nil, fmt.Errorf("ruff error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+
+	var diagnostics []RuffDiagnostic
+	if err := json.Unmarshal([]byte(stdout), &diagnostics); err != nil {
+		if output.Stderr != "" {
+			return nil, fmt.Errorf("ruff error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, fmt.Errorf("failed to parse Ruff output: %w", err)
+	}
+
+	violations := make([]linter.Violation, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		ruleID := "syntax-error"
+		severity := "error"
+		if d.Code != nil && *d.Code != "" {
+			ruleID = *d.Code
+			severity = codeToSeverity(ruleID)
+		}
+
+		violations = append(violations, linter.Violation{
+			File:     d.Filename,
+			Line:     d.Location.Row,
+			Column:   d.Location.Column,
+			Message:  d.Message,
+			Severity: severity,
+			RuleID:   ruleID,
+		})
+	}
+
+	return violations, nil
+}
+
+// codeToSeverity maps a Ruff rule code to severity.
+// Ruff has no severities; pyflakes (F) and pycodestyle errors (E9) indicate
+// broken code, everything else is a style or quality warning.
+func codeToSeverity(code string) string {
+	switch {
+	case strings.HasPrefix(code, "F"), strings.HasPrefix(code, "E9"):
+		return "error"
+	default:
+		return "warning"
+	}
+}
@@ -0,0 +1,109 @@
+package ruff
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func TestParseOutput_Empty(t *testing.T) {
+	for _, stdout := range []string{"", "[]", "  \n"} {
+		violations, err := parseOutput(&linter.ToolOutput{Stdout: stdout})
+		if err != nil {
+			t.Errorf("parseOutput(%q) error = %v", stdout, err)
+		}
+		if len(violations) != 0 {
+			t.Errorf("parseOutput(%q) returned %d violations, want 0", stdout, len(violations))
+		}
+	}
+}
+
+func TestParseOutput_Diagnostics(t *testing.T) {
+	output := &linter.ToolOutput{
+		Stdout: `[
+			{
+				"code": "E501",
+				"message": "Line too long (120 > 100)",
+				"filename": "/repo/src/app.py",
+				"location": {"row": 12, "column": 101},
+				"end_location": {"row": 12, "column": 121},
+				"url": "https://docs.astral.sh/ruff/rules/line-too-long"
+			},
+			{
+				"code": "F401",
+				"message": "` + "`os`" + ` imported but unused",
+				"filename": "/repo/src/app.py",
+				"location": {"row": 1, "column": 8},
+				"end_location": {"row": 1, "column": 10}
+			},
+			{
+				"code": null,
+				"message": "SyntaxError: Expected an expression",
+				"filename": "/repo/src/broken.py",
+				"location": {"row": 3, "column": 5},
+				"end_location": {"row": 3, "column": 6}
+			}
+		]`,
+		ExitCode: 1,
+	}
+
+	violations, err := parseOutput(output)
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 3 {
+		t.Fatalf("parseOutput() returned %d violations, want 3", len(violations))
+	}
+
+	tests := []struct {
+		ruleID   string
+		severity string
+		line     int
+		column   int
+	}{
+		{"E501", "warning", 12, 101},
+		{"F401", "error", 1, 8},
+		{"syntax-error", "error", 3, 5},
+	}
+	for i, tt := range tests {
+		v := violations[i]
+		if v.RuleID != tt.ruleID || v.Severity != tt.severity || v.Line != tt.line || v.Column != tt.column {
+			t.Errorf("violation[%d] = %+v, want rule %s severity %s at %d:%d", i, v, tt.ruleID, tt.severity, tt.line, tt.column)
+		}
+	}
+	if violations[0].File != "/repo/src/app.py" {
+		t.Errorf("File = %q, want /repo/src/app.py", violations[0].File)
+	}
+}
+
+func TestParseOutput_ToolError(t *testing.T) {
+	output := &linter.ToolOutput{
+		Stderr:   "ruff failed\n  Cause: unknown field `foo`",
+		ExitCode: 2,
+	}
+
+	if _, err := parseOutput(output); err == nil {
+		t.Error("parseOutput() expected error for exit code 2 with stderr")
+	}
+}
+
+func TestParseOutput_InvalidJSON(t *testing.T) {
+	if _, err := parseOutput(&linter.ToolOutput{Stdout: "not json", ExitCode: 1}); err == nil {
+		t.Error("parseOutput() expected error for invalid JSON")
+	}
+}
+
+func TestCodeToSeverity(t *testing.T) {
+	tests := map[string]string{
+		"F841":    "error",
+		"E999":    "error",
+		"E501":    "warning",
+		"N802":    "warning",
+		"PLR0913": "warning",
+	}
+	for code, want := range tests {
+		if got := codeToSeverity(code); got != want {
+			t.Errorf("codeToSeverity(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
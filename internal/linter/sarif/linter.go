@@ -0,0 +1,213 @@
+package sarif
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter  = (*Linter)(nil)
+	_ linter.Locator = (*Linter)(nil)
+)
+
+// Command describes how to run a SARIF-emitting analyzer.
+//
+// Args support the placeholders {config} (path of a temp file holding the
+// config passed to Execute) and {files} (the target files, one argument each).
+// Without {files} the files are appended to the arguments.
+type Command struct {
+	// Name is the engine name (e.g., "gosec", "bandit").
+	Name string
+
+	// Languages and Categories describe the analyzer's capabilities.
+	Languages  []string
+	Categories []string
+	Version    string
+
+	// Executable is the analyzer binary (looked up in ToolsDir/bin, then PATH).
+	Executable string
+
+	// Args are the analysis arguments; they must make the tool print SARIF to stdout.
+	Args []string
+
+	// VersionArgs checks availability. Default: ["--version"]
+	VersionArgs []string
+
+	// InstallArgs is an optional install command ({toolsDir}, {version} placeholders),
+	// e.g. ["go", "install", "github.com/securego/gosec/v2/cmd/gosec@{version}"].
+	InstallArgs []string
+}
+
+// Linter runs any SARIF-emitting analyzer and parses runs[].results[].
+//
+// Note: Linter is goroutine-safe and stateless. Temp config files are created
+// per execution and removed afterwards.
+type Linter struct {
+	cmd Command
+
+	// ToolsDir is where tools are installed
+	// Default: ~/.sym/tools
+	ToolsDir string
+}
+
+// New creates a SARIF linter for the given command definition.
+func New(cmd Command, toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+	return &Linter{cmd: cmd, ToolsDir: toolsDir}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return l.cmd.Name
+}
+
+// GetCapabilities returns the analyzer capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:                l.cmd.Name,
+		SupportedLanguages:  l.cmd.Languages,
+		SupportedCategories: l.cmd.Categories,
+		Version:             l.cmd.Version,
+	}
+}
+
+// CheckAvailability runs the analyzer with VersionArgs.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	path := l.executablePath()
+	if path == "" {
+		return fmt.Errorf("%s not found (checked: %s and global PATH)", l.cmd.Executable, l.localPath())
+	}
+
+	args := l.cmd.VersionArgs
+	if args == nil {
+		args = []string{"--version"}
+	}
+	output, err := linter.NewSubprocessExecutor().Execute(ctx, path, args...)
+	if err != nil {
+		return fmt.Errorf("%s not available: %w", l.cmd.Name, err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("%s not available (exit %d): %s", l.cmd.Name, output.ExitCode, strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Install runs InstallArgs.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if len(l.cmd.InstallArgs) == 0 {
+		return fmt.Errorf("%s not installed: install %s manually", l.cmd.Name, l.cmd.Executable)
+	}
+
+	toolsDir := config.ToolsDir
+	if toolsDir == "" {
+		toolsDir = l.ToolsDir
+	}
+	if err := linter.EnsureDir(toolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	version := config.ToolVersion(l.Name(), "latest")
+	args := make([]string, len(l.cmd.InstallArgs))
+	for i, arg := range l.cmd.InstallArgs {
+		arg = strings.ReplaceAll(arg, "{toolsDir}", toolsDir)
+		args[i] = strings.ReplaceAll(arg, "{version}", version)
+	}
+
+	output, err := linter.NewSubprocessExecutor().Execute(ctx, args[0], args[1:]...)
+	if err != nil {
+		return fmt.Errorf("failed to install %s: %w", l.cmd.Name, err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("failed to install %s: %s", l.cmd.Name, strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Locate reports the analyzer installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	args := l.cmd.VersionArgs
+	if args == nil {
+		args = []string{"--version"}
+	}
+	return linter.LocateTool(ctx, linter.PathTool(l.executablePath(), l.localPath()), args...)
+}
+
+// Execute runs the analyzer with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{}, nil
+	}
+
+	path := l.executablePath()
+	if path == "" {
+		return nil, fmt.Errorf("%s not found", l.cmd.Executable)
+	}
+
+	var configPath string
+	if l.usesConfig() {
+		if len(config) == 0 {
+			config = []byte("{}")
+		}
+		var err error
+		configPath, err = linter.WriteTempConfig(l.ToolsDir, l.cmd.Name, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write config: %w", err)
+		}
+		defer func() { _ = os.Remove(configPath) }()
+	}
+
+	args := make([]string, 0, len(l.cmd.Args)+len(files))
+	hasFiles := false
+	for _, arg := range l.cmd.Args {
+		if arg == "{files}" {
+			args = append(args, files...)
+			hasFiles = true
+			continue
+		}
+		args = append(args, strings.ReplaceAll(arg, "{config}", configPath))
+	}
+	if !hasFiles {
+		args = append(args, files...)
+	}
+
+	return linter.NewSubprocessExecutor().Execute(ctx, path, args...)
+}
+
+// ParseOutput parses SARIF from stdout.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	if strings.TrimSpace(output.Stdout) == "" {
+		if output.ExitCode != 0 && output.Stderr != "" {
+			return nil, fmt.Errorf("%s error: %s", l.cmd.Name, strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+	return Parse([]byte(output.Stdout))
+}
+
+// usesConfig reports whether the arguments reference {config}.
+func (l *Linter) usesConfig() bool {
+	for _, arg := range l.cmd.Args {
+		if strings.Contains(arg, "{config}") {
+			return true
+		}
+	}
+	return false
+}
+
+// localPath returns the path of the analyzer in the tools directory.
+func (l *Linter) localPath() string {
+	return filepath.Join(l.ToolsDir, "bin", l.cmd.Executable)
+}
+
+// executablePath finds the analyzer binary, or returns "".
+func (l *Linter) executablePath() string {
+	return linter.FindTool(l.localPath(), l.cmd.Executable)
+}
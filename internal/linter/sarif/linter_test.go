@@ -0,0 +1,60 @@
+package sarif
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinter_ExecuteAndParse(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	// A fake analyzer that reports one SARIF result per file
+	script := `printf '{"version":"2.1.0","runs":[{"results":['
+sep=""
+for f in "$@"; do
+  printf '%s{"ruleId":"no-x","level":"error","message":{"text":"x in %s"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"%s"},"region":{"startLine":1}}}]}' "$sep" "$f" "$f"
+  sep=","
+done
+printf ']}]}'`
+
+	l := New(Command{
+		Name:        "fake-sarif",
+		Languages:   []string{"go"},
+		Executable:  "sh",
+		Args:        []string{"-c", script, "sh"},
+		VersionArgs: []string{"-c", "exit 0"},
+	}, t.TempDir())
+
+	require.NoError(t, l.CheckAvailability(context.Background()))
+
+	output, err := l.Execute(context.Background(), nil, []string{"a.go", "b.go"})
+	require.NoError(t, err)
+
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, linter.Violation{File: "b.go", Line: 1, Message: "x in b.go", Severity: "error", RuleID: "no-x"}, violations[1])
+}
+
+func TestLinter_ParseOutput_Errors(t *testing.T) {
+	l := New(Command{Name: "fake-sarif", Executable: "fake-sarif"}, t.TempDir())
+
+	violations, err := l.ParseOutput(&linter.ToolOutput{})
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	_, err = l.ParseOutput(&linter.ToolOutput{Stderr: "bad flag", ExitCode: 2})
+	assert.ErrorContains(t, err, "bad flag")
+}
+
+func TestLinter_InstallWithoutCommand(t *testing.T) {
+	l := New(Command{Name: "fake-sarif", Executable: "fake-sarif"}, t.TempDir())
+	assert.ErrorContains(t, l.Install(context.Background(), linter.InstallConfig{}), "install fake-sarif manually")
+}
@@ -0,0 +1,272 @@
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Log is the subset of a SARIF 2.1.0 log used to build violations.
+type Log struct {
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run is a single analysis run.
+type Run struct {
+	Tool              Tool                        `json:"tool"`
+	Results           []Result                    `json:"results"`
+	Artifacts         []Artifact                  `json:"artifacts"`
+	OriginalURIBaseID map[string]ArtifactLocation `json:"originalUriBaseIds"`
+}
+
+// Tool describes the analyzer that produced a run.
+type Tool struct {
+	Driver struct {
+		Name  string                `json:"name"`
+		Rules []ReportingDescriptor `json:"rules"`
+	} `json:"driver"`
+}
+
+// ReportingDescriptor is a rule definition in tool.driver.rules.
+type ReportingDescriptor struct {
+	ID                   string             `json:"id"`
+	ShortDescription     *Message           `json:"shortDescription"`
+	MessageStrings       map[string]Message `json:"messageStrings"`
+	DefaultConfiguration *struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+// Result is a single finding.
+type Result struct {
+	RuleID    string `json:"ruleId"`
+	RuleIndex *int   `json:"ruleIndex"`
+	Rule      *struct {
+		ID    string `json:"id"`
+		Index *int   `json:"index"`
+	} `json:"rule"`
+	Kind         string     `json:"kind"`
+	Level        string     `json:"level"`
+	Message      Message    `json:"message"`
+	Locations    []Location `json:"locations"`
+	Suppressions []struct {
+		Status string `json:"status"`
+	} `json:"suppressions"`
+}
+
+// Message is a SARIF message object.
+type Message struct {
+	Text      string   `json:"text"`
+	Markdown  string   `json:"markdown"`
+	ID        string   `json:"id"`
+	Arguments []string `json:"arguments"`
+}
+
+// Location is a result location.
+type Location struct {
+	PhysicalLocation *struct {
+		ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+		Region           *struct {
+			StartLine   int `json:"startLine"`
+			StartColumn int `json:"startColumn"`
+		} `json:"region"`
+	} `json:"physicalLocation"`
+}
+
+// ArtifactLocation points to a file, possibly relative to a base URI.
+type ArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+	Index     *int   `json:"index"`
+}
+
+// Artifact is an entry of run.artifacts.
+type Artifact struct {
+	Location ArtifactLocation `json:"location"`
+}
+
+// Parse converts a SARIF 2.1 log to violations.
+//
+// Each runs[].results[] entry becomes one violation:
+//   - RuleID: result.ruleId, result.rule.id, or the id of the driver rule at ruleIndex
+//   - Severity: result.level, else the rule's defaultConfiguration.level, else "warning"
+//     (error → error, warning → warning, note/none → info)
+//   - File/Line/Column: the first physical location; file URIs are decoded and
+//     resolved against artifacts and originalUriBaseIds
+//
+// Results with kind "pass" or "notApplicable" and accepted suppressions are skipped.
+func Parse(data []byte) ([]linter.Violation, error) {
+	return ParseWithSeverity(data, LevelToSeverity)
+}
+
+// ParseWithSeverity is Parse with a custom mapping of SARIF levels (the
+// result's level, else the rule's default level, else "") to severities.
+func ParseWithSeverity(data []byte, toSeverity func(level string) string) ([]linter.Violation, error) {
+	var log Log
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("failed to parse SARIF output: %w", err)
+	}
+
+	var violations []linter.Violation
+	for i := range log.Runs {
+		run := &log.Runs[i]
+		for _, result := range run.Results {
+			if result.Kind == "pass" || result.Kind == "notApplicable" || isSuppressed(result) {
+				continue
+			}
+
+			rule := run.rule(result)
+			v := linter.Violation{
+				RuleID:   result.ruleID(rule),
+				Severity: severity(result, rule, toSeverity),
+				Message:  message(result.Message, rule),
+			}
+			if len(result.Locations) > 0 && result.Locations[0].PhysicalLocation != nil {
+				loc := result.Locations[0].PhysicalLocation
+				v.File = run.resolveURI(loc.ArtifactLocation)
+				if loc.Region != nil {
+					v.Line = loc.Region.StartLine
+					v.Column = loc.Region.StartColumn
+				}
+			}
+			violations = append(violations, v)
+		}
+	}
+	return violations, nil
+}
+
+// LevelToSeverity maps a SARIF level to "error", "warning" or "info".
+func LevelToSeverity(level string) string {
+	switch strings.ToLower(level) {
+	case "error":
+		return "error"
+	case "warning", "":
+		return "warning"
+	default: // note, none
+		return "info"
+	}
+}
+
+// rule returns the driver rule referenced by the result, or nil.
+func (r *Run) rule(result Result) *ReportingDescriptor {
+	index := result.RuleIndex
+	if index == nil && result.Rule != nil {
+		index = result.Rule.Index
+	}
+	rules := r.Tool.Driver.Rules
+	if index != nil && *index >= 0 && *index < len(rules) {
+		return &rules[*index]
+	}
+
+	id := result.RuleID
+	if id == "" && result.Rule != nil {
+		id = result.Rule.ID
+	}
+	for i := range rules {
+		if id != "" && rules[i].ID == id {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// resolveURI returns the file path of an artifact location.
+func (r *Run) resolveURI(loc ArtifactLocation) string {
+	uri := loc.URI
+	baseID := loc.URIBaseID
+	if uri == "" && loc.Index != nil && *loc.Index >= 0 && *loc.Index < len(r.Artifacts) {
+		uri = r.Artifacts[*loc.Index].Location.URI
+		if baseID == "" {
+			baseID = r.Artifacts[*loc.Index].Location.URIBaseID
+		}
+	}
+
+	if base, ok := r.OriginalURIBaseID[baseID]; ok && baseID != "" && base.URI != "" && !strings.Contains(uri, "://") {
+		uri = strings.TrimSuffix(base.URI, "/") + "/" + strings.TrimPrefix(uri, "/")
+	}
+	return uriToPath(uri)
+}
+
+// ruleID returns the result's rule ID.
+func (r Result) ruleID(rule *ReportingDescriptor) string {
+	switch {
+	case r.RuleID != "":
+		return r.RuleID
+	case r.Rule != nil && r.Rule.ID != "":
+		return r.Rule.ID
+	case rule != nil:
+		return rule.ID
+	}
+	return ""
+}
+
+// severity returns the normalized severity of a result.
+func severity(result Result, rule *ReportingDescriptor, toSeverity func(string) string) string {
+	if result.Level != "" {
+		return toSeverity(result.Level)
+	}
+	if result.Kind == "informational" {
+		return "info"
+	}
+	if rule != nil && rule.DefaultConfiguration != nil {
+		return toSeverity(rule.DefaultConfiguration.Level)
+	}
+	return toSeverity("")
+}
+
+// message returns the result text, falling back to the rule's message strings
+// and short description. {0}, {1}, ... placeholders are replaced by the arguments.
+func message(msg Message, rule *ReportingDescriptor) string {
+	text := msg.Text
+	if text == "" {
+		text = msg.Markdown
+	}
+	if text == "" && rule != nil {
+		if s, ok := rule.MessageStrings[msg.ID]; ok && msg.ID != "" {
+			text = s.Text
+		} else if rule.ShortDescription != nil {
+			text = rule.ShortDescription.Text
+		}
+	}
+	for i, arg := range msg.Arguments {
+		text = strings.ReplaceAll(text, fmt.Sprintf("{%d}", i), arg)
+	}
+	return text
+}
+
+// isSuppressed reports whether the result has a suppression that was not rejected.
+func isSuppressed(result Result) bool {
+	for _, s := range result.Suppressions {
+		if s.Status != "rejected" {
+			return true
+		}
+	}
+	return false
+}
+
+// uriToPath converts a file URI or relative URI reference to a file path.
+func uriToPath(uri string) string {
+	if u, err := url.Parse(uri); err == nil {
+		if u.Scheme == "file" {
+			p := u.Path
+			// Non-standard relative form file://src/a.go parses "src" as host
+			if u.Host != "" && u.Host != "localhost" {
+				p = u.Host + p
+			}
+			// file:///C:/dir → C:/dir
+			if len(p) > 2 && p[0] == '/' && p[2] == ':' {
+				p = p[1:]
+			}
+			return path.Clean(p)
+		}
+		if u.Scheme == "" {
+			return u.Path
+		}
+	}
+	return uri
+}
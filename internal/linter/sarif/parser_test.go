@@ -0,0 +1,92 @@
+package sarif
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Results(t *testing.T) {
+	data := []byte(`{
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {"name": "gosec", "rules": [
+				{"id": "G101", "shortDescription": {"text": "Hardcoded credentials"}, "defaultConfiguration": {"level": "error"}},
+				{"id": "G104", "messageStrings": {"unchecked": {"text": "Errors unhandled in {0}"}}}
+			]}},
+			"results": [
+				{"ruleId": "G101", "level": "warning", "message": {"text": "Potential hardcoded credentials"},
+				 "locations": [{"physicalLocation": {"artifactLocation": {"uri": "cmd/main.go"}, "region": {"startLine": 12, "startColumn": 2}}}]},
+				{"ruleIndex": 0, "message": {},
+				 "locations": [{"physicalLocation": {"artifactLocation": {"uri": "file:///repo/internal/db.go"}, "region": {"startLine": 3}}}]},
+				{"rule": {"index": 1}, "level": "note", "message": {"id": "unchecked", "arguments": ["main"]},
+				 "locations": [{"physicalLocation": {"artifactLocation": {"index": 0}}}]}
+			],
+			"artifacts": [{"location": {"uri": "pkg/util%20x.go", "uriBaseId": "SRCROOT"}}],
+			"originalUriBaseIds": {"SRCROOT": {"uri": "file:///repo/"}}
+		}]
+	}`)
+
+	violations, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, violations, 3)
+
+	assert.Equal(t, linter.Violation{File: "cmd/main.go", Line: 12, Column: 2, Message: "Potential hardcoded credentials", Severity: "warning", RuleID: "G101"}, violations[0])
+
+	// ruleIndex resolves the rule ID, default level and short description
+	assert.Equal(t, linter.Violation{File: "/repo/internal/db.go", Line: 3, Message: "Hardcoded credentials", Severity: "error", RuleID: "G101"}, violations[1])
+
+	// Artifact index, uriBaseId and message strings with arguments
+	assert.Equal(t, linter.Violation{File: "/repo/pkg/util x.go", Message: "Errors unhandled in main", Severity: "info", RuleID: "G104"}, violations[2])
+}
+
+func TestParse_SkipsPassAndSuppressed(t *testing.T) {
+	data := []byte(`{"runs": [{"results": [
+		{"ruleId": "a", "kind": "pass", "message": {"text": "ok"}},
+		{"ruleId": "b", "kind": "notApplicable", "message": {"text": "n/a"}},
+		{"ruleId": "c", "message": {"text": "suppressed"}, "suppressions": [{"kind": "inSource", "status": "accepted"}]},
+		{"ruleId": "d", "message": {"text": "rejected suppression"}, "suppressions": [{"kind": "external", "status": "rejected"}]},
+		{"ruleId": "e", "kind": "informational", "message": {"text": "fyi"}}
+	]}]}`)
+
+	violations, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, "d", violations[0].RuleID)
+	assert.Equal(t, "warning", violations[0].Severity) // SARIF default level
+	assert.Equal(t, "e", violations[1].RuleID)
+	assert.Equal(t, "info", violations[1].Severity)
+}
+
+func TestParse_MultipleRuns(t *testing.T) {
+	data := []byte(`{"runs": [
+		{"results": [{"ruleId": "r1", "level": "error", "message": {"text": "one"}}]},
+		{"results": [{"ruleId": "r2", "level": "none", "message": {"text": "two"}}]}
+	]}`)
+
+	violations, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, "error", violations[0].Severity)
+	assert.Equal(t, "info", violations[1].Severity)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse([]byte("not sarif"))
+	assert.ErrorContains(t, err, "failed to parse SARIF output")
+}
+
+func TestLevelToSeverity(t *testing.T) {
+	tests := map[string]string{
+		"error":   "error",
+		"warning": "warning",
+		"":        "warning",
+		"note":    "info",
+		"none":    "info",
+	}
+	for level, want := range tests {
+		assert.Equal(t, want, LevelToSeverity(level), level)
+	}
+}
@@ -0,0 +1,377 @@
+package semgrep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+// supportedLanguages lists the languages Semgrep rules are generated for.
+var supportedLanguages = []string{
+	"go", "python", "javascript", "typescript", "jsx", "tsx", "java", "kotlin",
+	"ruby", "rust", "c", "cpp", "csharp", "php", "scala", "swift",
+}
+
+// languageExtensions maps languages to file extensions for example files.
+var languageExtensions = map[string]string{
+	"go":         ".go",
+	"python":     ".py",
+	"javascript": ".js",
+	"typescript": ".ts",
+	"jsx":        ".jsx",
+	"tsx":        ".tsx",
+	"java":       ".java",
+	"kotlin":     ".kt",
+	"ruby":       ".rb",
+	"rust":       ".rs",
+	"c":          ".c",
+	"cpp":        ".cpp",
+	"csharp":     ".cs",
+	"php":        ".php",
+	"scala":      ".scala",
+	"swift":      ".swift",
+}
+
+// ruleChecker validates generated rules and runs them against example code.
+// It is implemented by Linter; tests use a fake.
+type ruleChecker interface {
+	ValidateRules(ctx context.Context, config []byte) error
+	CountFindings(ctx context.Context, config []byte, ext, code string) (int, error)
+}
+
+// Converter converts rules to Semgrep rules using LLM.
+//
+// Every generated rule is checked with `semgrep --validate` and run against
+// the rule's good/bad examples before it is accepted. A rule that fails any
+// check returns an error, so the main converter falls back to llm-validator.
+type Converter struct {
+	checker ruleChecker
+}
+
+// NewConverter creates a new Semgrep converter
+func NewConverter() *Converter {
+	return &Converter{checker: New(linter.DefaultToolsDir())}
+}
+
+func (c *Converter) Name() string {
+	return "semgrep"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return supportedLanguages
+}
+
+// GetLLMDescription returns a description of Semgrep's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Semgrep structural pattern matching (custom rules generated per convention, many languages)
+  - CAN: Forbidden function/method calls, required wrappers instead of direct APIs (e.g., use httpclient instead of net/http),
+         dangerous call patterns (SQL built by string concatenation, eval, shell exec), forbidden imports,
+         call argument patterns, missing error handling around specific calls, deprecated API usage
+  - CANNOT: Naming conventions across a codebase, formatting, documentation quality, cross-file architecture,
+         business logic correctness, type checking`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use Semgrep
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For structural code patterns (forbidden calls, required wrappers, dangerous call shapes) → use semgrep",
+		"For 'use X instead of Y' API conventions → use semgrep",
+		"For security patterns (SQL string concatenation, eval, command injection) → use semgrep",
+		"Prefer semgrep over llm-validator when the rule can be expressed as a code pattern",
+		"For naming and formatting rules → prefer the language's dedicated linter over semgrep",
+	}
+}
+
+// semgrepRuleData holds one generated Semgrep rule
+type semgrepRuleData struct {
+	Rule map[string]interface{}
+}
+
+// ConvertSingleRule converts ONE user rule to a Semgrep rule.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be expressed as a Semgrep pattern (skip),
+//	(nil, error) on conversion, validation or example test failure.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	language := c.selectLanguage(rule)
+	if language == "" {
+		return nil, nil
+	}
+	examples := linter.Examples(rule)
+
+	prompt := c.buildPrompt(rule, language, examples)
+	response, err := provider.Execute(ctx, prompt, llm.Text)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	ruleDef, err := parseGeneratedRule(response)
+	if err != nil {
+		return nil, err
+	}
+	if ruleDef == nil {
+		return nil, nil
+	}
+
+	// Normalize fields that must not depend on the LLM
+	ruleDef["languages"] = []string{language}
+	id := normalizeRule(ruleDef, rule)
+
+	config, err := marshalRules([]map[string]interface{}{ruleDef})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checker.ValidateRules(ctx, config); err != nil {
+		return nil, err
+	}
+	if err := c.testExamples(ctx, config, language, examples); err != nil {
+		return nil, err
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          semgrepRuleData{Rule: ruleDef},
+		NativeRuleIDs: []string{id},
+	}, nil
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[semgrepRuleData](data)
+}
+
+// ConvertNative uses a Semgrep rule as is: {"pattern": "eval(...)"}.
+// The id, severity and message are set like for generated rules, and
+// languages default to the rule's first Semgrep language.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var ruleDef map[string]interface{}
+	if err := json.Unmarshal(native, &ruleDef); err != nil {
+		return nil, fmt.Errorf("native config must be a Semgrep rule object: %w", err)
+	}
+	if len(ruleDef) == 0 {
+		return nil, fmt.Errorf("native config has no Semgrep rule")
+	}
+	if _, ok := ruleDef["languages"]; !ok {
+		language := c.selectLanguage(rule)
+		if language == "" {
+			return nil, fmt.Errorf("native Semgrep rule needs \"languages\" (no Semgrep language among %v)", rule.Languages)
+		}
+		ruleDef["languages"] = []string{language}
+	}
+	id := normalizeRule(ruleDef, rule)
+
+	config, err := marshalRules([]map[string]interface{}{ruleDef})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checker.ValidateRules(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          semgrepRuleData{Rule: ruleDef},
+		NativeRuleIDs: []string{id},
+	}, nil
+}
+
+// BuildConfig assembles the Semgrep rules file from successful conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]map[string]interface{}, 0, len(results))
+	for _, r := range results {
+		data, ok := r.Data.(semgrepRuleData)
+		if !ok {
+			continue
+		}
+		rules = append(rules, data.Rule)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	content, err := marshalRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &linter.LinterConfig{
+		Filename: "semgrep.yml",
+		Content:  content,
+		Format:   "yaml",
+	}, nil
+}
+
+// testExamples requires findings in every bad example and none in good examples.
+func (c *Converter) testExamples(ctx context.Context, config []byte, language string, examples schema.RuleExamples) error {
+	ext := languageExtensions[language]
+	for i, code := range examples.Bad {
+		n, err := c.checker.CountFindings(ctx, config, ext, code)
+		if err != nil {
+			return fmt.Errorf("failed to test bad example %d: %w", i+1, err)
+		}
+		if n == 0 {
+			return fmt.Errorf("generated semgrep rule does not match bad example %d", i+1)
+		}
+	}
+	for i, code := range examples.Good {
+		n, err := c.checker.CountFindings(ctx, config, ext, code)
+		if err != nil {
+			return fmt.Errorf("failed to test good example %d: %w", i+1, err)
+		}
+		if n > 0 {
+			return fmt.Errorf("generated semgrep rule matches good example %d (%d finding(s))", i+1, n)
+		}
+	}
+	return nil
+}
+
+// selectLanguage returns the first rule language Semgrep supports.
+// A rule without languages cannot be given a concrete Semgrep language.
+func (c *Converter) selectLanguage(rule schema.UserRule) string {
+	for _, lang := range rule.Languages {
+		lang = strings.ToLower(lang)
+		if _, ok := languageExtensions[lang]; ok {
+			return lang
+		}
+	}
+	return ""
+}
+
+// buildPrompt builds the Semgrep rule generation prompt
+func (c *Converter) buildPrompt(rule schema.UserRule, language string, examples schema.RuleExamples) string {
+	var sb strings.Builder
+
+	sb.WriteString(`You are a Semgrep rule expert. Write ONE Semgrep rule that reports violations of the coding rule below.
+
+Return ONLY the rule as YAML (no markdown fences, no explanation) in this structure:
+rules:
+  - id: rule-id
+    languages: [LANGUAGE]
+    severity: WARNING
+    message: Short explanation of the violation
+    patterns:
+      - pattern: ...
+      - pattern-not: ...
+
+Use pattern, patterns, pattern-either, pattern-not, pattern-inside, pattern-not-inside or metavariable-regex.
+The rule must match code that VIOLATES the rule and must not match compliant code.
+If the rule cannot be expressed as a Semgrep pattern (e.g., naming, formatting, documentation), return exactly:
+rules: []
+
+Example:
+Rule: "Never call db.Query with a string built by concatenation"
+Language: go
+Output:
+rules:
+  - id: no-query-concat
+    languages: [go]
+    severity: ERROR
+    message: Use query parameters instead of string concatenation
+    pattern-either:
+      - pattern: $DB.Query($A + $B, ...)
+      - pattern: $DB.Query(fmt.Sprintf(...), ...)`)
+
+	sb.WriteString(fmt.Sprintf("\n\nRule: %q\nLanguage: %s", rule.Say, language))
+	sb.WriteString(linter.ParamsPrompt(rule.Params))
+	for _, code := range examples.Bad {
+		sb.WriteString(fmt.Sprintf("\n\nViolating code (must match):\n%s", code))
+	}
+	for _, code := range examples.Good {
+		sb.WriteString(fmt.Sprintf("\n\nCompliant code (must not match):\n%s", code))
+	}
+	if len(examples.Bad) == 0 && len(examples.Good) == 0 && rule.Example != "" {
+		sb.WriteString(fmt.Sprintf("\n\nExample:\n%s", rule.Example))
+	}
+	return sb.String()
+}
+
+// parseGeneratedRule extracts the single rule from the LLM's YAML.
+// Returns (nil, nil) when the LLM reports the rule cannot be expressed.
+func parseGeneratedRule(response string) (map[string]interface{}, error) {
+	response = strings.TrimSpace(response)
+	response = strings.TrimPrefix(response, "```yaml")
+	response = strings.TrimPrefix(response, "```yml")
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var doc struct {
+		Rules []map[string]interface{} `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal([]byte(response), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse generated semgrep rule: %w (response: %.100s)", err, response)
+	}
+	if len(doc.Rules) == 0 {
+		return nil, nil
+	}
+	if len(doc.Rules) > 1 {
+		return nil, fmt.Errorf("LLM generated %d semgrep rules, expected 1", len(doc.Rules))
+	}
+	return doc.Rules[0], nil
+}
+
+// marshalRules renders a Semgrep rules file.
+func marshalRules(rules []map[string]interface{}) ([]byte, error) {
+	content, err := yaml.Marshal(map[string]interface{}{"rules": rules})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal semgrep rules: %w", err)
+	}
+	return content, nil
+}
+
+var unsafeIDChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// ruleIDFor returns the Semgrep rule ID for a user rule ID.
+func ruleIDFor(ruleID string) string {
+	return "sym-" + strings.Trim(unsafeIDChars.ReplaceAllString(ruleID, "-"), "-")
+}
+
+// normalizeRule sets the id, severity and message of a Semgrep rule from
+// the user rule and returns the id.
+func normalizeRule(ruleDef map[string]interface{}, rule schema.UserRule) string {
+	id := ruleIDFor(rule.ID)
+	ruleDef["id"] = id
+	ruleDef["severity"] = semgrepSeverity(rule.Severity)
+	if rule.Message != "" {
+		ruleDef["message"] = rule.Message
+	} else if msg, _ := ruleDef["message"].(string); msg == "" {
+		ruleDef["message"] = rule.Say
+	}
+	return id
+}
+
+// semgrepSeverity maps a policy severity to a Semgrep severity.
+func semgrepSeverity(severity string) string {
+	switch linter.MapSeverity(severity) {
+	case "error":
+		return "ERROR"
+	case "warning":
+		return "WARNING"
+	default:
+		if severity == "" {
+			return "ERROR"
+		}
+		return "INFO"
+	}
+}
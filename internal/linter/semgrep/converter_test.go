@@ -0,0 +1,182 @@
+package semgrep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// mockProvider is a mock LLM provider for testing
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string {
+	return "mock"
+}
+
+func (m *mockProvider) Close() error {
+	return nil
+}
+
+// fakeChecker reports a finding for code containing match.
+type fakeChecker struct {
+	validateErr error
+	match       string
+	exts        []string
+}
+
+func (f *fakeChecker) ValidateRules(ctx context.Context, config []byte) error {
+	return f.validateErr
+}
+
+func (f *fakeChecker) CountFindings(ctx context.Context, config []byte, ext, code string) (int, error) {
+	f.exts = append(f.exts, ext)
+	return strings.Count(code, f.match), nil
+}
+
+const generatedRule = `rules:
+  - id: whatever
+    languages: [python]
+    severity: INFO
+    message: Use httpclient
+    pattern: requests.get(...)
+`
+
+var httpRule = schema.UserRule{
+	ID:        "NET-1",
+	Say:       "Use the internal httpclient wrapper instead of requests",
+	Languages: []string{"Python"},
+	Severity:  "warning",
+	Example:   "# ✅ 좋은 예:\nhttpclient.get(url)\n\n# ❌ 나쁜 예:\nrequests.get(url)",
+}
+
+func TestConverter_ConvertSingleRule(t *testing.T) {
+	checker := &fakeChecker{match: "requests.get"}
+	c := &Converter{checker: checker}
+	provider := &mockProvider{response: "```yaml\n" + generatedRule + "```"}
+
+	result, err := c.ConvertSingleRule(context.Background(), httpRule, provider)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "NET-1", result.RuleID)
+	assert.Equal(t, []string{"sym-NET-1"}, result.NativeRuleIDs)
+
+	// Examples are passed to the LLM and tested with the language's extension
+	assert.Contains(t, provider.prompt, "Violating code (must match):\nrequests.get(url)")
+	assert.Contains(t, provider.prompt, "Compliant code (must not match):\nhttpclient.get(url)")
+	assert.Equal(t, []string{".py", ".py"}, checker.exts)
+
+	data := result.Data.(semgrepRuleData)
+	assert.Equal(t, "sym-NET-1", data.Rule["id"])
+	assert.Equal(t, "WARNING", data.Rule["severity"])
+	assert.Equal(t, []string{"python"}, data.Rule["languages"])
+}
+
+func TestConverter_ConvertSingleRule_Failures(t *testing.T) {
+	t.Run("invalid rule", func(t *testing.T) {
+		c := &Converter{checker: &fakeChecker{validateErr: fmt.Errorf("invalid semgrep rule: missing pattern")}}
+		_, err := c.ConvertSingleRule(context.Background(), httpRule, &mockProvider{response: generatedRule})
+		assert.ErrorContains(t, err, "missing pattern")
+	})
+
+	t.Run("does not match bad example", func(t *testing.T) {
+		c := &Converter{checker: &fakeChecker{match: "never"}}
+		_, err := c.ConvertSingleRule(context.Background(), httpRule, &mockProvider{response: generatedRule})
+		assert.ErrorContains(t, err, "does not match bad example 1")
+	})
+
+	t.Run("matches good example", func(t *testing.T) {
+		c := &Converter{checker: &fakeChecker{match: ".get("}}
+		_, err := c.ConvertSingleRule(context.Background(), httpRule, &mockProvider{response: generatedRule})
+		assert.ErrorContains(t, err, "matches good example 1")
+	})
+
+	t.Run("not expressible", func(t *testing.T) {
+		c := &Converter{checker: &fakeChecker{}}
+		result, err := c.ConvertSingleRule(context.Background(), httpRule, &mockProvider{response: "rules: []"})
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("unsupported language", func(t *testing.T) {
+		c := &Converter{checker: &fakeChecker{}}
+		rule := httpRule
+		rule.Languages = []string{"markdown"}
+		result, err := c.ConvertSingleRule(context.Background(), rule, &mockProvider{response: generatedRule})
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("malformed YAML", func(t *testing.T) {
+		c := &Converter{checker: &fakeChecker{}}
+		_, err := c.ConvertSingleRule(context.Background(), httpRule, &mockProvider{response: "rules: [unclosed"})
+		assert.ErrorContains(t, err, "failed to parse generated semgrep rule")
+	})
+}
+
+func TestConverter_BuildConfig(t *testing.T) {
+	c := &Converter{checker: &fakeChecker{match: "requests.get"}}
+	result, err := c.ConvertSingleRule(context.Background(), httpRule, &mockProvider{response: generatedRule})
+	require.NoError(t, err)
+
+	config, err := c.BuildConfig([]*linter.SingleRuleResult{result})
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, "semgrep.yml", config.Filename)
+
+	var doc struct {
+		Rules []map[string]interface{} `yaml:"rules"`
+	}
+	require.NoError(t, yaml.Unmarshal(config.Content, &doc))
+	require.Len(t, doc.Rules, 1)
+	assert.Equal(t, "sym-NET-1", doc.Rules[0]["id"])
+	assert.Equal(t, "requests.get(...)", doc.Rules[0]["pattern"])
+
+	empty, err := c.BuildConfig(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, empty)
+}
+
+func TestRuleIDFor(t *testing.T) {
+	assert.Equal(t, "sym-NET-1", ruleIDFor("NET-1"))
+	assert.Equal(t, "sym-rule-7", ruleIDFor("rule 7"))
+	assert.Equal(t, "sym-a_b-c", ruleIDFor("a_b/c."))
+}
+
+func TestConverter_ConvertNative(t *testing.T) {
+	c := &Converter{checker: &fakeChecker{}}
+
+	result, err := c.ConvertNative(context.Background(), httpRule, json.RawMessage(`{"pattern": "requests.get(...)"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sym-NET-1"}, result.NativeRuleIDs)
+	data := result.Data.(semgrepRuleData)
+	assert.Equal(t, "requests.get(...)", data.Rule["pattern"])
+	assert.Equal(t, []string{"python"}, data.Rule["languages"])
+	assert.Equal(t, "WARNING", data.Rule["severity"])
+	assert.Equal(t, httpRule.Say, data.Rule["message"])
+
+	// Rules without a Semgrep language must name one
+	noLang := schema.UserRule{ID: "X", Say: "x"}
+	_, err = c.ConvertNative(context.Background(), noLang, json.RawMessage(`{"pattern": "eval(...)"}`))
+	assert.ErrorContains(t, err, "languages")
+
+	c.checker = &fakeChecker{validateErr: fmt.Errorf("invalid pattern")}
+	_, err = c.ConvertNative(context.Background(), httpRule, json.RawMessage(`{"pattern": "("}`))
+	assert.ErrorContains(t, err, "invalid pattern")
+}
@@ -0,0 +1,294 @@
+package semgrep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/sarif"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
+
+// Linter wraps Semgrep for structural pattern matching in many languages.
+//
+// Semgrep rules are generated by the converter from natural language rules,
+// so any structural convention ("use our httpclient wrapper instead of
+// net/http") can be checked without the LLM at validation time.
+// Output is read as SARIF and parsed by the sarif package.
+//
+// Note: Linter is goroutine-safe and stateless.
+type Linter struct {
+	// ToolsDir is where the Semgrep virtualenv is installed
+	// Default: ~/.sym/tools
+	ToolsDir string
+}
+
+// New creates a new Semgrep linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+	return &Linter{ToolsDir: toolsDir}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "semgrep"
+}
+
+// GetCapabilities returns the Semgrep linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:               "semgrep",
+		SupportedLanguages: supportedLanguages,
+		SupportedCategories: []string{
+			"pattern",
+			"security",
+			"ast",
+			"error_handling",
+			"custom",
+		},
+		Version: ">=1.50.0",
+	}
+}
+
+// CheckAvailability checks if Semgrep is installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	path := l.getSemgrepCommand()
+	if path == "" {
+		return fmt.Errorf("semgrep not found (checked: %s and global PATH)", l.getLocalPath())
+	}
+	output, err := linter.NewSubprocessExecutor().Execute(ctx, path, "--version")
+	if err != nil {
+		return fmt.Errorf("semgrep not available: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("semgrep not available: %s", strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Install installs Semgrep via pip in a virtualenv.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if err := linter.EnsureDir(l.ToolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	pythonCmd := "python3"
+	if _, err := exec.LookPath(pythonCmd); err != nil {
+		pythonCmd = "python"
+		if _, err := exec.LookPath(pythonCmd); err != nil {
+			return fmt.Errorf("python not found: please install Python 3.9+ first")
+		}
+	}
+
+	executor := linter.NewSubprocessExecutor()
+	venvPath := l.getVenvPath()
+	if config.Force {
+		_ = os.RemoveAll(venvPath)
+	}
+	if _, err := os.Stat(l.getVenvBin("pip")); os.IsNotExist(err) {
+		_ = os.RemoveAll(venvPath)
+		output, err := executor.Execute(ctx, pythonCmd, "-m", "venv", venvPath)
+		if err != nil {
+			return fmt.Errorf("failed to create virtualenv: %w", err)
+		}
+		if output.ExitCode != 0 {
+			return fmt.Errorf("failed to create virtualenv: %s", strings.TrimSpace(output.Stderr+output.Stdout))
+		}
+	}
+
+	pkg := "semgrep"
+	if version := config.ToolVersion(l.Name(), ""); version != "" {
+		pkg = fmt.Sprintf("semgrep==%s", version)
+	}
+	output, err := executor.Execute(ctx, l.getVenvBin("pip"), "install", pkg)
+	if err != nil {
+		return fmt.Errorf("pip install failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("pip install failed: %s", strings.TrimSpace(output.Stderr))
+	}
+	return nil
+}
+
+// Locate reports the Semgrep installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolveSemgrep(), "--version")
+}
+
+// Uninstall removes the Semgrep virtualenv from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, filepath.Base(l.getVenvPath()))
+}
+
+// Execute runs Semgrep with the given rules config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{}, nil
+	}
+	return l.scan(ctx, config, files)
+}
+
+// ParseOutput converts Semgrep SARIF output to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	if strings.TrimSpace(output.Stdout) == "" {
+		if output.ExitCode != 0 && output.Stderr != "" {
+			return nil, fmt.Errorf("semgrep error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+	return sarif.Parse([]byte(output.Stdout))
+}
+
+// ValidateRules runs `semgrep --validate` on a rules config.
+func (l *Linter) ValidateRules(ctx context.Context, config []byte) error {
+	if err := l.ensureAvailable(ctx); err != nil {
+		return err
+	}
+
+	configPath, err := l.writeTempRules(config)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	output, err := linter.NewSubprocessExecutor().Execute(ctx, l.getSemgrepCommand(),
+		"--validate", "--config", configPath, "--metrics=off", "--disable-version-check", "--quiet")
+	if err != nil {
+		return fmt.Errorf("semgrep --validate failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		msg := strings.TrimSpace(output.Stderr)
+		if msg == "" {
+			msg = strings.TrimSpace(output.Stdout)
+		}
+		return fmt.Errorf("invalid semgrep rule: %s", msg)
+	}
+	return nil
+}
+
+// CountFindings runs a rules config against code written to a temp file with
+// the given extension and returns the number of findings.
+func (l *Linter) CountFindings(ctx context.Context, config []byte, ext, code string) (int, error) {
+	if err := l.ensureAvailable(ctx); err != nil {
+		return 0, err
+	}
+
+	dir, err := os.MkdirTemp("", "sym-semgrep-example-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	file := filepath.Join(dir, "example"+ext)
+	if err := os.WriteFile(file, []byte(code), 0644); err != nil {
+		return 0, fmt.Errorf("failed to write example: %w", err)
+	}
+
+	output, err := l.scan(ctx, config, []string{file})
+	if err != nil {
+		return 0, err
+	}
+	violations, err := l.ParseOutput(output)
+	if err != nil {
+		return 0, err
+	}
+	return len(violations), nil
+}
+
+// scan runs `semgrep scan` with SARIF output.
+func (l *Linter) scan(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	configPath, err := l.writeTempRules(config)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	args := []string{
+		"scan",
+		"--config", configPath,
+		"--sarif",
+		"--quiet",
+		"--metrics=off",
+		"--disable-version-check",
+		// Example files live in temp dirs and target files may be gitignored
+		"--no-git-ignore",
+		"--scan-unknown-extensions",
+	}
+	args = append(args, files...)
+
+	return linter.NewSubprocessExecutor().Execute(ctx, l.getSemgrepCommand(), args...)
+}
+
+// ensureAvailable installs Semgrep when it is missing.
+func (l *Linter) ensureAvailable(ctx context.Context) error {
+	if err := l.CheckAvailability(ctx); err == nil {
+		return nil
+	}
+	if err := l.Install(ctx, linter.InstallConfig{ToolsDir: l.ToolsDir}); err != nil {
+		return fmt.Errorf("semgrep not available: %w", err)
+	}
+	return nil
+}
+
+// writeTempRules writes a rules config to a temp YAML file.
+func (l *Linter) writeTempRules(config []byte) (string, error) {
+	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
+	if err := linter.EnsureDir(tmpDir); err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	f, err := os.CreateTemp(tmpDir, "semgrep-*.yml")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp config: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := f.Write(config); err != nil {
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write temp config: %w", err)
+	}
+	return f.Name(), nil
+}
+
+// getVenvPath returns the path to the Semgrep virtualenv.
+func (l *Linter) getVenvPath() string {
+	return filepath.Join(l.ToolsDir, "semgrep-venv")
+}
+
+// getVenvBin returns the path of an executable in the virtualenv.
+func (l *Linter) getVenvBin(name string) string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(l.getVenvPath(), "Scripts", name+".exe")
+	}
+	return filepath.Join(l.getVenvPath(), "bin", name)
+}
+
+// getLocalPath returns the path to the local Semgrep binary.
+func (l *Linter) getLocalPath() string {
+	return l.getVenvBin("semgrep")
+}
+
+// getSemgrepCommand returns the Semgrep binary, or "".
+func (l *Linter) getSemgrepCommand() string {
+	return l.resolveSemgrep().Path
+}
+
+// resolveSemgrep locates Semgrep: project virtualenv, PATH, then tools dir virtualenv.
+func (l *Linter) resolveSemgrep() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "semgrep",
+		Python:       true,
+		ManagedPaths: []string{l.getLocalPath()},
+	})
+}
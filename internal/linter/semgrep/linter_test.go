@@ -0,0 +1,41 @@
+package semgrep
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinter_Capabilities(t *testing.T) {
+	l := New(t.TempDir())
+	assert.Equal(t, "semgrep", l.Name())
+	caps := l.GetCapabilities()
+	assert.Contains(t, caps.SupportedLanguages, "go")
+	assert.Contains(t, caps.SupportedLanguages, "python")
+	assert.Contains(t, caps.SupportedCategories, "pattern")
+}
+
+func TestLinter_ParseOutput(t *testing.T) {
+	l := New(t.TempDir())
+
+	// Semgrep qualifies SARIF rule IDs with the config path
+	output := &linter.ToolOutput{Stdout: `{"version": "2.1.0", "runs": [{
+		"tool": {"driver": {"name": "Semgrep OSS", "rules": [{"id": "tmp.semgrep-123.sym-NET-1", "defaultConfiguration": {"level": "warning"}}]}},
+		"results": [{"ruleId": "tmp.semgrep-123.sym-NET-1", "message": {"text": "Use httpclient"},
+			"locations": [{"physicalLocation": {"artifactLocation": {"uri": "app/api.py"}, "region": {"startLine": 8, "startColumn": 5}}}]}]
+	}]}`, ExitCode: 0}
+
+	violations, err := l.ParseOutput(output)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, linter.Violation{File: "app/api.py", Line: 8, Column: 5, Message: "Use httpclient", Severity: "warning", RuleID: "tmp.semgrep-123.sym-NET-1"}, violations[0])
+
+	_, err = l.ParseOutput(&linter.ToolOutput{Stderr: "invalid config", ExitCode: 7})
+	assert.ErrorContains(t, err, "invalid config")
+
+	violations, err = l.ParseOutput(&linter.ToolOutput{})
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
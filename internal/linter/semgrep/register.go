@@ -0,0 +1,13 @@
+package semgrep
+
+import (
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func init() {
+	_ = linter.Global().RegisterTool(
+		New(linter.DefaultToolsDir()),
+		NewConverter(),
+		"semgrep.yml",
+	)
+}
@@ -0,0 +1,259 @@
+package shellcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+var codePattern = regexp.MustCompile(`^(?:SC)?([0-9]{4})$`)
+
+// optionalChecks maps ShellCheck's opt-in checks to the codes they report.
+var optionalChecks = map[string][]string{
+	"add-default-case":           {"SC2249"},
+	"avoid-nullary-conditions":   {"SC2244"},
+	"check-extra-masked-returns": {"SC2312"},
+	"check-set-e-suppressed":     {"SC2310", "SC2311"},
+	"check-unassigned-uppercase": {"SC2154"},
+	"deprecate-which":            {"SC2230"},
+	"quote-safe-variables":       {"SC2248"},
+	"require-double-brackets":    {"SC2292"},
+	"require-variable-braces":    {"SC2250"},
+}
+
+// Converter converts rules to ShellCheck configuration using LLM
+type Converter struct{}
+
+// NewConverter creates a new ShellCheck converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return "shellcheck"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"shell", "sh", "bash"}
+}
+
+// GetLLMDescription returns a description of ShellCheck's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `Shell script analysis via ShellCheck (sh, bash, dash, ksh)
+  - CAN: Quoting ($var vs "$var", SC2086), word splitting and globbing, unused/unassigned variables (SC2034, SC2154),
+         cd without error handling (SC2164), useless cat/echo, backticks vs $() (SC2006), read without -r (SC2162),
+         POSIX portability, [[ ]] vs [ ] (require-double-brackets), ${var} braces (require-variable-braces),
+         case without default (add-default-case), which vs command -v (deprecate-which), masked return values
+  - CANNOT: Naming conventions, file headers, Dockerfiles, business logic, required 'set -euo pipefail'`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use ShellCheck
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For shell script quoting, variable usage, portability or error handling → use shellcheck",
+		"For shell naming or required header lines (e.g., 'set -euo pipefail') → use sym-pattern, not shellcheck",
+	}
+}
+
+// shellCheckRuleData holds ShellCheck-specific conversion data
+type shellCheckRuleData struct {
+	Codes    []string
+	Optional []string
+}
+
+// ConvertSingleRule converts ONE user rule to ShellCheck check codes.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by ShellCheck (skip),
+//	(nil, error) on actual conversion error.
+//
+// Note: Concurrency is handled by the main converter.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		Codes    []string `json:"codes"`
+		Optional []string `json:"optional"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	if len(result.Codes) == 0 && len(result.Optional) == 0 {
+		return nil, nil
+	}
+	return checksResult(rule, result.Codes, result.Optional)
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[shellCheckRuleData](data)
+}
+
+// ConvertNative selects ShellCheck codes and optional checks by name:
+// {"codes": ["SC2086"], "optional": ["require-variable-braces"]}.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	var checks struct {
+		Codes    []string `json:"codes"`
+		Optional []string `json:"optional"`
+	}
+	if err := json.Unmarshal(native, &checks); err != nil {
+		return nil, fmt.Errorf("native config must be an object of codes and optional checks: %w", err)
+	}
+	if len(checks.Codes) == 0 && len(checks.Optional) == 0 {
+		return nil, fmt.Errorf("native config has no codes or optional checks")
+	}
+	return checksResult(rule, checks.Codes, checks.Optional)
+}
+
+// checksResult validates check codes and optional check names.
+func checksResult(rule schema.UserRule, codes, optional []string) (*linter.SingleRuleResult, error) {
+	data := shellCheckRuleData{}
+	for _, name := range optional {
+		checkCodes, ok := optionalChecks[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown ShellCheck optional check %q", name)
+		}
+		data.Optional = appendUnique(data.Optional, name)
+		data.Codes = appendUnique(data.Codes, checkCodes...)
+	}
+	for _, code := range codes {
+		m := codePattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(code)))
+		if m == nil {
+			return nil, fmt.Errorf("invalid ShellCheck code %q", code)
+		}
+		data.Codes = appendUnique(data.Codes, "SC"+m[1])
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID:        rule.ID,
+		Data:          data,
+		NativeRuleIDs: data.Codes,
+	}, nil
+}
+
+// BuildConfig assembles shellcheck.json from successful rule conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	var cfg Config
+	for _, r := range results {
+		data, ok := r.Data.(shellCheckRuleData)
+		if !ok {
+			continue
+		}
+		cfg.Include = appendUnique(cfg.Include, data.Codes...)
+		cfg.Enable = appendUnique(cfg.Enable, data.Optional...)
+	}
+
+	if len(cfg.Include) == 0 {
+		return nil, nil
+	}
+
+	content, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: "shellcheck.json",
+		Content:  content,
+		Format:   "json",
+	}, nil
+}
+
+// buildPrompt builds the ShellCheck rule conversion prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	optional := make([]string, 0, len(optionalChecks))
+	for name, codes := range optionalChecks {
+		optional = append(optional, fmt.Sprintf("- %s (%s)", name, strings.Join(codes, ", ")))
+	}
+	sort.Strings(optional)
+
+	prompt := fmt.Sprintf(`You are a ShellCheck expert. Convert the natural language shell scripting rule to ShellCheck checks.
+
+Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "codes": ["SC2086", ...],
+  "optional": ["optional-check-name", ...]
+}
+
+"codes" lists the ShellCheck codes that detect violations of the rule. Only these codes are reported.
+"optional" lists opt-in checks that must be enabled for the rule (they are off by default):
+%s
+
+Common codes:
+- SC2086 (double quote to prevent globbing and word splitting), SC2046 (quote command substitution)
+- SC2034 (variable appears unused), SC2154 (variable referenced but not assigned)
+- SC2164 (cd without || exit), SC2162 (read without -r), SC2006 (use $(...) instead of backticks)
+- SC2002 (useless cat), SC2155 (declare and assign separately to avoid masking return values)
+- SC2044/SC2045 (iterating over find/ls output), SC3010-SC3060 (non-POSIX features in sh scripts)
+
+If the rule cannot be expressed with ShellCheck, return:
+{
+  "codes": [],
+  "optional": []
+}
+
+Examples:
+
+Input: "Always quote variable expansions"
+Output:
+{
+  "codes": ["SC2086", "SC2248"],
+  "optional": ["quote-safe-variables"]
+}
+
+Input: "Use $(...) instead of backticks"
+Output:
+{
+  "codes": ["SC2006"],
+  "optional": []
+}`, strings.Join(optional, "\n"))
+
+	prompt += fmt.Sprintf("\n\nConvert this rule to ShellCheck checks:\n\n%s", rule.Say)
+	prompt += linter.ParamsPrompt(rule.Params)
+	return prompt
+}
+
+// appendUnique appends values that are not already present.
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, existing := range list {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,123 @@
+package shellcheck
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(_ context.Context, prompt string, _ llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) Close() error { return nil }
+
+func TestConvertSingleRule(t *testing.T) {
+	provider := &mockProvider{response: `{"codes": ["SC2086", "2046"], "optional": ["require-variable-braces"]}`}
+	rule := schema.UserRule{ID: "SH-QUOTE", Say: "Always quote variable expansions"}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("ConvertSingleRule() returned nil result")
+	}
+
+	want := []string{"SC2250", "SC2086", "SC2046"}
+	if strings.Join(result.NativeRuleIDs, ",") != strings.Join(want, ",") {
+		t.Errorf("NativeRuleIDs = %v, want %v", result.NativeRuleIDs, want)
+	}
+	if !strings.Contains(provider.prompt, rule.Say) || !strings.Contains(provider.prompt, "require-double-brackets (SC2292)") {
+		t.Error("prompt should include the rule and optional checks")
+	}
+}
+
+func TestConvertSingleRule_Skip(t *testing.T) {
+	provider := &mockProvider{response: `{"codes": [], "optional": []}`}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "Script names use kebab-case"}, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result != nil {
+		t.Errorf("ConvertSingleRule() = %+v, want nil", result)
+	}
+}
+
+func TestConvertSingleRule_Invalid(t *testing.T) {
+	for _, response := range []string{
+		`not json`,
+		`{"codes": ["quote-everything"]}`,
+		`{"codes": [], "optional": ["all"]}`,
+	} {
+		_, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response})
+		if err == nil {
+			t.Errorf("ConvertSingleRule(%q) expected error", response)
+		}
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: shellCheckRuleData{Codes: []string{"SC2086"}}},
+		{RuleID: "R2", Data: shellCheckRuleData{Codes: []string{"SC2250", "SC2086"}, Optional: []string{"require-variable-braces"}}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil {
+		t.Fatalf("BuildConfig() error = %v", err)
+	}
+	if config.Filename != "shellcheck.json" {
+		t.Errorf("Filename = %q, want shellcheck.json", config.Filename)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(config.Content, &cfg); err != nil {
+		t.Fatalf("config is not valid JSON: %v", err)
+	}
+	if strings.Join(cfg.Include, ",") != "SC2086,SC2250" {
+		t.Errorf("Include = %v, want [SC2086 SC2250]", cfg.Include)
+	}
+	if strings.Join(cfg.Enable, ",") != "require-variable-braces" {
+		t.Errorf("Enable = %v, want [require-variable-braces]", cfg.Enable)
+	}
+}
+
+func TestBuildConfig_Empty(t *testing.T) {
+	config, err := NewConverter().BuildConfig(nil)
+	if err != nil || config != nil {
+		t.Errorf("BuildConfig(nil) = %v, %v; want nil, nil", config, err)
+	}
+}
+
+func TestConvertNative(t *testing.T) {
+	rule := schema.UserRule{ID: "SH-1", Say: "Quote variables and add default cases"}
+
+	result, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"codes": ["sc2086"], "optional": ["add-default-case"]}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	if got := strings.Join(result.NativeRuleIDs, ","); got != "SC2249,SC2086" {
+		t.Errorf("NativeRuleIDs = %s", got)
+	}
+
+	for _, native := range []string{`{}`, `{"codes": ["quote"]}`, `{"optional": ["unknown-check"]}`} {
+		if _, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(native)); err == nil {
+			t.Errorf("ConvertNative(%s) expected error", native)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package shellcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Config is the content of shellcheck.json.
+// ShellCheck's rc file cannot restrict checks to a list of codes, so the
+// generated config is translated to command-line flags instead.
+type Config struct {
+	// Include lists the SC codes to report (e.g., "SC2086"); all other checks are off
+	Include []string `json:"include"`
+
+	// Enable lists optional checks to turn on (e.g., "require-variable-braces")
+	Enable []string `json:"enable,omitempty"`
+}
+
+// execute runs ShellCheck with the given config and files.
+func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{
+			Stdout:   `{"comments":[]}`,
+			ExitCode: 0,
+		}, nil
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid shellcheck config: %w", err)
+	}
+
+	args := getExecutionArgs(cfg, files)
+
+	// Execute - uses CWD by default
+	return l.executor.Execute(ctx, l.getShellCheckCommand(ctx), args...)
+}
+
+// getExecutionArgs returns the ShellCheck arguments for a config.
+func getExecutionArgs(cfg Config, files []string) []string {
+	args := []string{
+		"--format=json1",
+		"--norc", // Ignore the project's .shellcheckrc; only generated rules apply
+	}
+	if len(cfg.Include) > 0 {
+		args = append(args, "--include="+strings.Join(cfg.Include, ","))
+	}
+	if len(cfg.Enable) > 0 {
+		args = append(args, "--enable="+strings.Join(cfg.Enable, ","))
+	}
+	args = append(args, "--")
+	args = append(args, files...)
+
+	return args
+}
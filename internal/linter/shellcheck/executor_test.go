@@ -0,0 +1,39 @@
+package shellcheck
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestGetExecutionArgs(t *testing.T) {
+	cfg := Config{
+		Include: []string{"SC2086", "SC2250"},
+		Enable:  []string{"require-variable-braces"},
+	}
+
+	got := strings.Join(getExecutionArgs(cfg, []string{"a.sh", "bin/run"}), " ")
+	want := "--format=json1 --norc --include=SC2086,SC2250 --enable=require-variable-braces -- a.sh bin/run"
+	if got != want {
+		t.Errorf("getExecutionArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestExecute_InvalidConfig(t *testing.T) {
+	l := New(t.TempDir())
+	if _, err := l.Execute(context.Background(), []byte("enable=all"), []string{"a.sh"}); err == nil {
+		t.Error("Execute() expected error for non-JSON config")
+	}
+}
+
+func TestExecute_NoFiles(t *testing.T) {
+	l := New(t.TempDir())
+	output, err := l.Execute(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	violations, err := l.ParseOutput(output)
+	if err != nil || len(violations) != 0 {
+		t.Errorf("ParseOutput() = %v, %v; want no violations", violations, err)
+	}
+}
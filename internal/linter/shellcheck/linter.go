@@ -0,0 +1,257 @@
+package shellcheck
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter           = (*Linter)(nil)
+	_ linter.ArtifactProvider = (*Linter)(nil)
+	_ linter.Locator          = (*Linter)(nil)
+	_ linter.Uninstaller      = (*Linter)(nil)
+)
+
+const (
+	// DefaultVersion is the default ShellCheck version.
+	DefaultVersion = "0.10.0"
+
+	// GitHubReleaseURL is the base URL for ShellCheck releases.
+	GitHubReleaseURL = "https://github.com/koalaman/shellcheck/releases/download"
+)
+
+// Linter wraps ShellCheck for shell script validation.
+//
+// ShellCheck finds quoting bugs, unsafe expansions, portability problems
+// and style issues in sh/bash/dash/ksh scripts. Every check has a stable
+// SC code (e.g., SC2086), and some opinionated checks are optional and
+// must be enabled by name (e.g., require-variable-braces).
+//
+// Note: Linter is goroutine-safe and stateless. WorkDir is determined
+// by CWD at execution time, not stored in the linter.
+type Linter struct {
+	// ToolsDir is where ShellCheck is installed
+	// Default: ~/.sym/tools
+	ToolsDir string
+
+	// executor runs ShellCheck subprocess
+	executor *linter.SubprocessExecutor
+}
+
+// New creates a new ShellCheck linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+
+	return &Linter{
+		ToolsDir: toolsDir,
+		executor: linter.NewSubprocessExecutor(),
+	}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "shellcheck"
+}
+
+// GetCapabilities returns the ShellCheck linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:               "shellcheck",
+		SupportedLanguages: []string{"shell", "sh", "bash"},
+		SupportedCategories: []string{
+			"style",
+			"error_handling",
+			"pattern",
+			"security",
+		},
+		Version: DefaultVersion,
+	}
+}
+
+// CheckAvailability checks if ShellCheck is installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	path := linter.FindTool(l.getLocalPath(ctx), "shellcheck")
+	if path == "" {
+		return fmt.Errorf("shellcheck not found at %s or in PATH: run Install first", l.getLocalPath(ctx))
+	}
+
+	cmd := exec.CommandContext(ctx, path, "--version")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("shellcheck execution failed: %w", err)
+	}
+	return nil
+}
+
+// Install downloads ShellCheck from GitHub releases.
+// Release archives are tar.xz on Linux/macOS (extracted with the system tar)
+// and zip on Windows.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if err := linter.EnsureDir(l.ToolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	version := config.ToolVersion(l.Name(), DefaultVersion)
+
+	installDir := filepath.Join(l.ToolsDir, fmt.Sprintf("shellcheck-%s", version))
+	if !config.Force {
+		if _, err := os.Stat(filepath.Join(installDir, binaryName())); err == nil {
+			return nil // Already installed
+		}
+	}
+
+	art, err := l.Artifact(version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return err
+	}
+
+	archivePath := filepath.Join(l.ToolsDir, art.Name)
+	if err := linter.FetchArtifact(ctx, config, art, archivePath); err != nil {
+		return fmt.Errorf("failed to download shellcheck: %w", err)
+	}
+	defer func() { _ = os.Remove(archivePath) }()
+
+	if err := l.extractArchive(ctx, archivePath, version, installDir); err != nil {
+		return fmt.Errorf("failed to extract shellcheck: %w", err)
+	}
+
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(filepath.Join(installDir, binaryName()), 0755); err != nil {
+			return fmt.Errorf("failed to make shellcheck executable: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Locate reports the ShellCheck installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	local := l.getLocalPath(ctx)
+	return linter.LocateTool(ctx, linter.PathTool(linter.FindTool(local, "shellcheck"), local), "--version")
+}
+
+// Uninstall removes all ShellCheck versions from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.RemoveInstalls(l.ToolsDir, "shellcheck-*")
+}
+
+// Execute runs ShellCheck with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files)
+}
+
+// ParseOutput converts ShellCheck json1 output to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(output)
+}
+
+// getLocalPath returns the path to the managed ShellCheck binary.
+func (l *Linter) getLocalPath(ctx context.Context) string {
+	return filepath.Join(l.ToolsDir, fmt.Sprintf("shellcheck-%s", linter.LockedVersion(ctx, l.Name(), DefaultVersion)), binaryName())
+}
+
+// getShellCheckCommand returns the ShellCheck command to use.
+func (l *Linter) getShellCheckCommand(ctx context.Context) string {
+	if path := linter.FindTool(l.getLocalPath(ctx), "shellcheck"); path != "" {
+		return path
+	}
+	// Fall back to local path (will fail with proper error)
+	return l.getLocalPath(ctx)
+}
+
+// binaryName returns the ShellCheck executable name for the current OS.
+func binaryName() string {
+	if runtime.GOOS == "windows" {
+		return "shellcheck.exe"
+	}
+	return "shellcheck"
+}
+
+// getDownloadURL constructs the release URL for an OS and architecture.
+func getDownloadURL(version, goos, goarch string) (string, error) {
+	if goos == "windows" {
+		if goarch != "amd64" {
+			return "", fmt.Errorf("unsupported architecture: %s", goarch)
+		}
+		return fmt.Sprintf("%s/v%s/shellcheck-v%s.zip", GitHubReleaseURL, version, version), nil
+	}
+
+	if goos != "linux" && goos != "darwin" {
+		return "", fmt.Errorf("unsupported OS: %s", goos)
+	}
+
+	var archName string
+	switch goarch {
+	case "amd64":
+		archName = "x86_64"
+	case "arm64":
+		archName = "aarch64"
+	default:
+		return "", fmt.Errorf("unsupported architecture: %s", goarch)
+	}
+
+	return fmt.Sprintf("%s/v%s/shellcheck-v%s.%s.%s.tar.xz", GitHubReleaseURL, version, version, goos, archName), nil
+}
+
+// Artifact returns the ShellCheck release archive for a platform.
+func (l *Linter) Artifact(version, goos, goarch string) (linter.Artifact, error) {
+	if version == "" {
+		version = DefaultVersion
+	}
+	url, err := getDownloadURL(version, goos, goarch)
+	if err != nil {
+		return linter.Artifact{}, err
+	}
+	return linter.Artifact{Tool: l.Name(), Version: version, Name: path.Base(url), URL: url}, nil
+}
+
+// extractArchive extracts the ShellCheck binary into installDir.
+func (l *Linter) extractArchive(ctx context.Context, archivePath, version, installDir string) error {
+	tempDir := filepath.Join(l.ToolsDir, ".tmp-extract-shellcheck")
+	if err := os.MkdirAll(tempDir, 0755); err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "unzip", "-q", "-o", archivePath, "-d", tempDir)
+	} else {
+		cmd = exec.CommandContext(ctx, "tar", "-xJf", archivePath, "-C", tempDir)
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("extraction failed: %w (ensure tar with xz support or unzip is installed)", err)
+	}
+
+	// tar.xz archives contain shellcheck-v{version}/shellcheck; the zip has the binary at the root
+	extracted := filepath.Join(tempDir, fmt.Sprintf("shellcheck-v%s", version), binaryName())
+	if _, err := os.Stat(extracted); err != nil {
+		extracted = filepath.Join(tempDir, binaryName())
+		if _, err := os.Stat(extracted); err != nil {
+			return fmt.Errorf("shellcheck binary not found in archive")
+		}
+	}
+
+	if err := os.RemoveAll(installDir); err != nil {
+		return fmt.Errorf("failed to remove old installation: %w", err)
+	}
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return fmt.Errorf("failed to create installation dir: %w", err)
+	}
+	if err := os.Rename(extracted, filepath.Join(installDir, binaryName())); err != nil {
+		return fmt.Errorf("failed to move to installation dir: %w", err)
+	}
+
+	return nil
+}
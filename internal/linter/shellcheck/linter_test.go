@@ -0,0 +1,40 @@
+package shellcheck
+
+import (
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	l := New("")
+	if l.ToolsDir == "" {
+		t.Error("ToolsDir should not be empty")
+	}
+	if l.Name() != "shellcheck" {
+		t.Errorf("Name() = %q, want shellcheck", l.Name())
+	}
+}
+
+func TestGetDownloadURL(t *testing.T) {
+	tests := []struct {
+		goos, goarch string
+		want         string
+		wantErr      bool
+	}{
+		{"linux", "amd64", GitHubReleaseURL + "/v0.10.0/shellcheck-v0.10.0.linux.x86_64.tar.xz", false},
+		{"darwin", "arm64", GitHubReleaseURL + "/v0.10.0/shellcheck-v0.10.0.darwin.aarch64.tar.xz", false},
+		{"windows", "amd64", GitHubReleaseURL + "/v0.10.0/shellcheck-v0.10.0.zip", false},
+		{"freebsd", "amd64", "", true},
+		{"linux", "386", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := getDownloadURL("0.10.0", tt.goos, tt.goarch)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("getDownloadURL(%s/%s) error = %v, wantErr %v", tt.goos, tt.goarch, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("getDownloadURL(%s/%s) = %q, want %q", tt.goos, tt.goarch, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package shellcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// ShellCheckOutput is the top-level `--format=json1` output.
+type ShellCheckOutput struct {
+	Comments []ShellCheckComment `json:"comments"`
+}
+
+// ShellCheckComment represents a single ShellCheck finding.
+type ShellCheckComment struct {
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	EndLine   int    `json:"endLine"`
+	Column    int    `json:"column"`
+	EndColumn int    `json:"endColumn"`
+	Level     string `json:"level"` // error, warning, info, style
+	Code      int    `json:"code"`  // e.g., 2086 for SC2086
+	Message   string `json:"message"`
+}
+
+// parseOutput converts ShellCheck json1 output to violations.
+// Exit code 1 means findings were reported; anything higher is a ShellCheck failure.
+func parseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	stdout := strings.TrimSpace(output.Stdout)
+	if stdout == "" {
+		if output.ExitCode > 1 {
+			return nil, fmt.Errorf("shellcheck error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+
+	var result ShellCheckOutput
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse ShellCheck output: %w", err)
+	}
+
+	violations := make([]linter.Violation, 0, len(result.Comments))
+	for _, c := range result.Comments {
+		violations = append(violations, linter.Violation{
+			File:     c.File,
+			Line:     c.Line,
+			Column:   c.Column,
+			Message:  c.Message,
+			Severity: linter.MapSeverity(c.Level),
+			RuleID:   fmt.Sprintf("SC%d", c.Code),
+		})
+	}
+
+	return violations, nil
+}
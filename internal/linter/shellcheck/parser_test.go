@@ -0,0 +1,64 @@
+package shellcheck
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+func TestParseOutput(t *testing.T) {
+	output := &linter.ToolOutput{
+		Stdout: `{"comments":[
+			{"file":"scripts/deploy.sh","line":4,"endLine":4,"column":6,"endColumn":10,"level":"info","code":2086,"message":"Double quote to prevent globbing and word splitting."},
+			{"file":"scripts/deploy.sh","line":9,"endLine":9,"column":1,"endColumn":8,"level":"warning","code":2164,"message":"Use 'cd ... || exit' in case cd fails."},
+			{"file":"bin/run","line":2,"endLine":2,"column":1,"endColumn":3,"level":"style","code":2250,"message":"Prefer putting braces around variable references."}
+		]}`,
+		ExitCode: 1,
+	}
+
+	violations, err := parseOutput(output)
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 3 {
+		t.Fatalf("parseOutput() returned %d violations, want 3", len(violations))
+	}
+
+	tests := []struct {
+		file     string
+		line     int
+		ruleID   string
+		severity string
+	}{
+		{"scripts/deploy.sh", 4, "SC2086", "info"},
+		{"scripts/deploy.sh", 9, "SC2164", "warning"},
+		{"bin/run", 2, "SC2250", "info"},
+	}
+	for i, tt := range tests {
+		v := violations[i]
+		if v.File != tt.file || v.Line != tt.line || v.RuleID != tt.ruleID || v.Severity != tt.severity {
+			t.Errorf("violation[%d] = %+v, want %s:%d %s (%s)", i, v, tt.file, tt.line, tt.ruleID, tt.severity)
+		}
+	}
+}
+
+func TestParseOutput_Empty(t *testing.T) {
+	for _, stdout := range []string{"", `{"comments":[]}`} {
+		violations, err := parseOutput(&linter.ToolOutput{Stdout: stdout})
+		if err != nil {
+			t.Errorf("parseOutput(%q) error = %v", stdout, err)
+		}
+		if len(violations) != 0 {
+			t.Errorf("parseOutput(%q) returned %d violations, want 0", stdout, len(violations))
+		}
+	}
+}
+
+func TestParseOutput_Errors(t *testing.T) {
+	if _, err := parseOutput(&linter.ToolOutput{Stderr: "unrecognized option", ExitCode: 3}); err == nil {
+		t.Error("parseOutput() expected error for exit code 3")
+	}
+	if _, err := parseOutput(&linter.ToolOutput{Stdout: "not json", ExitCode: 1}); err == nil {
+		t.Error("parseOutput() expected error for invalid JSON")
+	}
+}
@@ -0,0 +1,266 @@
+package stylelint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Compile-time interface check
+var _ linter.Converter = (*Converter)(nil)
+
+// ruleNamePattern matches built-in Stylelint rule names (plugin rules contain "/")
+var ruleNamePattern = regexp.MustCompile(`^[a-z]+(-[a-z]+)*$`)
+
+// Converter converts rules to Stylelint configuration using LLM
+type Converter struct{}
+
+// NewConverter creates a new Stylelint converter
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) Name() string {
+	return "stylelint"
+}
+
+func (c *Converter) SupportedLanguages() []string {
+	return []string{"css", "scss"}
+}
+
+// GetLLMDescription returns a description of Stylelint's capabilities for LLM routing
+func (c *Converter) GetLLMDescription() string {
+	return `ONLY built-in Stylelint rules for CSS/SCSS (color-no-hex, selector-class-pattern, selector-max-id, max-nesting-depth, etc.)
+  - CAN: Class/ID/custom property naming patterns, banned colors/units/properties/values, !important usage,
+         selector limits (ids, specificity, compound selectors, nesting depth), notation (hex length, quotes), duplicates
+  - CANNOT: Design token semantics, unused CSS across files, component-level conventions, visual output`
+}
+
+// GetRoutingHints returns routing rules for LLM to decide when to use Stylelint
+func (c *Converter) GetRoutingHints() []string {
+	return []string{
+		"For CSS/SCSS naming (class selectors, custom properties) → use stylelint",
+		"For CSS/SCSS restrictions (no hex colors, no !important, allowed units, max nesting) → use stylelint",
+		"For CSS formatting only (indentation, spacing) → use prettier, not stylelint",
+	}
+}
+
+// stylelintRuleData holds Stylelint-specific conversion data
+type stylelintRuleData struct {
+	RuleName string
+	Config   interface{}
+}
+
+// ConvertSingleRule converts ONE user rule to Stylelint rule configuration.
+// Returns (result, nil) on success,
+//
+//	(nil, nil) if rule cannot be converted by Stylelint (skip),
+//	(nil, error) on actual conversion error.
+//
+// Note: Concurrency is handled by the main converter.
+func (c *Converter) ConvertSingleRule(ctx context.Context, rule schema.UserRule, provider llm.Provider) (*linter.SingleRuleResult, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("LLM provider is required")
+	}
+
+	prompt := c.buildPrompt(rule)
+	response, err := provider.Execute(ctx, prompt, llm.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	response = linter.CleanJSONResponse(response)
+	if response == "" {
+		return nil, fmt.Errorf("LLM returned empty response")
+	}
+
+	var result struct {
+		RuleName  string                 `json:"rule_name"`
+		Primary   interface{}            `json:"primary"`
+		Secondary map[string]interface{} `json:"secondary"`
+	}
+	if err := json.Unmarshal([]byte(response), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM response: %w (response: %.100s)", err, response)
+	}
+
+	if result.RuleName == "" {
+		return nil, nil
+	}
+	if !ruleNamePattern.MatchString(result.RuleName) {
+		return nil, fmt.Errorf("invalid Stylelint rule name %q (only built-in rules are supported)", result.RuleName)
+	}
+	if result.Primary == nil {
+		result.Primary = true
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: stylelintRuleData{
+			RuleName: result.RuleName,
+			Config:   formatRuleConfig(result.Primary, result.Secondary, rule.Severity),
+		},
+		NativeRuleIDs: []string{result.RuleName},
+	}, nil
+}
+
+// DecodeRuleData restores cached rule data for incremental conversion.
+func (c *Converter) DecodeRuleData(data json.RawMessage) (interface{}, error) {
+	return linter.UnmarshalRuleData[stylelintRuleData](data)
+}
+
+// ConvertNative uses a native Stylelint rule entry as is:
+// {"color-hex-length": "short"} or {"max-nesting-depth": [3, {"severity": "warning"}]}.
+// A null entry enables the rule at the rule's severity.
+func (c *Converter) ConvertNative(ctx context.Context, rule schema.UserRule, native json.RawMessage) (*linter.SingleRuleResult, error) {
+	ruleName, raw, err := linter.SingleNativeRule(native)
+	if err != nil {
+		return nil, err
+	}
+	if !ruleNamePattern.MatchString(ruleName) {
+		return nil, fmt.Errorf("invalid Stylelint rule name %q (only built-in rules are supported)", ruleName)
+	}
+
+	var config interface{}
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("invalid config for %s: %w", ruleName, err)
+	}
+	if config == nil {
+		config = formatRuleConfig(true, nil, rule.Severity)
+	}
+
+	return &linter.SingleRuleResult{
+		RuleID: rule.ID,
+		Data: stylelintRuleData{
+			RuleName: ruleName,
+			Config:   config,
+		},
+		NativeRuleIDs: []string{ruleName},
+	}, nil
+}
+
+// BuildConfig assembles .stylelintrc.json from successful rule conversions.
+func (c *Converter) BuildConfig(results []*linter.SingleRuleResult) (*linter.LinterConfig, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	rules := make(map[string]interface{})
+	for _, r := range results {
+		data, ok := r.Data.(stylelintRuleData)
+		if !ok {
+			continue
+		}
+		rules[data.RuleName] = data.Config
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	config := map[string]interface{}{
+		"rules": rules,
+		"overrides": []map[string]interface{}{
+			{
+				"files":        []string{"**/*.scss"},
+				"customSyntax": "postcss-scss",
+			},
+		},
+	}
+
+	content, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &linter.LinterConfig{
+		Filename: ".stylelintrc.json",
+		Content:  content,
+		Format:   "json",
+	}, nil
+}
+
+// buildPrompt builds the Stylelint rule conversion prompt
+func (c *Converter) buildPrompt(rule schema.UserRule) string {
+	prompt := `You are a Stylelint configuration expert. Convert the natural language CSS/SCSS rule to ONE built-in Stylelint rule.
+
+Return ONLY a JSON object (no markdown fences) with this structure:
+{
+  "rule_name": "stylelint-rule-name",
+  "primary": <primary option: true, a string, a number, an array or an object>,
+  "secondary": {...} or null
+}
+
+Available built-in Stylelint rules:
+- Naming: selector-class-pattern, selector-id-pattern, custom-property-pattern, keyframes-name-pattern
+- Colors: color-no-hex, color-named ("never"), color-hex-length ("short"|"long"), color-no-invalid-hex
+- Restrictions: declaration-no-important, unit-allowed-list, unit-disallowed-list, property-disallowed-list,
+  declaration-property-value-disallowed-list, declaration-property-unit-allowed-list, function-disallowed-list, at-rule-disallowed-list
+- Limits: selector-max-id, selector-max-specificity, selector-max-compound-selectors, selector-max-type, max-nesting-depth
+- Duplicates: declaration-block-no-duplicate-properties, no-duplicate-selectors
+- Notation: font-weight-notation, alpha-value-notation, length-zero-no-unit
+
+Secondary options may include "message", "ignore", "ignoreProperties" etc. Do NOT set "severity"; it is set from the policy.
+
+CRITICAL RULES:
+1. ONLY use built-in Stylelint rules - do NOT use plugin rules (e.g., scss/*, order/*)
+2. Stylistic rules removed in Stylelint 16 (indentation, max-line-length, etc.) are NOT available
+3. If no rule can enforce this requirement, return rule_name as empty string ""
+
+Examples:
+
+Input: "Do not use hex colors; use design tokens"
+Output:
+{
+  "rule_name": "color-no-hex",
+  "primary": true,
+  "secondary": null
+}
+
+Input: "Class names must be kebab-case"
+Output:
+{
+  "rule_name": "selector-class-pattern",
+  "primary": "^[a-z][a-z0-9]*(-[a-z0-9]+)*$",
+  "secondary": {"message": "Class names must be kebab-case"}
+}
+
+Input: "Components should look consistent"
+Output:
+{
+  "rule_name": "",
+  "primary": null,
+  "secondary": null
+}`
+
+	prompt += fmt.Sprintf("\n\nConvert this rule to Stylelint configuration:\n\n%s", rule.Say)
+	prompt += linter.ParamsPrompt(rule.Params)
+	return prompt
+}
+
+// formatRuleConfig builds a Stylelint rule value: [primary, secondary].
+// The policy severity always overrides any severity suggested by the LLM.
+func formatRuleConfig(primary interface{}, secondary map[string]interface{}, severity string) interface{} {
+	options := make(map[string]interface{}, len(secondary)+1)
+	for k, v := range secondary {
+		options[k] = v
+	}
+	options["severity"] = mapSeverity(severity)
+
+	return []interface{}{primary, options}
+}
+
+// mapSeverity maps user severity to Stylelint severity ("error" or "warning").
+func mapSeverity(severity string) string {
+	switch strings.ToLower(severity) {
+	case "warning", "warn", "info":
+		return "warning"
+	default:
+		return "error"
+	}
+}
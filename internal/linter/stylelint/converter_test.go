@@ -0,0 +1,144 @@
+package stylelint
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+type mockProvider struct {
+	response string
+	prompt   string
+}
+
+func (m *mockProvider) Execute(_ context.Context, prompt string, _ llm.ResponseFormat) (string, error) {
+	m.prompt = prompt
+	return m.response, nil
+}
+
+func (m *mockProvider) Name() string { return "mock" }
+
+func (m *mockProvider) Close() error { return nil }
+
+func TestConvertSingleRule(t *testing.T) {
+	provider := &mockProvider{response: `{"rule_name": "selector-class-pattern", "primary": "^[a-z-]+$", "secondary": {"message": "kebab-case", "severity": "error"}}`}
+	rule := schema.UserRule{ID: "CSS-CLASS", Say: "Class names must be kebab-case", Severity: "warning"}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), rule, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	if result == nil {
+		t.Fatal("ConvertSingleRule() returned nil result")
+	}
+	if len(result.NativeRuleIDs) != 1 || result.NativeRuleIDs[0] != "selector-class-pattern" {
+		t.Errorf("NativeRuleIDs = %v", result.NativeRuleIDs)
+	}
+
+	config := result.Data.(stylelintRuleData).Config.([]interface{})
+	if config[0] != "^[a-z-]+$" {
+		t.Errorf("primary = %v", config[0])
+	}
+	secondary := config[1].(map[string]interface{})
+	if secondary["severity"] != "warning" || secondary["message"] != "kebab-case" {
+		t.Errorf("secondary = %v, want policy severity and message", secondary)
+	}
+	if !strings.Contains(provider.prompt, rule.Say) {
+		t.Error("prompt should include the rule text")
+	}
+}
+
+func TestConvertSingleRule_DefaultPrimary(t *testing.T) {
+	provider := &mockProvider{response: `{"rule_name": "color-no-hex", "primary": null, "secondary": null}`}
+
+	result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "No hex colors"}, provider)
+	if err != nil {
+		t.Fatalf("ConvertSingleRule() error = %v", err)
+	}
+	config := result.Data.(stylelintRuleData).Config.([]interface{})
+	if config[0] != true || config[1].(map[string]interface{})["severity"] != "error" {
+		t.Errorf("Config = %v, want [true {severity: error}]", config)
+	}
+}
+
+func TestConvertSingleRule_SkipAndInvalid(t *testing.T) {
+	result, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"},
+		&mockProvider{response: `{"rule_name": ""}`})
+	if err != nil || result != nil {
+		t.Errorf("ConvertSingleRule() = %v, %v; want nil, nil", result, err)
+	}
+
+	for _, response := range []string{`not json`, `{"rule_name": "scss/dollar-variable-pattern", "primary": "^[a-z]"}`} {
+		if _, err := NewConverter().ConvertSingleRule(context.Background(), schema.UserRule{ID: "R1", Say: "x"}, &mockProvider{response: response}); err == nil {
+			t.Errorf("ConvertSingleRule(%q) expected error", response)
+		}
+	}
+}
+
+func TestBuildConfig(t *testing.T) {
+	results := []*linter.SingleRuleResult{
+		{RuleID: "R1", Data: stylelintRuleData{RuleName: "color-no-hex", Config: []interface{}{true, map[string]interface{}{"severity": "error"}}}},
+		{RuleID: "R2", Data: stylelintRuleData{RuleName: "max-nesting-depth", Config: []interface{}{3, map[string]interface{}{"severity": "warning"}}}},
+	}
+
+	config, err := NewConverter().BuildConfig(results)
+	if err != nil {
+		t.Fatalf("BuildConfig() error = %v", err)
+	}
+	if config.Filename != ".stylelintrc.json" || config.Format != "json" {
+		t.Errorf("config = %s (%s)", config.Filename, config.Format)
+	}
+
+	var parsed struct {
+		Rules     map[string]interface{}   `json:"rules"`
+		Overrides []map[string]interface{} `json:"overrides"`
+	}
+	if err := json.Unmarshal(config.Content, &parsed); err != nil {
+		t.Fatalf("config is not valid JSON: %v", err)
+	}
+	if len(parsed.Rules) != 2 {
+		t.Errorf("rules = %v, want 2 rules", parsed.Rules)
+	}
+	if len(parsed.Overrides) != 1 || parsed.Overrides[0]["customSyntax"] != "postcss-scss" {
+		t.Errorf("overrides = %v, want postcss-scss for SCSS", parsed.Overrides)
+	}
+}
+
+func TestBuildConfig_Empty(t *testing.T) {
+	config, err := NewConverter().BuildConfig(nil)
+	if err != nil || config != nil {
+		t.Errorf("BuildConfig(nil) = %v, %v; want nil, nil", config, err)
+	}
+}
+
+func TestConvertNative(t *testing.T) {
+	rule := schema.UserRule{ID: "CSS-1", Say: "Use short hex colors", Severity: "warning"}
+
+	result, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"color-hex-length": "short"}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	data := result.Data.(stylelintRuleData)
+	if data.RuleName != "color-hex-length" || data.Config != "short" {
+		t.Errorf("Data = %+v", data)
+	}
+
+	// A null entry enables the rule at the rule's severity
+	result, err = NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"color-no-invalid-hex": null}`))
+	if err != nil {
+		t.Fatalf("ConvertNative() error = %v", err)
+	}
+	config, _ := json.Marshal(result.Data.(stylelintRuleData).Config)
+	if string(config) != `[true,{"severity":"warning"}]` {
+		t.Errorf("Config = %s", config)
+	}
+
+	if _, err := NewConverter().ConvertNative(context.Background(), rule, json.RawMessage(`{"plugin/some-rule": true}`)); err == nil {
+		t.Error("expected error for plugin rule")
+	}
+}
@@ -0,0 +1,93 @@
+package stylelint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// execute runs Stylelint with the given config, files and extra flags.
+func (l *Linter) execute(ctx context.Context, config []byte, files []string, extraArgs ...string) (*linter.ToolOutput, error) {
+	if len(files) == 0 {
+		return &linter.ToolOutput{
+			Stdout:   "[]",
+			ExitCode: 0,
+		}, nil
+	}
+
+	// Write config to temp file
+	configPath, err := l.writeConfigFile(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write config: %w", err)
+	}
+	defer func() { _ = os.Remove(configPath) }()
+
+	stylelintCmd, args := l.getExecutionArgs(configPath, files)
+	args = append(args, extraArgs...)
+
+	// Execute - uses CWD by default
+	return l.executor.Execute(ctx, stylelintCmd, args...)
+}
+
+// resolveStylelint locates Stylelint: project node_modules, PATH, then tools dir.
+func (l *Linter) resolveStylelint() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "stylelint",
+		Node:         true,
+		ManagedPaths: []string{l.getStylelintPath()},
+	})
+}
+
+// getStylelintCommand returns the Stylelint command to use.
+func (l *Linter) getStylelintCommand() string {
+	if tool := l.resolveStylelint(); tool.Found() {
+		return tool.Path
+	}
+
+	// Fall back to npx
+	return "npx"
+}
+
+// getExecutionArgs returns the command and arguments for Stylelint execution.
+func (l *Linter) getExecutionArgs(configPath string, files []string) (string, []string) {
+	stylelintCmd := l.getStylelintCommand()
+
+	var args []string
+	if stylelintCmd == "npx" {
+		args = []string{"stylelint@16"}
+	}
+
+	args = append(args,
+		"--config", configPath,
+		"--config-basedir", l.ToolsDir, // Resolve customSyntax (postcss-scss) from the tools dir
+		"--formatter", "json",
+		"--allow-empty-input",
+	)
+	args = append(args, files...)
+
+	return stylelintCmd, args
+}
+
+// writeConfigFile writes Stylelint config to a temp file.
+func (l *Linter) writeConfigFile(config []byte) (string, error) {
+	tmpDir := filepath.Join(l.ToolsDir, ".tmp")
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return "", err
+	}
+
+	tmpFile, err := os.CreateTemp(tmpDir, "stylelintrc-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tmpFile.Close() }()
+
+	if _, err := tmpFile.Write(config); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+
+	return tmpFile.Name(), nil
+}
@@ -0,0 +1,60 @@
+package stylelint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetExecutionArgs(t *testing.T) {
+	toolsDir := t.TempDir()
+	l := New(toolsDir)
+
+	_, args := l.getExecutionArgs("/tmp/stylelintrc.json", []string{"a.css", "b.scss"})
+	joined := strings.Join(args, " ")
+
+	for _, want := range []string{
+		"--config /tmp/stylelintrc.json",
+		"--config-basedir " + toolsDir,
+		"--formatter json",
+		"--allow-empty-input",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("args %q should contain %q", joined, want)
+		}
+	}
+	if !strings.HasSuffix(joined, "a.css b.scss") {
+		t.Errorf("args %q should end with the files", joined)
+	}
+}
+
+func TestGetExecutionArgs_LocalInstall(t *testing.T) {
+	toolsDir := t.TempDir()
+	binDir := filepath.Join(toolsDir, "node_modules", ".bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(binDir, "stylelint"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, args := New(toolsDir).getExecutionArgs("c.json", []string{"a.css"})
+	if cmd != filepath.Join(binDir, "stylelint") {
+		t.Errorf("command = %q, want local stylelint", cmd)
+	}
+	if args[0] != "--config" {
+		t.Errorf("args[0] = %q, want --config", args[0])
+	}
+}
+
+func TestExecute_NoFiles(t *testing.T) {
+	output, err := New(t.TempDir()).Execute(context.Background(), []byte("{}"), nil)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.Stdout != "[]" {
+		t.Errorf("Stdout = %q, want []", output.Stdout)
+	}
+}
@@ -0,0 +1,168 @@
+package stylelint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Fixer       = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
+
+// DefaultVersion is the default Stylelint version range.
+const DefaultVersion = "^16.0.0"
+
+// Linter wraps Stylelint for CSS/SCSS validation.
+//
+// Stylelint covers stylesheet conventions:
+// - Pattern rules: selector-class-pattern, custom-property-pattern
+// - Restriction rules: color-no-hex, declaration-property-unit-allowed-list
+// - Limit rules: selector-max-id, max-nesting-depth, selector-max-specificity
+// - Notation rules: color-hex-length, font-weight-notation
+//
+// SCSS is parsed with postcss-scss, installed next to Stylelint.
+//
+// Note: Linter is goroutine-safe and stateless. WorkDir is determined
+// by CWD at execution time, not stored in the linter.
+type Linter struct {
+	// ToolsDir is where Stylelint is installed (shared npm tools dir)
+	// Default: ~/.sym/tools
+	ToolsDir string
+
+	// executor runs Stylelint subprocess
+	executor *linter.SubprocessExecutor
+}
+
+// New creates a new Stylelint linter.
+func New(toolsDir string) *Linter {
+	if toolsDir == "" {
+		toolsDir = linter.DefaultToolsDir()
+	}
+
+	return &Linter{
+		ToolsDir: toolsDir,
+		executor: linter.NewSubprocessExecutor(),
+	}
+}
+
+// Name returns the linter name.
+func (l *Linter) Name() string {
+	return "stylelint"
+}
+
+// GetCapabilities returns the Stylelint linter capabilities.
+func (l *Linter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{
+		Name:                "stylelint",
+		SupportedLanguages:  []string{"css", "scss"},
+		SupportedCategories: []string{"naming", "pattern", "style", "length"},
+		Version:             DefaultVersion,
+	}
+}
+
+// CheckAvailability checks if Stylelint is installed.
+func (l *Linter) CheckAvailability(ctx context.Context) error {
+	if l.resolveStylelint().Found() {
+		return nil
+	}
+
+	return fmt.Errorf("stylelint not found (checked: project node_modules, global PATH and %s)", l.getStylelintPath())
+}
+
+// Install installs Stylelint and postcss-scss via npm into the shared tools dir.
+func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error {
+	if err := linter.EnsureDir(l.ToolsDir); err != nil {
+		return fmt.Errorf("failed to create tools dir: %w", err)
+	}
+
+	if _, err := exec.LookPath("npm"); err != nil {
+		return fmt.Errorf("npm not found: please install Node.js first")
+	}
+
+	version := config.ToolVersion(l.Name(), DefaultVersion)
+
+	// Initialize package.json if needed
+	packageJSON := filepath.Join(l.ToolsDir, "package.json")
+	if _, err := os.Stat(packageJSON); os.IsNotExist(err) {
+		if err := l.initPackageJSON(); err != nil {
+			return fmt.Errorf("failed to init package.json: %w", err)
+		}
+	}
+
+	output, err := l.executor.Run(ctx, "npm", []string{"install", fmt.Sprintf("stylelint@%s", version), "postcss-scss"}, linter.WithWorkDir(l.ToolsDir))
+	if err != nil {
+		return fmt.Errorf("npm install failed: %w", err)
+	}
+	if output.ExitCode != 0 {
+		return fmt.Errorf("npm install failed: %s", strings.TrimSpace(output.Stderr))
+	}
+
+	return nil
+}
+
+// Locate reports the Stylelint installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolveStylelint(), "--version")
+}
+
+// Uninstall removes Stylelint and postcss-scss from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.NpmUninstall(ctx, l.ToolsDir, "stylelint", "postcss-scss")
+}
+
+// Execute runs Stylelint with the given config and files.
+func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files)
+}
+
+// RuleConfig keeps only the given rules of the .stylelintrc.json config.
+func (l *Linter) RuleConfig(config []byte, ruleIDs []string) ([]byte, error) {
+	return linter.KeepJSONRules(config, ruleIDs)
+}
+
+// Fix runs Stylelint with --fix, rewriting files in place.
+func (l *Linter) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	// Implementation in executor.go
+	return l.execute(ctx, config, files, "--fix")
+}
+
+// ParseOutput converts Stylelint JSON output to violations.
+func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	// Implementation in parser.go
+	return parseOutput(output)
+}
+
+// getStylelintPath returns the path to local Stylelint binary.
+func (l *Linter) getStylelintPath() string {
+	return filepath.Join(l.ToolsDir, "node_modules", ".bin", "stylelint")
+}
+
+// initPackageJSON creates a minimal package.json.
+func (l *Linter) initPackageJSON() error {
+	pkg := map[string]interface{}{
+		"name":        "symphony-tools",
+		"version":     "1.0.0",
+		"description": "Symphony validation tools",
+		"private":     true,
+	}
+
+	data, err := json.MarshalIndent(pkg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(l.ToolsDir, "package.json")
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,75 @@
+package stylelint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+// StylelintOutput represents Stylelint JSON formatter output.
+// Stylelint outputs an array of file results.
+type StylelintOutput []StylelintFileResult
+
+// StylelintFileResult represents results for a single file.
+type StylelintFileResult struct {
+	Source   string             `json:"source"`
+	Errored  bool               `json:"errored"`
+	Warnings []StylelintWarning `json:"warnings"`
+}
+
+// StylelintWarning represents a single violation.
+type StylelintWarning struct {
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndLine   int    `json:"endLine,omitempty"`
+	EndColumn int    `json:"endColumn,omitempty"`
+	Rule      string `json:"rule"`
+	Severity  string `json:"severity"` // error, warning
+	Text      string `json:"text"`
+}
+
+// parseOutput converts Stylelint JSON output to violations.
+// Stylelint 16 writes formatter output to stderr, so stderr is used when
+// stdout is empty.
+func parseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	data := strings.TrimSpace(output.Stdout)
+	if data == "" && strings.HasPrefix(strings.TrimSpace(output.Stderr), "[") {
+		data = strings.TrimSpace(output.Stderr)
+	}
+
+	if data == "" || data == "[]" {
+		// Exit code 2 means lint problems; anything else non-zero is a Stylelint failure
+		if output.ExitCode != 0 && output.ExitCode != 2 {
+			return nil, fmt.Errorf("stylelint error: %s", strings.TrimSpace(output.Stderr))
+		}
+		return nil, nil
+	}
+
+	var results StylelintOutput
+	if err := json.Unmarshal([]byte(data), &results); err != nil {
+		return nil, fmt.Errorf("failed to parse Stylelint output: %w", err)
+	}
+
+	var violations []linter.Violation
+	for _, fileResult := range results {
+		for _, w := range fileResult.Warnings {
+			violations = append(violations, linter.Violation{
+				File:     fileResult.Source,
+				Line:     w.Line,
+				Column:   w.Column,
+				Message:  stripRuleSuffix(w.Text, w.Rule),
+				Severity: linter.MapSeverity(w.Severity),
+				RuleID:   w.Rule,
+			})
+		}
+	}
+
+	return violations, nil
+}
+
+// stripRuleSuffix removes the " (rule-name)" suffix Stylelint appends to messages.
+func stripRuleSuffix(text, rule string) string {
+	return strings.TrimSuffix(text, " ("+rule+")")
+}
@@ -0,0 +1,72 @@
+package stylelint
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+)
+
+const sampleOutput = `[
+	{
+		"source": "/repo/web/app.css",
+		"deprecations": [],
+		"invalidOptionWarnings": [],
+		"parseErrors": [],
+		"errored": true,
+		"warnings": [
+			{"line": 3, "column": 10, "endLine": 3, "endColumn": 14, "rule": "color-no-hex", "severity": "error", "text": "Unexpected hex color \"#fff\" (color-no-hex)"},
+			{"line": 7, "column": 1, "rule": "selector-class-pattern", "severity": "warning", "text": "Expected class selector \".fooBar\" to match pattern (selector-class-pattern)"}
+		]
+	},
+	{"source": "/repo/web/clean.scss", "errored": false, "warnings": []}
+]`
+
+func TestParseOutput(t *testing.T) {
+	violations, err := parseOutput(&linter.ToolOutput{Stdout: sampleOutput, ExitCode: 2})
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("parseOutput() returned %d violations, want 2", len(violations))
+	}
+
+	v := violations[0]
+	if v.File != "/repo/web/app.css" || v.Line != 3 || v.Column != 10 || v.RuleID != "color-no-hex" || v.Severity != "error" {
+		t.Errorf("violation[0] = %+v", v)
+	}
+	if v.Message != `Unexpected hex color "#fff"` {
+		t.Errorf("Message = %q, rule suffix should be stripped", v.Message)
+	}
+	if violations[1].Severity != "warning" {
+		t.Errorf("violation[1].Severity = %q, want warning", violations[1].Severity)
+	}
+}
+
+func TestParseOutput_Stderr(t *testing.T) {
+	// Stylelint 16 prints formatter output to stderr
+	violations, err := parseOutput(&linter.ToolOutput{Stderr: sampleOutput, ExitCode: 2})
+	if err != nil {
+		t.Fatalf("parseOutput() error = %v", err)
+	}
+	if len(violations) != 2 {
+		t.Errorf("parseOutput() returned %d violations, want 2", len(violations))
+	}
+}
+
+func TestParseOutput_Empty(t *testing.T) {
+	for _, stdout := range []string{"", "[]"} {
+		violations, err := parseOutput(&linter.ToolOutput{Stdout: stdout})
+		if err != nil || len(violations) != 0 {
+			t.Errorf("parseOutput(%q) = %v, %v; want no violations", stdout, violations, err)
+		}
+	}
+}
+
+func TestParseOutput_Errors(t *testing.T) {
+	if _, err := parseOutput(&linter.ToolOutput{Stderr: "Error: No configuration provided", ExitCode: 78}); err == nil {
+		t.Error("parseOutput() expected error for invalid config exit code")
+	}
+	if _, err := parseOutput(&linter.ToolOutput{Stdout: "not json", ExitCode: 2}); err == nil {
+		t.Error("parseOutput() expected error for invalid JSON")
+	}
+}
@@ -9,28 +9,61 @@ import (
 )
 
 // SubprocessExecutor runs external tools as subprocesses.
+//
+// An executor only holds defaults and is never modified by Execute or Run, so
+// a linter registered once can share its executor across concurrent
+// validations. Per-call settings are passed as ExecOptions.
 type SubprocessExecutor struct {
 	// Timeout is the max execution time.
 	// Default: 2 minutes
 	Timeout time.Duration
+}
+
+// ExecOption sets a per-call option of Run.
+type ExecOption func(*execConfig)
+
+// execConfig holds the per-call options of one Run.
+type execConfig struct {
+	workDir string
+	env     map[string]string
+}
 
-	// WorkDir is the working directory.
-	WorkDir string
+// WithWorkDir runs the command in dir instead of the current directory.
+func WithWorkDir(dir string) ExecOption {
+	return func(c *execConfig) {
+		c.workDir = dir
+	}
+}
 
-	// Env is additional environment variables.
-	Env map[string]string
+// WithEnv adds an environment variable to the command's environment.
+func WithEnv(key, value string) ExecOption {
+	return func(c *execConfig) {
+		if c.env == nil {
+			c.env = make(map[string]string)
+		}
+		c.env[key] = value
+	}
 }
 
 // NewSubprocessExecutor creates a new executor.
 func NewSubprocessExecutor() *SubprocessExecutor {
 	return &SubprocessExecutor{
 		Timeout: 2 * time.Minute,
-		Env:     make(map[string]string),
 	}
 }
 
-// Execute runs a command and returns its output.
+// Execute runs a command in the current directory and returns its output.
 func (e *SubprocessExecutor) Execute(ctx context.Context, name string, args ...string) (*ToolOutput, error) {
+	return e.Run(ctx, name, args)
+}
+
+// Run runs a command with per-call options and returns its output.
+func (e *SubprocessExecutor) Run(ctx context.Context, name string, args []string, opts ...ExecOption) (*ToolOutput, error) {
+	var config execConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	// Apply timeout
 	if e.Timeout > 0 {
 		var cancel context.CancelFunc
@@ -41,13 +74,13 @@ func (e *SubprocessExecutor) Execute(ctx context.Context, name string, args ...s
 	// Create command
 	cmd := exec.CommandContext(ctx, name, args...)
 
-	if e.WorkDir != "" {
-		cmd.Dir = e.WorkDir
+	if config.workDir != "" {
+		cmd.Dir = config.workDir
 	}
 
 	// Add environment variables
-	if len(e.Env) > 0 {
-		cmd.Env = append(os.Environ(), e.envSlice()...)
+	if len(config.env) > 0 {
+		cmd.Env = append(os.Environ(), envSlice(config.env)...)
 	}
 
 	// Capture output
@@ -74,9 +107,9 @@ func (e *SubprocessExecutor) Execute(ctx context.Context, name string, args ...s
 	return output, nil
 }
 
-func (e *SubprocessExecutor) envSlice() []string {
-	result := make([]string, 0, len(e.Env))
-	for k, v := range e.Env {
+func envSlice(env map[string]string) []string {
+	result := make([]string, 0, len(env))
+	for k, v := range env {
 		result = append(result, fmt.Sprintf("%s=%s", k, v))
 	}
 	return result
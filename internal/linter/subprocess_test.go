@@ -2,8 +2,12 @@ package linter
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -17,10 +21,6 @@ func TestNewSubprocessExecutor(t *testing.T) {
 	if executor.Timeout != 2*time.Minute {
 		t.Errorf("Default timeout = %v, want 2m", executor.Timeout)
 	}
-
-	if executor.Env == nil {
-		t.Error("Env map should be initialized")
-	}
 }
 
 func TestExecute_Success(t *testing.T) {
@@ -41,18 +41,17 @@ func TestExecute_Success(t *testing.T) {
 	}
 }
 
-func TestExecute_WithWorkDir(t *testing.T) {
+func TestRun_WithWorkDir(t *testing.T) {
 	executor := NewSubprocessExecutor()
-	executor.WorkDir = os.TempDir()
 	ctx := context.Background()
 
 	var output *ToolOutput
 	var err error
 
 	if runtime.GOOS == "windows" {
-		output, err = executor.Execute(ctx, "cmd", "/c", "cd")
+		output, err = executor.Run(ctx, "cmd", []string{"/c", "cd"}, WithWorkDir(os.TempDir()))
 	} else {
-		output, err = executor.Execute(ctx, "pwd")
+		output, err = executor.Run(ctx, "pwd", nil, WithWorkDir(os.TempDir()))
 	}
 
 	if err != nil {
@@ -64,29 +63,64 @@ func TestExecute_WithWorkDir(t *testing.T) {
 	}
 }
 
-func TestExecute_WithEnv(t *testing.T) {
+func TestRun_WithEnv(t *testing.T) {
 	executor := NewSubprocessExecutor()
-	executor.Env = map[string]string{
-		"TEST_VAR": "test_value",
-	}
 	ctx := context.Background()
 
 	var output *ToolOutput
 	var err error
 
 	if runtime.GOOS == "windows" {
-		output, err = executor.Execute(ctx, "cmd", "/c", "echo %TEST_VAR%")
+		output, err = executor.Run(ctx, "cmd", []string{"/c", "echo %TEST_VAR%"}, WithEnv("TEST_VAR", "test_value"))
 	} else {
-		output, err = executor.Execute(ctx, "sh", "-c", "echo $TEST_VAR")
+		output, err = executor.Run(ctx, "sh", []string{"-c", "echo $TEST_VAR"}, WithEnv("TEST_VAR", "test_value"))
 	}
 
 	if err != nil {
-		t.Fatalf("Execute failed: %v", err)
+		t.Fatalf("Run failed: %v", err)
 	}
 
 	if output.ExitCode != 0 {
 		t.Errorf("ExitCode = %d, want 0", output.ExitCode)
 	}
+
+	if got := strings.TrimSpace(output.Stdout); got != "test_value" {
+		t.Errorf("TEST_VAR = %q, want test_value", got)
+	}
+}
+
+func TestRun_ConcurrentOptions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	// One shared executor, different options per call: no call may observe
+	// another call's work dir or environment.
+	executor := NewSubprocessExecutor()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		dir := t.TempDir()
+		value := fmt.Sprintf("value%d", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			output, err := executor.Run(ctx, "sh", []string{"-c", "echo $TEST_VAR; pwd"}, WithWorkDir(dir), WithEnv("TEST_VAR", value))
+			if err != nil {
+				t.Errorf("Run failed: %v", err)
+				return
+			}
+			want := value + "\n" + dir
+			if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+				want = value + "\n" + resolved
+			}
+			if got := strings.TrimSpace(output.Stdout); got != want {
+				t.Errorf("output = %q, want %q", got, want)
+			}
+		}()
+	}
+	wg.Wait()
 }
 
 func TestExecute_NonZeroExit(t *testing.T) {
@@ -132,14 +166,10 @@ func TestExecute_Timeout(t *testing.T) {
 }
 
 func TestEnvSlice(t *testing.T) {
-	executor := &SubprocessExecutor{
-		Env: map[string]string{
-			"KEY1": "value1",
-			"KEY2": "value2",
-		},
-	}
-
-	slice := executor.envSlice()
+	slice := envSlice(map[string]string{
+		"KEY1": "value1",
+		"KEY2": "value2",
+	})
 	if len(slice) != 2 {
 		t.Errorf("envSlice() length = %d, want 2", len(slice))
 	}
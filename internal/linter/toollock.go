@@ -0,0 +1,315 @@
+package linter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ToolLockFile is the lockfile name in the .sym directory.
+const ToolLockFile = "tools.lock"
+
+// ToolsSourceEnv names the environment variable that sets InstallConfig.Source
+// for validation runs (e.g., a mirror directory on air-gapped build agents).
+const ToolsSourceEnv = "SYM_TOOLS_SOURCE"
+
+// ToolLock pins tool versions and the SHA-256 digests of their downloaded
+// artifacts. It is stored as .sym/tools.lock and committed with the policy.
+type ToolLock struct {
+	Version int                    `json:"version"`
+	Tools   map[string]*LockedTool `json:"tools"`
+}
+
+// LockedTool is the pinned version of one tool.
+type LockedTool struct {
+	Version string `json:"version"`
+
+	// Artifacts maps artifact file names to hex SHA-256 digests.
+	// Tools installed by package managers (npm, pip, gem) pin only the version.
+	Artifacts map[string]string `json:"artifacts,omitempty"`
+}
+
+// Artifact is a downloadable tool distribution (archive, jar or binary).
+type Artifact struct {
+	Tool    string // linter name (e.g., "pmd")
+	Version string // tool version
+	Name    string // file name, also the name in mirrors and tools.lock
+	URL     string // upstream download URL
+}
+
+// ArtifactProvider is implemented by linters installed from downloaded
+// artifacts. It lets `sym tools vendor` populate mirrors for any platform.
+type ArtifactProvider interface {
+	// Artifact returns the distribution of version (empty = default) for goos/goarch.
+	Artifact(version, goos, goarch string) (Artifact, error)
+}
+
+// NewToolLock creates an empty lock.
+func NewToolLock() *ToolLock {
+	return &ToolLock{Version: 1, Tools: make(map[string]*LockedTool)}
+}
+
+// LoadToolLock reads a lockfile. Returns nil without error if it does not exist.
+func LoadToolLock(path string) (*ToolLock, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	lock := NewToolLock()
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
+	}
+	if lock.Tools == nil {
+		lock.Tools = make(map[string]*LockedTool)
+	}
+	return lock, nil
+}
+
+// Save writes the lockfile.
+func (l *ToolLock) Save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := EnsureDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Get returns the pinned entry of a tool, or nil. Safe on a nil lock.
+func (l *ToolLock) Get(tool string) *LockedTool {
+	if l == nil {
+		return nil
+	}
+	return l.Tools[tool]
+}
+
+// Pin records a tool version and, if name is set, an artifact digest.
+// Pinning a different version drops the digests of the old one.
+func (l *ToolLock) Pin(tool, version, name, digest string) {
+	entry := l.Tools[tool]
+	if entry == nil || entry.Version != version {
+		entry = &LockedTool{Version: version}
+		l.Tools[tool] = entry
+	}
+	if name == "" {
+		return
+	}
+	if entry.Artifacts == nil {
+		entry.Artifacts = make(map[string]string)
+	}
+	entry.Artifacts[name] = digest
+}
+
+// ToolVersion returns the version to install: the explicit Version, then the
+// version pinned in Lock, then def.
+func (c InstallConfig) ToolVersion(tool, def string) string {
+	if c.Version != "" {
+		return c.Version
+	}
+	if locked := c.Lock.Get(tool); locked != nil && locked.Version != "" {
+		return locked.Version
+	}
+	return def
+}
+
+// NewInstallConfig returns the install settings of a project: the default
+// tools dir, symDir/tools.lock and the SYM_TOOLS_SOURCE mirror.
+func NewInstallConfig(symDir string) (InstallConfig, error) {
+	lock, err := LoadToolLock(filepath.Join(symDir, ToolLockFile))
+	if err != nil {
+		return InstallConfig{}, err
+	}
+	return InstallConfig{
+		ToolsDir: DefaultToolsDir(),
+		Source:   os.Getenv(ToolsSourceEnv),
+		Lock:     lock,
+	}, nil
+}
+
+type toolLockKey struct{}
+
+// WithToolLock returns a context carrying the tool lock of the package being
+// validated (InstallConfig.Lock), so linters locate the same versioned
+// installation that Install placed. A nil lock means nothing is pinned.
+func WithToolLock(ctx context.Context, lock *ToolLock) context.Context {
+	return context.WithValue(ctx, toolLockKey{}, lock)
+}
+
+// LockedVersion returns the version of tool pinned in the context's tool lock
+// (see WithToolLock), or def if none is pinned. Without a lock in the context,
+// the .sym/tools.lock found from the working directory is used. Linters use it
+// to locate the installation that Install placed under a versioned directory.
+func LockedVersion(ctx context.Context, tool, def string) string {
+	if lock, ok := ctx.Value(toolLockKey{}).(*ToolLock); ok {
+		return InstallConfig{Lock: lock}.ToolVersion(tool, def)
+	}
+
+	path := FindProjectFile(filepath.Join(".sym", ToolLockFile))
+	if path == "" {
+		return def
+	}
+	lock, err := LoadToolLock(path)
+	if err != nil {
+		return def
+	}
+	return InstallConfig{Lock: lock}.ToolVersion(tool, def)
+}
+
+// MirrorPath returns the location of an artifact in a mirror: <tool>/<version>/<name>.
+func MirrorPath(mirror string, art Artifact) string {
+	if isURL(mirror) {
+		return strings.TrimSuffix(mirror, "/") + "/" + art.Tool + "/" + art.Version + "/" + art.Name
+	}
+	return filepath.Join(mirror, art.Tool, art.Version, art.Name)
+}
+
+// FetchArtifact places an artifact at dest and verifies it against cfg.Lock.
+//
+// The artifact comes from cfg.Source when set: a mirror directory or URL
+// (laid out by MirrorPath), or an archive file used as is. Without a source
+// it is downloaded from the upstream URL. If the lock pins the tool, the
+// version must match and the artifact's SHA-256 digest must be recorded and
+// equal; dest is removed on mismatch.
+func FetchArtifact(ctx context.Context, cfg InstallConfig, art Artifact, dest string) error {
+	if err := checkLocked(cfg.Lock, art); err != nil {
+		return err
+	}
+
+	if err := EnsureDir(filepath.Dir(dest)); err != nil {
+		return err
+	}
+
+	switch source := cfg.Source; {
+	case source == "":
+		if err := DownloadFile(ctx, art.URL, dest); err != nil {
+			return err
+		}
+	case isURL(source):
+		if err := DownloadFile(ctx, MirrorPath(source, art), dest); err != nil {
+			return fmt.Errorf("mirror: %w", err)
+		}
+	default:
+		info, err := os.Stat(source)
+		if err != nil {
+			return fmt.Errorf("tools source %s: %w", source, err)
+		}
+		path := source
+		if info.IsDir() {
+			path = MirrorPath(source, art)
+		}
+		if err := copyFile(path, dest); err != nil {
+			return fmt.Errorf("%s not found in tools source: %w", art.Name, err)
+		}
+	}
+
+	if locked := cfg.Lock.Get(art.Tool); locked != nil {
+		digest, err := FileSHA256(dest)
+		if err != nil {
+			return err
+		}
+		if digest != locked.Artifacts[art.Name] {
+			_ = os.Remove(dest)
+			return fmt.Errorf("checksum mismatch for %s: got sha256 %s, %s pins %s", art.Name, digest, ToolLockFile, locked.Artifacts[art.Name])
+		}
+	}
+
+	return nil
+}
+
+// checkLocked verifies that a locked tool pins the artifact's version and digest.
+func checkLocked(lock *ToolLock, art Artifact) error {
+	locked := lock.Get(art.Tool)
+	if locked == nil {
+		return nil
+	}
+	if locked.Version != art.Version {
+		return fmt.Errorf("%s %s is not the version pinned in %s (%s)", art.Tool, art.Version, ToolLockFile, locked.Version)
+	}
+	if locked.Artifacts[art.Name] == "" {
+		return fmt.Errorf("%s has no sha256 digest in %s: run 'sym tools vendor' to record it", art.Name, ToolLockFile)
+	}
+	return nil
+}
+
+// DownloadFile downloads url to destPath via a temporary file.
+func DownloadFile(ctx context.Context, url, destPath string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed: HTTP %d for URL %s", resp.StatusCode, url)
+	}
+
+	return writeFileAtomic(destPath, resp.Body)
+}
+
+// FileSHA256 returns the hex SHA-256 digest of a file.
+func FileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// copyFile copies src to dest via a temporary file.
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	return writeFileAtomic(dest, in)
+}
+
+// writeFileAtomic writes r to path.tmp and renames it into place.
+func writeFileAtomic(path string, r io.Reader) error {
+	tempFile := path + ".tmp"
+	out, err := os.Create(tempFile)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tempFile)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tempFile)
+		return err
+	}
+
+	return os.Rename(tempFile, path)
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
@@ -0,0 +1,204 @@
+package linter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testArtifact = Artifact{
+	Tool:    "pmd",
+	Version: "7.0.0",
+	Name:    "pmd-dist-7.0.0-bin.zip",
+	URL:     "http://127.0.0.1:1/unreachable",
+}
+
+func digestOf(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+// newMirror writes content at the mirror location of testArtifact.
+func newMirror(t *testing.T, content string) string {
+	t.Helper()
+	mirror := t.TempDir()
+	path := MirrorPath(mirror, testArtifact)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return mirror
+}
+
+func lockedLock(digest string) *ToolLock {
+	lock := NewToolLock()
+	lock.Pin(testArtifact.Tool, testArtifact.Version, testArtifact.Name, digest)
+	return lock
+}
+
+func TestToolLock_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".sym", ToolLockFile)
+
+	lock, err := LoadToolLock(path)
+	if err != nil || lock != nil {
+		t.Fatalf("missing lock: got %v, %v; want nil, nil", lock, err)
+	}
+
+	lock = lockedLock("abc")
+	lock.Pin("eslint", "8.57.0", "", "")
+	if err := lock.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadToolLock(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.Get("pmd").Artifacts[testArtifact.Name]; got != "abc" {
+		t.Errorf("pmd digest = %q, want abc", got)
+	}
+	if got := loaded.Get("eslint"); got == nil || got.Version != "8.57.0" || len(got.Artifacts) != 0 {
+		t.Errorf("eslint entry = %+v", got)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadToolLock(path); err == nil {
+		t.Error("expected error for invalid lockfile")
+	}
+}
+
+func TestToolLock_PinNewVersionDropsDigests(t *testing.T) {
+	lock := lockedLock("abc")
+	lock.Pin("pmd", "7.1.0", "pmd-dist-7.1.0-bin.zip", "def")
+
+	entry := lock.Get("pmd")
+	if entry.Version != "7.1.0" {
+		t.Errorf("version = %q, want 7.1.0", entry.Version)
+	}
+	if len(entry.Artifacts) != 1 || entry.Artifacts["pmd-dist-7.1.0-bin.zip"] != "def" {
+		t.Errorf("artifacts = %v", entry.Artifacts)
+	}
+}
+
+func TestInstallConfig_ToolVersion(t *testing.T) {
+	lock := lockedLock("abc")
+
+	tests := []struct {
+		name   string
+		config InstallConfig
+		want   string
+	}{
+		{"default", InstallConfig{}, "6.0.0"},
+		{"locked", InstallConfig{Lock: lock}, "7.0.0"},
+		{"explicit", InstallConfig{Version: "7.2.0", Lock: lock}, "7.2.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.ToolVersion("pmd", "6.0.0"); got != tt.want {
+				t.Errorf("ToolVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockedVersion_ContextLock(t *testing.T) {
+	// A package-level lock in the context wins over the working directory's lock
+	ctx := WithToolLock(context.Background(), lockedLock("abc"))
+	if got := LockedVersion(ctx, "pmd", "6.0.0"); got != "7.0.0" {
+		t.Errorf("LockedVersion() = %q, want 7.0.0", got)
+	}
+
+	// A package without a lock uses the default version
+	ctx = WithToolLock(context.Background(), nil)
+	if got := LockedVersion(ctx, "pmd", "6.0.0"); got != "6.0.0" {
+		t.Errorf("LockedVersion() without lock = %q, want 6.0.0", got)
+	}
+}
+
+func TestFetchArtifact_MirrorDir(t *testing.T) {
+	mirror := newMirror(t, "zip")
+	dest := filepath.Join(t.TempDir(), "tools", testArtifact.Name)
+
+	cfg := InstallConfig{Source: mirror, Lock: lockedLock(digestOf("zip"))}
+	if err := FetchArtifact(context.Background(), cfg, testArtifact, dest); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(dest); string(data) != "zip" {
+		t.Errorf("dest content = %q", data)
+	}
+}
+
+func TestFetchArtifact_ArchiveFile(t *testing.T) {
+	archive := filepath.Join(t.TempDir(), "pmd.zip")
+	if err := os.WriteFile(archive, []byte("zip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dest := filepath.Join(t.TempDir(), testArtifact.Name)
+
+	// Without a lock the artifact is not verified
+	if err := FetchArtifact(context.Background(), InstallConfig{Source: archive}, testArtifact, dest); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dest); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFetchArtifact_MirrorURL(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		_, _ = w.Write([]byte("zip"))
+	}))
+	defer server.Close()
+
+	dest := filepath.Join(t.TempDir(), testArtifact.Name)
+	cfg := InstallConfig{Source: server.URL + "/", Lock: lockedLock(digestOf("zip"))}
+	if err := FetchArtifact(context.Background(), cfg, testArtifact, dest); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/pmd/7.0.0/" + testArtifact.Name; requested != want {
+		t.Errorf("requested %q, want %q", requested, want)
+	}
+}
+
+func TestFetchArtifact_ChecksumMismatch(t *testing.T) {
+	mirror := newMirror(t, "tampered")
+	dest := filepath.Join(t.TempDir(), testArtifact.Name)
+
+	cfg := InstallConfig{Source: mirror, Lock: lockedLock(digestOf("zip"))}
+	err := FetchArtifact(context.Background(), cfg, testArtifact, dest)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Error("dest should be removed on mismatch")
+	}
+}
+
+func TestFetchArtifact_LockViolations(t *testing.T) {
+	mirror := newMirror(t, "zip")
+	dest := filepath.Join(t.TempDir(), testArtifact.Name)
+
+	unpinned := NewToolLock()
+	unpinned.Pin("pmd", "7.0.0", "", "")
+	if err := FetchArtifact(context.Background(), InstallConfig{Source: mirror, Lock: unpinned}, testArtifact, dest); err == nil {
+		t.Error("expected error for missing digest")
+	}
+
+	other := NewToolLock()
+	other.Pin("pmd", "6.55.0", "pmd-dist-6.55.0-bin.zip", "abc")
+	if err := FetchArtifact(context.Background(), InstallConfig{Source: mirror, Lock: other}, testArtifact, dest); err == nil {
+		t.Error("expected error for unpinned version")
+	}
+}
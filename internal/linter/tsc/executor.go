@@ -33,6 +33,17 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 		tsconfig["files"] = absFiles
 	}
 
+	// In merge mode, extend the project's tsconfig so paths, baseUrl and
+	// other compiler options keep working. Only the listed files are checked.
+	if linter.ProjectConfigMerge(ctx) {
+		if _, ok := tsconfig["extends"]; !ok {
+			if projectConfig := linter.FindProjectFile("tsconfig.json"); projectConfig != "" {
+				tsconfig["extends"] = projectConfig
+				tsconfig["include"] = []string{}
+			}
+		}
+	}
+
 	// Marshal updated config
 	updatedConfig, err := json.MarshalIndent(tsconfig, "", "  ")
 	if err != nil {
@@ -60,10 +71,9 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 	defer func() { _ = os.Remove(configPath) }()
 
 	// Determine tsc binary path
-	tscPath := l.getTSCPath()
-	if _, err := os.Stat(tscPath); os.IsNotExist(err) {
-		// Try global tsc
-		tscPath = "tsc"
+	tscPath := "tsc"
+	if tool := l.resolveTSC(); tool.Found() {
+		tscPath = tool.Path
 	}
 
 	// Build tsc command
@@ -75,8 +85,6 @@ func (l *Linter) execute(ctx context.Context, config []byte, files []string) (*l
 	}
 
 	// Execute tsc
-	// Reset WorkDir to use CWD (Install() may have set it to ToolsDir)
-	l.executor.WorkDir = ""
 	output, err := l.executor.Execute(ctx, tscPath, args...)
 
 	// TSC returns non-zero exit code when there are type errors
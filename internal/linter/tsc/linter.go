@@ -11,8 +11,12 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/linter"
 )
 
-// Compile-time interface check
-var _ linter.Linter = (*Linter)(nil)
+// Compile-time interface checks
+var (
+	_ linter.Linter      = (*Linter)(nil)
+	_ linter.Locator     = (*Linter)(nil)
+	_ linter.Uninstaller = (*Linter)(nil)
+)
 
 // Linter wraps TypeScript Compiler (tsc) for type checking.
 //
@@ -57,24 +61,17 @@ func (l *Linter) GetCapabilities() linter.Capabilities {
 		SupportedLanguages:  []string{"typescript"},
 		SupportedCategories: []string{"typechecker"},
 		Version:             "^5.0.0",
+		FileInput:           linter.FileInputList, // files are listed in the generated tsconfig
 	}
 }
 
 // CheckAvailability checks if tsc is installed.
 func (l *Linter) CheckAvailability(ctx context.Context) error {
-	// Try local installation first
-	tscPath := l.getTSCPath()
-	if _, err := os.Stat(tscPath); err == nil {
-		return nil // Found in tools dir
-	}
-
-	// Try global installation
-	cmd := exec.CommandContext(ctx, "tsc", "--version")
-	if err := cmd.Run(); err == nil {
-		return nil // Found globally
+	if l.resolveTSC().Found() {
+		return nil
 	}
 
-	return fmt.Errorf("tsc not found (checked: %s and global PATH)", tscPath)
+	return fmt.Errorf("tsc not found (checked: project node_modules, global PATH and %s)", l.getTSCPath())
 }
 
 // Install installs TypeScript via npm.
@@ -90,10 +87,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Determine version
-	version := config.Version
-	if version == "" {
-		version = "^5.0.0" // Default to TypeScript 5.x
-	}
+	version := config.ToolVersion(l.Name(), "^5.0.0") // Default to TypeScript 5.x
 
 	// Initialize package.json if needed
 	packageJSON := filepath.Join(l.ToolsDir, "package.json")
@@ -104,8 +98,7 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	}
 
 	// Install TypeScript
-	l.executor.WorkDir = l.ToolsDir
-	_, err := l.executor.Execute(ctx, "npm", "install", fmt.Sprintf("typescript@%s", version))
+	_, err := l.executor.Run(ctx, "npm", []string{"install", fmt.Sprintf("typescript@%s", version)}, linter.WithWorkDir(l.ToolsDir))
 	if err != nil {
 		return fmt.Errorf("npm install failed: %w", err)
 	}
@@ -113,6 +106,16 @@ func (l *Linter) Install(ctx context.Context, config linter.InstallConfig) error
 	return nil
 }
 
+// Locate reports the TypeScript installation CheckAvailability uses.
+func (l *Linter) Locate(ctx context.Context) (linter.Installation, error) {
+	return linter.LocateTool(ctx, l.resolveTSC(), "--version")
+}
+
+// Uninstall removes TypeScript from the tools directory.
+func (l *Linter) Uninstall(ctx context.Context) error {
+	return linter.NpmUninstall(ctx, l.ToolsDir, "typescript")
+}
+
 // Execute runs tsc with the given config and files.
 // Returns type checking results.
 func (l *Linter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
@@ -124,6 +127,16 @@ func (l *Linter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, err
 	return parseOutput(output)
 }
 
+// resolveTSC locates tsc: project node_modules, PATH, then tools dir.
+// The project's TypeScript version matters for type checking, so it is preferred.
+func (l *Linter) resolveTSC() linter.ToolCommand {
+	return linter.ResolveTool(linter.ToolLookup{
+		Name:         "tsc",
+		Node:         true,
+		ManagedPaths: []string{l.getTSCPath()},
+	})
+}
+
 // getTSCPath returns the path to local tsc binary.
 func (l *Linter) getTSCPath() string {
 	return filepath.Join(l.ToolsDir, "node_modules", ".bin", "tsc")
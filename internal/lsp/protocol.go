@@ -0,0 +1,242 @@
+package lsp
+
+import "encoding/json"
+
+// This file defines the subset of the Language Server Protocol 3.17 used by the server.
+// Field names follow the specification so messages round-trip through encoding/json.
+
+// message is a JSON-RPC 2.0 request, response or notification.
+// Requests have ID and Method, notifications only Method, responses only ID.
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+// responseError is a JSON-RPC error object.
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// JSON-RPC and LSP error codes.
+const (
+	codeMethodNotFound       = -32601
+	codeInvalidParams        = -32602
+	codeInternalError        = -32603
+	codeServerNotInitialized = -32002
+)
+
+// Diagnostic severities.
+const (
+	SeverityError       = 1
+	SeverityWarning     = 2
+	SeverityInformation = 3
+	SeverityHint        = 4
+)
+
+// Commands handled by workspace/executeCommand.
+const (
+	// CommandRunLLMRules runs llm-validator rules on a document. Arguments: [uri]
+	CommandRunLLMRules = "sym.runLLMRules"
+	// CommandApplyRemedy applies a rule's autofix remedy to a document. Arguments: [uri, ruleID]
+	CommandApplyRemedy = "sym.applyRemedy"
+)
+
+// Position is a zero-based line and UTF-16 character offset.
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range is a half-open range in a document.
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// Diagnostic is a single problem reported for a document.
+type Diagnostic struct {
+	Range    Range          `json:"range"`
+	Severity int            `json:"severity,omitempty"`
+	Code     string         `json:"code,omitempty"`
+	Source   string         `json:"source,omitempty"`
+	Message  string         `json:"message"`
+	Data     *DiagnosticRef `json:"data,omitempty"`
+}
+
+// DiagnosticRef is attached to diagnostics so code actions can find the originating rule.
+type DiagnosticRef struct {
+	RuleID string `json:"ruleId"`
+	Tool   string `json:"tool,omitempty"`
+}
+
+// TextDocumentIdentifier identifies a document by URI.
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// TextDocumentItem is an opened document.
+type TextDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+// InitializeParams are the parameters of the initialize request.
+type InitializeParams struct {
+	ProcessID int    `json:"processId,omitempty"`
+	RootURI   string `json:"rootUri,omitempty"`
+}
+
+// InitializeResult is the result of the initialize request.
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+	ServerInfo   ServerInfo         `json:"serverInfo"`
+}
+
+// ServerInfo describes the server.
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// ServerCapabilities lists the features the server supports.
+type ServerCapabilities struct {
+	TextDocumentSync       TextDocumentSyncOptions `json:"textDocumentSync"`
+	CodeActionProvider     CodeActionOptions       `json:"codeActionProvider"`
+	ExecuteCommandProvider ExecuteCommandOptions   `json:"executeCommandProvider"`
+}
+
+// TextDocumentSyncOptions declares which document notifications the server wants.
+// Change is TextDocumentSyncFull: the server keeps the text of open documents
+// for remedies, while linters still validate files on disk after save.
+type TextDocumentSyncOptions struct {
+	OpenClose bool        `json:"openClose"`
+	Change    int         `json:"change"`
+	Save      SaveOptions `json:"save"`
+}
+
+// TextDocumentSyncFull makes clients send the whole text on every change.
+const TextDocumentSyncFull = 1
+
+// SaveOptions configures didSave notifications.
+type SaveOptions struct {
+	IncludeText bool `json:"includeText"`
+}
+
+// CodeActionOptions lists the code action kinds the server returns.
+type CodeActionOptions struct {
+	CodeActionKinds []string `json:"codeActionKinds"`
+}
+
+// ExecuteCommandOptions lists the commands the server executes.
+type ExecuteCommandOptions struct {
+	Commands []string `json:"commands"`
+}
+
+// DidOpenTextDocumentParams are the parameters of textDocument/didOpen.
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// VersionedTextDocumentIdentifier identifies a specific version of a document.
+type VersionedTextDocumentIdentifier struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version"`
+}
+
+// TextDocumentContentChangeEvent is a document change. With full sync, Text
+// is the whole new content.
+type TextDocumentContentChangeEvent struct {
+	Text string `json:"text"`
+}
+
+// DidChangeTextDocumentParams are the parameters of textDocument/didChange.
+type DidChangeTextDocumentParams struct {
+	TextDocument   VersionedTextDocumentIdentifier  `json:"textDocument"`
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// DidSaveTextDocumentParams are the parameters of textDocument/didSave.
+type DidSaveTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// DidCloseTextDocumentParams are the parameters of textDocument/didClose.
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// PublishDiagnosticsParams are the parameters of textDocument/publishDiagnostics.
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// CodeActionParams are the parameters of textDocument/codeAction.
+type CodeActionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Range        Range                  `json:"range"`
+	Context      CodeActionContext      `json:"context"`
+}
+
+// CodeActionContext carries the diagnostics overlapping the requested range.
+type CodeActionContext struct {
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// CodeAction is a command offered to the user for a range.
+type CodeAction struct {
+	Title       string       `json:"title"`
+	Kind        string       `json:"kind"`
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+	Command     *Command     `json:"command,omitempty"`
+}
+
+// Command references a command executed through workspace/executeCommand.
+type Command struct {
+	Title     string        `json:"title"`
+	Command   string        `json:"command"`
+	Arguments []interface{} `json:"arguments,omitempty"`
+}
+
+// ExecuteCommandParams are the parameters of workspace/executeCommand.
+type ExecuteCommandParams struct {
+	Command   string            `json:"command"`
+	Arguments []json.RawMessage `json:"arguments"`
+}
+
+// TextEdit replaces a range of a document.
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"newText"`
+}
+
+// WorkspaceEdit groups text edits by document URI.
+type WorkspaceEdit struct {
+	Changes map[string][]TextEdit `json:"changes"`
+}
+
+// ApplyWorkspaceEditParams are the parameters of the workspace/applyEdit request.
+type ApplyWorkspaceEditParams struct {
+	Label string        `json:"label,omitempty"`
+	Edit  WorkspaceEdit `json:"edit"`
+}
+
+// ShowMessageParams are the parameters of window/showMessage.
+type ShowMessageParams struct {
+	Type    int    `json:"type"`
+	Message string `json:"message"`
+}
+
+// Message types for window/showMessage.
+const (
+	MessageTypeError   = 1
+	MessageTypeWarning = 2
+	MessageTypeInfo    = 3
+)
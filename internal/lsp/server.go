@@ -0,0 +1,843 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode/utf16"
+
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/policy"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/internal/validator"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// diagnosticSource is the source shown next to every published diagnostic
+const diagnosticSource = "sym"
+
+// document holds the text and last validation results of an open document.
+// Linter and LLM results are kept apart because they run on different triggers.
+type document struct {
+	text   string
+	linter []validator.Violation
+	llm    []validator.Violation
+}
+
+// Server is a Language Server Protocol server over stdio.
+//
+// Linter-engine rules run when a document is opened or saved and are published
+// as diagnostics. llm-validator rules run on demand through the
+// "sym.runLLMRules" command (offered as a source code action), and rules with
+// an autofix remedy get a quick fix backed by "sym.applyRemedy".
+//
+// Each document is validated with the code policy of its nearest .sym package,
+// like `sym validate`. Only open documents get diagnostics.
+type Server struct {
+	rootDir     string
+	policyPath  string // Overrides the root package's code policy when set
+	llmProvider llm.Provider
+	logger      io.Writer
+
+	mu          sync.Mutex
+	workspace   *workspaceRef
+	documents   map[string]*document
+	initialized bool
+
+	out     io.Writer
+	writeMu sync.Mutex
+	nextID  int
+
+	wg sync.WaitGroup
+}
+
+// workspaceRef is a loaded workspace and the work using it. A workspace
+// replaced by a policy reload is closed only after that work finishes.
+type workspaceRef struct {
+	*validator.Workspace
+	users  sync.WaitGroup
+	closed chan struct{} // Closed once the workspace is closed
+}
+
+// release ends one use of the workspace started by acquireWorkspace.
+func (w *workspaceRef) release() {
+	w.users.Done()
+}
+
+// close closes the workspace once every use has been released.
+func (w *workspaceRef) close() {
+	w.users.Wait()
+	_ = w.Workspace.Close()
+	close(w.closed)
+}
+
+// NewServer creates a server for the project at rootDir. Code policies are loaded
+// from every .sym package; a non-empty policyPath replaces the root package's policy.
+// Linters run in the current working directory, so callers should chdir to rootDir.
+func NewServer(rootDir, policyPath string) (*Server, error) {
+	rootDir, err := filepath.Abs(rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project root: %w", err)
+	}
+
+	s := &Server{
+		rootDir:    rootDir,
+		policyPath: policyPath,
+		logger:     os.Stderr,
+		documents:  make(map[string]*document),
+	}
+	if err := s.loadPolicy(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetLLMProvider enables on-demand llm-validator rules.
+func (s *Server) SetLLMProvider(provider llm.Provider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.llmProvider = provider
+	if s.workspace != nil {
+		s.workspace.SetLLMProvider(provider)
+	}
+}
+
+// SetLogger sets where server logs are written (default: stderr).
+// Logs must never go to stdout, which carries the protocol.
+func (s *Server) SetLogger(w io.Writer) {
+	s.logger = w
+}
+
+// Serve reads messages from in and writes responses and notifications to out
+// until the client sends "exit", in is closed, or ctx is cancelled.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	s.out = out
+	reader := bufio.NewReader(in)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		s.wg.Wait()
+		s.mu.Lock()
+		ws := s.workspace
+		s.mu.Unlock()
+		ws.close()
+	}()
+
+	for {
+		msg, err := readMessage(reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return err
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+		if msg.Method == "" {
+			// Response to a server-initiated request (workspace/applyEdit)
+			continue
+		}
+
+		if msg.ID != nil {
+			s.handleRequest(ctx, msg)
+		} else {
+			s.handleNotification(ctx, msg)
+		}
+	}
+}
+
+// handleRequest dispatches a request and sends its response.
+// Long-running requests reply from their own goroutine.
+func (s *Server) handleRequest(ctx context.Context, msg *message) {
+	s.mu.Lock()
+	initialized := s.initialized
+	s.mu.Unlock()
+
+	if !initialized && msg.Method != "initialize" {
+		s.replyError(msg.ID, codeServerNotInitialized, "server not initialized")
+		return
+	}
+
+	switch msg.Method {
+	case "initialize":
+		s.mu.Lock()
+		s.initialized = true
+		s.mu.Unlock()
+		s.reply(msg.ID, s.initializeResult())
+
+	case "shutdown":
+		s.wg.Wait()
+		s.reply(msg.ID, nil)
+
+	case "textDocument/codeAction":
+		var params CodeActionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			s.replyError(msg.ID, codeInvalidParams, err.Error())
+			return
+		}
+		s.reply(msg.ID, s.codeActions(params))
+
+	case "workspace/executeCommand":
+		var params ExecuteCommandParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			s.replyError(msg.ID, codeInvalidParams, err.Error())
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			if err := s.executeCommand(ctx, params); err != nil {
+				s.replyError(msg.ID, codeInternalError, err.Error())
+				return
+			}
+			s.reply(msg.ID, nil)
+		}()
+
+	default:
+		s.replyError(msg.ID, codeMethodNotFound, fmt.Sprintf("method not supported: %s", msg.Method))
+	}
+}
+
+// handleNotification handles a notification. Validation runs in the background.
+func (s *Server) handleNotification(ctx context.Context, msg *message) {
+	switch msg.Method {
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			s.logf("invalid didOpen params: %v", err)
+			return
+		}
+		s.mu.Lock()
+		s.documents[params.TextDocument.URI] = &document{text: params.TextDocument.Text}
+		s.mu.Unlock()
+		s.runInBackground(func() { s.validateLinters(ctx, params.TextDocument.URI) })
+
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			s.logf("invalid didChange params: %v", err)
+			return
+		}
+		if len(params.ContentChanges) == 0 {
+			return
+		}
+		s.mu.Lock()
+		if doc, ok := s.documents[params.TextDocument.URI]; ok {
+			doc.text = params.ContentChanges[len(params.ContentChanges)-1].Text
+		}
+		s.mu.Unlock()
+
+	case "textDocument/didSave":
+		var params DidSaveTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			s.logf("invalid didSave params: %v", err)
+			return
+		}
+		uri := params.TextDocument.URI
+		if s.isPolicyFile(uri) {
+			if err := s.loadPolicy(); err != nil {
+				s.showMessage(MessageTypeError, fmt.Sprintf("Failed to reload code policy: %v", err))
+			}
+			return
+		}
+		s.runInBackground(func() { s.validateLinters(ctx, uri) })
+
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			s.logf("invalid didClose params: %v", err)
+			return
+		}
+		// Cleared under the lock so no in-flight validation can publish after it
+		s.mu.Lock()
+		delete(s.documents, params.TextDocument.URI)
+		s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+		s.mu.Unlock()
+	}
+}
+
+// initializeResult advertises the server capabilities.
+func (s *Server) initializeResult() InitializeResult {
+	return InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncOptions{
+				OpenClose: true,
+				Change:    TextDocumentSyncFull,
+				Save:      SaveOptions{IncludeText: false},
+			},
+			CodeActionProvider: CodeActionOptions{
+				CodeActionKinds: []string{"quickfix", "source"},
+			},
+			ExecuteCommandProvider: ExecuteCommandOptions{
+				Commands: []string{CommandRunLLMRules, CommandApplyRemedy},
+			},
+		},
+		ServerInfo: ServerInfo{Name: "sym"},
+	}
+}
+
+// validateLinters runs linter-engine rules on an open document and publishes diagnostics.
+func (s *Server) validateLinters(ctx context.Context, uri string) {
+	rel, ok := s.relativePath(uri)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	doc := s.documents[uri]
+	ws := s.acquireWorkspace()
+	s.mu.Unlock()
+	defer ws.release()
+	if doc == nil {
+		return
+	}
+
+	// Linters read the file from disk, so no diff is needed
+	changes := []git.Change{{FilePath: rel, Status: "M"}}
+	result, err := ws.ValidateChangesWithOptions(ctx, changes, validator.ValidateOptions{
+		EngineFilter: validator.LinterEnginesOnly,
+	})
+	if err != nil {
+		s.logf("validation failed for %s: %v", rel, err)
+		return
+	}
+	s.logValidationErrors(result.Errors)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.documents[uri] != doc {
+		return // Closed (or reopened) while validating
+	}
+	doc.linter = s.violationsForFile(result.Violations, rel)
+	s.publish(uri, doc)
+}
+
+// validateLLM runs llm-validator rules on the document and publishes diagnostics.
+// Uncommitted changes are reviewed as a diff; a clean file is reviewed as a whole.
+func (s *Server) validateLLM(ctx context.Context, uri string) error {
+	rel, ok := s.relativePath(uri)
+	if !ok {
+		return fmt.Errorf("file is outside the project: %s", uri)
+	}
+
+	s.mu.Lock()
+	provider := s.llmProvider
+	doc := s.documents[uri]
+	ws := s.acquireWorkspace()
+	s.mu.Unlock()
+	defer ws.release()
+	if provider == nil {
+		return fmt.Errorf("LLM provider not configured")
+	}
+	if doc == nil {
+		return fmt.Errorf("document is not open: %s", uri)
+	}
+
+	change, err := s.llmChange(rel)
+	if err != nil {
+		return err
+	}
+
+	result, err := ws.ValidateChangesWithOptions(ctx, []git.Change{change}, validator.ValidateOptions{
+		EngineFilter: validator.LLMEngineOnly,
+		SkipRBAC:     true,
+	})
+	if err != nil {
+		return err
+	}
+	s.logValidationErrors(result.Errors)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.documents[uri] != doc {
+		return nil // Closed (or reopened) while validating
+	}
+	doc.llm = s.violationsForFile(result.Violations, rel)
+	s.publish(uri, doc)
+	return nil
+}
+
+// llmChange returns the change reviewed by llm-validator rules for a file.
+func (s *Server) llmChange(rel string) (git.Change, error) {
+	if changes, err := git.GetChangesForFiles([]string{rel}); err == nil {
+		for _, change := range changes {
+			if change.FilePath == rel && change.Status != "D" {
+				return change, nil
+			}
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(s.rootDir, rel))
+	if err != nil {
+		return git.Change{}, fmt.Errorf("failed to read %s: %w", rel, err)
+	}
+
+	// Present the whole file as added lines
+	lines := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "+" + line
+	}
+	return git.Change{FilePath: rel, Status: "M", Diff: strings.Join(lines, "\n")}, nil
+}
+
+// codeActions returns remedy quick fixes for the given diagnostics and the
+// on-demand LLM command for the document.
+func (s *Server) codeActions(params CodeActionParams) []CodeAction {
+	actions := []CodeAction{}
+	uri := params.TextDocument.URI
+
+	rel, ok := s.relativePath(uri)
+	if !ok {
+		return actions
+	}
+
+	s.mu.Lock()
+	ws := s.acquireWorkspace()
+	hasLLM := s.llmProvider != nil
+	s.mu.Unlock()
+	defer ws.release()
+
+	v, ok := ws.ValidatorFor(rel)
+	if !ok {
+		return actions
+	}
+	rules := v.Policy().Rules
+
+	offered := make(map[string]bool)
+	for _, d := range params.Context.Diagnostics {
+		if d.Source != diagnosticSource || offered[d.Code] {
+			continue
+		}
+		rule := findRule(rules, d.Code)
+		if rule == nil || !v.CanApplyRemedy(*rule) {
+			continue
+		}
+		offered[d.Code] = true
+
+		tool := rule.Remedy.Tool
+		if tool == "" {
+			tool = ruleEngine(*rule)
+		}
+		actions = append(actions, CodeAction{
+			Title:       fmt.Sprintf("Fix %s with %s", rule.ID, tool),
+			Kind:        "quickfix",
+			Diagnostics: []Diagnostic{d},
+			Command: &Command{
+				Title:     fmt.Sprintf("Fix %s with %s", rule.ID, tool),
+				Command:   CommandApplyRemedy,
+				Arguments: []interface{}{uri, rule.ID},
+			},
+		})
+	}
+
+	if hasLLM && hasLLMRules(rules) {
+		actions = append(actions, CodeAction{
+			Title: "Run Symphony LLM rules",
+			Kind:  "source",
+			Command: &Command{
+				Title:     "Run Symphony LLM rules",
+				Command:   CommandRunLLMRules,
+				Arguments: []interface{}{uri},
+			},
+		})
+	}
+
+	return actions
+}
+
+// executeCommand runs a workspace command.
+func (s *Server) executeCommand(ctx context.Context, params ExecuteCommandParams) error {
+	var args []string
+	for _, raw := range params.Arguments {
+		var arg string
+		if err := json.Unmarshal(raw, &arg); err != nil {
+			return fmt.Errorf("invalid argument for %s: %w", params.Command, err)
+		}
+		args = append(args, arg)
+	}
+
+	switch params.Command {
+	case CommandRunLLMRules:
+		if len(args) != 1 {
+			return fmt.Errorf("%s expects [uri]", params.Command)
+		}
+		return s.validateLLM(ctx, args[0])
+
+	case CommandApplyRemedy:
+		if len(args) != 2 {
+			return fmt.Errorf("%s expects [uri, ruleId]", params.Command)
+		}
+		return s.applyRemedy(ctx, args[0], args[1])
+
+	default:
+		return fmt.Errorf("unknown command: %s", params.Command)
+	}
+}
+
+// applyRemedy runs the rule's fixer on a temp copy of the document's editor
+// text and sends the result to the client as a workspace edit. The file on disk
+// is never touched, so unsaved edits are kept and saving stays up to the user.
+func (s *Server) applyRemedy(ctx context.Context, uri, ruleID string) error {
+	rel, ok := s.relativePath(uri)
+	if !ok {
+		return fmt.Errorf("file is outside the project: %s", uri)
+	}
+
+	s.mu.Lock()
+	doc := s.documents[uri]
+	var original string
+	if doc != nil {
+		original = doc.text
+	}
+	ws := s.acquireWorkspace()
+	s.mu.Unlock()
+	defer ws.release()
+	if doc == nil {
+		return fmt.Errorf("document is not open: %s", uri)
+	}
+
+	v, ok := ws.ValidatorFor(rel)
+	if !ok {
+		return fmt.Errorf("no code policy applies to %s", rel)
+	}
+	rule := findRule(v.Policy().Rules, ruleID)
+	if rule == nil {
+		return fmt.Errorf("rule not found: %s", ruleID)
+	}
+
+	fixed, err := v.FixContent(ctx, *rule, rel, original)
+	if err != nil {
+		return err
+	}
+
+	if fixed == original {
+		s.showMessage(MessageTypeInfo, fmt.Sprintf("%s: nothing to fix", ruleID))
+		return nil
+	}
+
+	s.request("workspace/applyEdit", ApplyWorkspaceEditParams{
+		Label: fmt.Sprintf("Fix %s", ruleID),
+		Edit: WorkspaceEdit{
+			Changes: map[string][]TextEdit{
+				uri: {{Range: fullRange(original), NewText: fixed}},
+			},
+		},
+	})
+	return nil
+}
+
+// publish sends the merged linter and LLM diagnostics of an open document.
+// Callers hold s.mu, so publishing cannot interleave with didClose.
+func (s *Server) publish(uri string, doc *document) {
+	violations := append(append([]validator.Violation{}, doc.linter...), doc.llm...)
+	lines := strings.Split(doc.text, "\n")
+
+	diagnostics := make([]Diagnostic, 0, len(violations))
+	for _, v := range violations {
+		diagnostics = append(diagnostics, toDiagnostic(v, lines))
+	}
+
+	s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+// toDiagnostic converts a violation to a diagnostic spanning the rest of its line.
+func toDiagnostic(v validator.Violation, lines []string) Diagnostic {
+	line := v.Line - 1
+	if line < 0 {
+		line = 0
+	}
+	start := v.Column - 1
+	if start < 0 {
+		start = 0
+	}
+
+	end := start + 1
+	if line < len(lines) {
+		if n := utf16Len(strings.TrimRight(lines[line], "\r")); n > start {
+			end = n
+		}
+	}
+
+	return Diagnostic{
+		Range: Range{
+			Start: Position{Line: line, Character: start},
+			End:   Position{Line: line, Character: end},
+		},
+		Severity: toSeverity(v.Severity),
+		Code:     v.RuleID,
+		Source:   diagnosticSource,
+		Message:  v.Message,
+		Data:     &DiagnosticRef{RuleID: v.RuleID, Tool: v.ToolName},
+	}
+}
+
+// toSeverity maps a policy severity to an LSP diagnostic severity.
+func toSeverity(severity string) int {
+	switch strings.ToLower(severity) {
+	case "error":
+		return SeverityError
+	case "warning", "warn":
+		return SeverityWarning
+	case "info":
+		return SeverityInformation
+	default:
+		return SeverityHint
+	}
+}
+
+// loadPolicy (re)loads the code policies of every .sym package.
+func (s *Server) loadPolicy() error {
+	// Validator output must stay off stdout, so verbose is always false
+	ws, err := validator.NewWorkspace(s.rootDir, false)
+	if err != nil {
+		return err
+	}
+	if s.policyPath != "" {
+		codePolicy, err := policy.NewLoader(false).LoadCodePolicy(s.policyPath)
+		if err != nil {
+			_ = ws.Close()
+			return err
+		}
+		ws.SetPolicy(".", codePolicy)
+	}
+	if !ws.HasPolicies() {
+		_ = ws.Close()
+		return fmt.Errorf("no code-policy.json found in %s", filepath.Join(s.rootDir, ".sym"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.llmProvider != nil {
+		ws.SetLLMProvider(s.llmProvider)
+	}
+	// Work in flight keeps using the old workspace; close it when that work is done
+	if old := s.workspace; old != nil {
+		s.runInBackground(old.close)
+	}
+	s.workspace = &workspaceRef{Workspace: ws, closed: make(chan struct{})}
+	return nil
+}
+
+// acquireWorkspace returns the current workspace, which is not closed until
+// the caller releases it. s.mu must be held.
+func (s *Server) acquireWorkspace() *workspaceRef {
+	s.workspace.users.Add(1)
+	return s.workspace
+}
+
+// violationsForFile keeps the violations reported for rel.
+// Tools report either project-relative or absolute paths.
+func (s *Server) violationsForFile(violations []validator.Violation, rel string) []validator.Violation {
+	var matched []validator.Violation
+	for _, v := range violations {
+		path := filepath.Clean(v.File)
+		if filepath.IsAbs(path) {
+			if r, err := filepath.Rel(s.rootDir, path); err == nil {
+				path = r
+			}
+		}
+		if filepath.ToSlash(path) == rel {
+			matched = append(matched, v)
+		}
+	}
+	return matched
+}
+
+// relativePath converts a file URI to a slash-separated path relative to the project root.
+func (s *Server) relativePath(uri string) (string, bool) {
+	path, err := uriToPath(uri)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(s.rootDir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// isPolicyFile reports whether uri points at a code policy: the --policy
+// override or the .sym/code-policy.json of a package, including new packages.
+func (s *Server) isPolicyFile(uri string) bool {
+	path, err := uriToPath(uri)
+	if err != nil {
+		return false
+	}
+	if s.policyPath != "" {
+		if policyPath, err := filepath.Abs(s.policyPath); err == nil && path == policyPath {
+			return true
+		}
+	}
+	return filepath.Base(path) == "code-policy.json" && filepath.Base(filepath.Dir(path)) == ".sym"
+}
+
+// hasLLMRules reports whether any enabled rule uses llm-validator.
+func hasLLMRules(rules []schema.PolicyRule) bool {
+	for _, rule := range rules {
+		if rule.Enabled && validator.LLMEngineOnly(ruleEngine(rule)) {
+			return true
+		}
+	}
+	return false
+}
+
+// runInBackground runs fn in a goroutine tracked for shutdown.
+func (s *Server) runInBackground(fn func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		fn()
+	}()
+}
+
+// logValidationErrors logs engine errors without failing the request.
+func (s *Server) logValidationErrors(errs []validator.ValidationError) {
+	for _, e := range errs {
+		s.logf("%s (%s): %s", e.Engine, e.RuleID, e.Message)
+	}
+}
+
+// logf writes a log line to the logger.
+func (s *Server) logf(format string, args ...interface{}) {
+	if s.logger != nil {
+		_, _ = fmt.Fprintf(s.logger, "sym lsp: "+format+"\n", args...)
+	}
+}
+
+// showMessage sends window/showMessage to the client.
+func (s *Server) showMessage(messageType int, text string) {
+	s.notify("window/showMessage", ShowMessageParams{Type: messageType, Message: text})
+}
+
+// reply sends a successful response.
+func (s *Server) reply(id *json.RawMessage, result interface{}) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		s.replyError(id, codeInternalError, err.Error())
+		return
+	}
+	s.write(&message{ID: id, Result: data})
+}
+
+// replyError sends an error response.
+func (s *Server) replyError(id *json.RawMessage, code int, text string) {
+	s.write(&message{ID: id, Error: &responseError{Code: code, Message: text}})
+}
+
+// notify sends a notification.
+func (s *Server) notify(method string, params interface{}) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		s.logf("failed to encode %s: %v", method, err)
+		return
+	}
+	s.write(&message{Method: method, Params: data})
+}
+
+// request sends a server-initiated request. The client's response is ignored.
+func (s *Server) request(method string, params interface{}) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		s.logf("failed to encode %s: %v", method, err)
+		return
+	}
+
+	s.writeMu.Lock()
+	s.nextID++
+	id := json.RawMessage(fmt.Sprintf("%d", s.nextID))
+	s.writeMu.Unlock()
+
+	s.write(&message{ID: &id, Method: method, Params: data})
+}
+
+// write serializes msg to the output stream.
+func (s *Server) write(msg *message) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	// Result must be present (null) on successful responses
+	if msg.ID != nil && msg.Method == "" && msg.Error == nil && msg.Result == nil {
+		msg.Result = json.RawMessage("null")
+	}
+	if err := writeMessage(s.out, msg); err != nil {
+		s.logf("failed to write message: %v", err)
+	}
+}
+
+// findRule returns the rule with the given ID.
+func findRule(rules []schema.PolicyRule, id string) *schema.PolicyRule {
+	for i := range rules {
+		if rules[i].ID == id {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// ruleEngine returns the engine of a rule.
+func ruleEngine(rule schema.PolicyRule) string {
+	engine, _ := rule.Check["engine"].(string)
+	return engine
+}
+
+// fullRange returns the range covering the whole text.
+func fullRange(text string) Range {
+	lines := strings.Split(text, "\n")
+	last := len(lines) - 1
+	return Range{
+		Start: Position{Line: 0, Character: 0},
+		End:   Position{Line: last, Character: utf16Len(lines[last])},
+	}
+}
+
+// utf16Len returns the length of s in UTF-16 code units, the LSP character unit.
+func utf16Len(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+// uriToPath converts a file:// URI to an absolute path.
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI scheme: %s", u.Scheme)
+	}
+
+	path := u.Path
+	// file:///C:/dir -> C:/dir
+	if runtime.GOOS == "windows" {
+		path = strings.TrimPrefix(path, "/")
+	}
+	return filepath.Clean(filepath.FromSlash(path)), nil
+}
+
+// PathToURI converts an absolute path to a file:// URI.
+func PathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
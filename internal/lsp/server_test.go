@@ -0,0 +1,518 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLinter reports a violation on every line containing "TODO" and fixes
+// files by replacing "TODO" with "DONE".
+type fakeLinter struct{}
+
+func (f *fakeLinter) Name() string { return "lsp-fake" }
+
+func (f *fakeLinter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{Name: "lsp-fake", SupportedLanguages: []string{"go"}}
+}
+
+func (f *fakeLinter) CheckAvailability(ctx context.Context) error { return nil }
+
+func (f *fakeLinter) Install(ctx context.Context, config linter.InstallConfig) error { return nil }
+
+func (f *fakeLinter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	var out []string
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for i, line := range strings.Split(string(data), "\n") {
+			if col := strings.Index(line, "TODO"); col >= 0 {
+				out = append(out, fmt.Sprintf("%s:%d:%d", file, i+1, col+1))
+			}
+		}
+	}
+	return &linter.ToolOutput{Stdout: strings.Join(out, "\n")}, nil
+}
+
+func (f *fakeLinter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	var violations []linter.Violation
+	for _, line := range strings.Split(output.Stdout, "\n") {
+		if line == "" {
+			continue
+		}
+		var file string
+		var ln, col int
+		parts := strings.Split(line, ":")
+		file = parts[0]
+		_, _ = fmt.Sscanf(parts[1], "%d", &ln)
+		_, _ = fmt.Sscanf(parts[2], "%d", &col)
+		violations = append(violations, linter.Violation{
+			File: file, Line: ln, Column: col, Message: "TODO left in code", RuleID: "no-todo",
+		})
+	}
+	return violations, nil
+}
+
+func (f *fakeLinter) RuleConfig(config []byte, ruleIDs []string) ([]byte, error) {
+	return config, nil
+}
+
+func (f *fakeLinter) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(file, []byte(strings.ReplaceAll(string(data), "TODO", "DONE")), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return &linter.ToolOutput{}, nil
+}
+
+func init() {
+	_ = linter.Global().RegisterTool(&fakeLinter{}, nil, "")
+}
+
+// fakeProvider always reports a high-confidence violation.
+type fakeProvider struct{}
+
+func (p *fakeProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	return `{"violates": true, "confidence": "high", "description": "missing doc comment", "suggestion": ""}`, nil
+}
+
+func (p *fakeProvider) Name() string { return "fake" }
+
+func (p *fakeProvider) Close() error { return nil }
+
+const testPolicy = `{
+  "version": "1.0.0",
+  "rules": [
+    {
+      "id": "NO-TODO",
+      "enabled": true,
+      "severity": "warning",
+      "desc": "No TODO comments",
+      "when": {"languages": ["go"]},
+      "check": {"engine": "lsp-fake", "desc": "No TODO comments"},
+      "remedy": {"autofix": true, "tool": "lsp-fake"}
+    },
+    {
+      "id": "DOC",
+      "enabled": true,
+      "severity": "error",
+      "desc": "Exported functions need doc comments",
+      "when": {"languages": ["go"]},
+      "check": {"engine": "llm-validator", "desc": "Exported functions need doc comments"}
+    }
+  ],
+  "enforce": {"stages": ["pre-commit"]}
+}`
+
+// scriptedClient drives the server through an in-memory pipe.
+type scriptedClient struct {
+	t        *testing.T
+	toServer *io.PipeWriter
+	messages chan *message
+	nextID   int
+	done     chan error
+}
+
+func startServer(t *testing.T, withLLM bool) (*scriptedClient, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".sym"), 0755))
+	policyPath := filepath.Join(root, ".sym", "code-policy.json")
+	require.NoError(t, os.WriteFile(policyPath, []byte(testPolicy), 0644))
+
+	server, err := NewServer(root, policyPath)
+	require.NoError(t, err)
+	server.SetLogger(io.Discard)
+	if withLLM {
+		server.SetLLMProvider(&fakeProvider{})
+	}
+
+	// The fake linter resolves paths against the working directory, like real linters
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(root))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	c := &scriptedClient{
+		t:        t,
+		toServer: clientWriter,
+		messages: make(chan *message, 32),
+		done:     make(chan error, 1),
+	}
+
+	go func() {
+		c.done <- server.Serve(context.Background(), serverReader, serverWriter)
+		_ = serverWriter.Close()
+	}()
+	go func() {
+		reader := bufio.NewReader(clientReader)
+		for {
+			msg, err := readMessage(reader)
+			if err != nil {
+				close(c.messages)
+				return
+			}
+			c.messages <- msg
+		}
+	}()
+
+	return c, root
+}
+
+func (c *scriptedClient) send(msg *message) {
+	require.NoError(c.t, writeMessage(c.toServer, msg))
+}
+
+func (c *scriptedClient) request(method string, params interface{}) int {
+	c.nextID++
+	id := json.RawMessage(fmt.Sprintf("%d", c.nextID))
+	data, err := json.Marshal(params)
+	require.NoError(c.t, err)
+	c.send(&message{ID: &id, Method: method, Params: data})
+	return c.nextID
+}
+
+func (c *scriptedClient) notify(method string, params interface{}) {
+	data, err := json.Marshal(params)
+	require.NoError(c.t, err)
+	c.send(&message{Method: method, Params: data})
+}
+
+// expect waits for the next message matching pred.
+func (c *scriptedClient) expect(pred func(*message) bool) *message {
+	c.t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg, ok := <-c.messages:
+			require.True(c.t, ok, "server closed the stream")
+			if pred(msg) {
+				return msg
+			}
+		case <-timeout:
+			c.t.Fatal("timed out waiting for message")
+			return nil
+		}
+	}
+}
+
+func (c *scriptedClient) expectResponse(id int) *message {
+	want := fmt.Sprintf("%d", id)
+	return c.expect(func(m *message) bool { return m.Method == "" && m.ID != nil && string(*m.ID) == want })
+}
+
+func (c *scriptedClient) expectMethod(method string) *message {
+	return c.expect(func(m *message) bool { return m.Method == method })
+}
+
+// expectDiagnostics waits for diagnostics of uri with the given number of entries.
+func (c *scriptedClient) expectDiagnostics(uri string, count int) PublishDiagnosticsParams {
+	var params PublishDiagnosticsParams
+	c.expect(func(m *message) bool {
+		if m.Method != "textDocument/publishDiagnostics" {
+			return false
+		}
+		require.NoError(c.t, json.Unmarshal(m.Params, &params))
+		return params.URI == uri && len(params.Diagnostics) == count
+	})
+	return params
+}
+
+func (c *scriptedClient) open(uri, text string) {
+	c.notify("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "go", Version: 1, Text: text},
+	})
+}
+
+func (c *scriptedClient) initialize() {
+	id := c.request("initialize", InitializeParams{})
+	resp := c.expectResponse(id)
+	require.Nil(c.t, resp.Error)
+	c.notify("initialized", struct{}{})
+}
+
+func (c *scriptedClient) shutdown() {
+	id := c.request("shutdown", nil)
+	c.expectResponse(id)
+	c.notify("exit", nil)
+	require.NoError(c.t, <-c.done)
+}
+
+func TestServer_Initialize(t *testing.T) {
+	c, _ := startServer(t, false)
+
+	id := c.request("initialize", InitializeParams{})
+	resp := c.expectResponse(id)
+	require.Nil(t, resp.Error)
+
+	var result InitializeResult
+	require.NoError(t, json.Unmarshal(resp.Result, &result))
+	assert.True(t, result.Capabilities.TextDocumentSync.OpenClose)
+	assert.ElementsMatch(t, []string{CommandRunLLMRules, CommandApplyRemedy}, result.Capabilities.ExecuteCommandProvider.Commands)
+
+	c.shutdown()
+}
+
+func TestServer_RejectsRequestsBeforeInitialize(t *testing.T) {
+	c, _ := startServer(t, false)
+
+	id := c.request("textDocument/codeAction", CodeActionParams{})
+	resp := c.expectResponse(id)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, codeServerNotInitialized, resp.Error.Code)
+
+	c.notify("exit", nil)
+	require.NoError(t, <-c.done)
+}
+
+func TestServer_DidOpenAndSavePublishLinterDiagnostics(t *testing.T) {
+	c, root := startServer(t, false)
+	c.initialize()
+
+	path := filepath.Join(root, "main.go")
+	content := "package main\n\n// TODO: remove\nfunc main() {}\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	uri := PathToURI(path)
+
+	c.open(uri, content)
+	params := c.expectDiagnostics(uri, 1)
+	d := params.Diagnostics[0]
+	assert.Equal(t, "NO-TODO", d.Code)
+	assert.Equal(t, SeverityWarning, d.Severity)
+	assert.Equal(t, "TODO left in code", d.Message)
+	assert.Equal(t, Range{Start: Position{Line: 2, Character: 3}, End: Position{Line: 2, Character: 15}}, d.Range)
+
+	// Saving a fix re-validates the file on disk
+	require.NoError(t, os.WriteFile(path, []byte("package main\n\nfunc main() {}\n"), 0644))
+	c.notify("textDocument/didSave", DidSaveTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}})
+	c.expectDiagnostics(uri, 0)
+
+	c.shutdown()
+}
+
+func TestServer_ClosedDocumentsGetNoDiagnostics(t *testing.T) {
+	c, root := startServer(t, false)
+	c.initialize()
+
+	path := filepath.Join(root, "main.go")
+	content := "package main\n\n// TODO: remove\nfunc main() {}\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	uri := PathToURI(path)
+
+	// A save of a document that is not open is not validated, and a validation
+	// still running when the document closes must not publish after the clear
+	c.notify("textDocument/didSave", DidSaveTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}})
+	c.open(uri, content)
+	c.notify("textDocument/didClose", DidCloseTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}})
+
+	// shutdown waits for background validations before replying
+	id := c.request("shutdown", nil)
+	var last *PublishDiagnosticsParams
+	c.expect(func(m *message) bool {
+		if m.Method == "textDocument/publishDiagnostics" {
+			var params PublishDiagnosticsParams
+			require.NoError(t, json.Unmarshal(m.Params, &params))
+			last = &params
+		}
+		return m.Method == "" && m.ID != nil && string(*m.ID) == fmt.Sprintf("%d", id)
+	})
+	require.NotNil(t, last)
+	assert.Empty(t, last.Diagnostics)
+
+	c.notify("exit", nil)
+	require.NoError(t, <-c.done)
+}
+
+func TestServer_NestedPackagePolicy(t *testing.T) {
+	c, root := startServer(t, false)
+	c.initialize()
+
+	// sub/ has its own .sym package whose rule reports the same linter finding
+	subPolicy := strings.ReplaceAll(testPolicy, `"id": "NO-TODO"`, `"id": "SUB-NO-TODO"`)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "sub", ".sym"), 0755))
+	subPolicyPath := filepath.Join(root, "sub", ".sym", "code-policy.json")
+	require.NoError(t, os.WriteFile(subPolicyPath, []byte(subPolicy), 0644))
+	// Saving a package policy reloads the packages
+	c.notify("textDocument/didSave", DidSaveTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: PathToURI(subPolicyPath)}})
+
+	path := filepath.Join(root, "sub", "main.go")
+	content := "package main\n\n// TODO: remove\nfunc main() {}\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	uri := PathToURI(path)
+
+	c.open(uri, content)
+	params := c.expectDiagnostics(uri, 1)
+	assert.Equal(t, "SUB-NO-TODO", params.Diagnostics[0].Code)
+
+	c.shutdown()
+}
+
+func TestServer_ReloadClosesWorkspaceAfterInFlightWork(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".sym"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".sym", "code-policy.json"), []byte(testPolicy), 0644))
+
+	server, err := NewServer(root, "")
+	require.NoError(t, err)
+
+	// Validation in flight holds the workspace it started with
+	server.mu.Lock()
+	old := server.acquireWorkspace()
+	server.mu.Unlock()
+
+	require.NoError(t, server.loadPolicy())
+	assert.NotSame(t, old, server.workspace)
+	select {
+	case <-old.closed:
+		t.Fatal("workspace closed while in use")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	old.release()
+	select {
+	case <-old.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("workspace not closed after its last use")
+	}
+	server.wg.Wait()
+}
+
+func TestServer_CodeActionsAndRemedy(t *testing.T) {
+	c, root := startServer(t, true)
+	c.initialize()
+
+	path := filepath.Join(root, "main.go")
+	original := "package main\n\n// TODO: remove\nfunc main() {}\n"
+	require.NoError(t, os.WriteFile(path, []byte(original), 0644))
+	uri := PathToURI(path)
+
+	c.open(uri, original)
+	published := c.expectDiagnostics(uri, 1)
+
+	// Unsaved edits in the editor buffer are what the remedy fixes
+	buffer := original + "\n// TODO: unsaved\n"
+	c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: uri, Version: 2},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: buffer}},
+	})
+
+	id := c.request("textDocument/codeAction", CodeActionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Range:        published.Diagnostics[0].Range,
+		Context:      CodeActionContext{Diagnostics: published.Diagnostics},
+	})
+	var actions []CodeAction
+	require.NoError(t, json.Unmarshal(c.expectResponse(id).Result, &actions))
+	require.Len(t, actions, 2)
+	assert.Equal(t, "quickfix", actions[0].Kind)
+	assert.Equal(t, CommandApplyRemedy, actions[0].Command.Command)
+	assert.Equal(t, "source", actions[1].Kind)
+	assert.Equal(t, CommandRunLLMRules, actions[1].Command.Command)
+
+	// Applying the remedy sends the fixed buffer as an edit and leaves the file untouched
+	id = c.request("workspace/executeCommand", actions[0].Command)
+	edit := c.expectMethod("workspace/applyEdit")
+	var editParams ApplyWorkspaceEditParams
+	require.NoError(t, json.Unmarshal(edit.Params, &editParams))
+	require.Len(t, editParams.Edit.Changes[uri], 1)
+	assert.Equal(t, strings.ReplaceAll(buffer, "TODO", "DONE"), editParams.Edit.Changes[uri][0].NewText)
+	assert.Equal(t, fullRange(buffer), editParams.Edit.Changes[uri][0].Range)
+	assert.Nil(t, c.expectResponse(id).Error)
+
+	onDisk, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, original, string(onDisk))
+
+	c.shutdown()
+}
+
+func TestServer_RunLLMRulesCommand(t *testing.T) {
+	c, root := startServer(t, true)
+	c.initialize()
+
+	path := filepath.Join(root, "api.go")
+	content := "package api\n\nfunc Exported() {}\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	uri := PathToURI(path)
+
+	c.open(uri, content)
+	c.expectDiagnostics(uri, 0)
+	id := c.request("workspace/executeCommand", Command{Command: CommandRunLLMRules, Arguments: []interface{}{uri}})
+
+	params := c.expectDiagnostics(uri, 1)
+	assert.Equal(t, "DOC", params.Diagnostics[0].Code)
+	assert.Equal(t, SeverityError, params.Diagnostics[0].Severity)
+	assert.Contains(t, params.Diagnostics[0].Message, "missing doc comment")
+	assert.Nil(t, c.expectResponse(id).Error)
+
+	c.shutdown()
+}
+
+func TestServer_RunLLMRulesWithoutProvider(t *testing.T) {
+	c, root := startServer(t, false)
+	c.initialize()
+
+	uri := PathToURI(filepath.Join(root, "api.go"))
+	id := c.request("workspace/executeCommand", Command{Command: CommandRunLLMRules, Arguments: []interface{}{uri}})
+
+	resp := c.expectResponse(id)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Message, "LLM provider not configured")
+
+	c.shutdown()
+}
+
+func TestToSeverity(t *testing.T) {
+	assert.Equal(t, SeverityError, toSeverity("error"))
+	assert.Equal(t, SeverityWarning, toSeverity("warning"))
+	assert.Equal(t, SeverityInformation, toSeverity("info"))
+	assert.Equal(t, SeverityHint, toSeverity(""))
+}
+
+func TestURIRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir with space", "a.go")
+	got, err := uriToPath(PathToURI(path))
+	require.NoError(t, err)
+	assert.Equal(t, path, got)
+}
+
+func TestServer_RelativePath(t *testing.T) {
+	root := t.TempDir()
+	s := &Server{rootDir: root}
+
+	rel, ok := s.relativePath(PathToURI(filepath.Join(root, "..env.go")))
+	assert.True(t, ok)
+	assert.Equal(t, "..env.go", rel)
+
+	rel, ok = s.relativePath(PathToURI(filepath.Join(root, "...config", "x.go")))
+	assert.True(t, ok)
+	assert.Equal(t, "...config/x.go", rel)
+
+	_, ok = s.relativePath(PathToURI(filepath.Join(filepath.Dir(root), "other.go")))
+	assert.False(t, ok)
+	_, ok = s.relativePath(PathToURI(filepath.Dir(root)))
+	assert.False(t, ok)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/converter"
 	"github.com/DevSymphony/sym-cli/internal/importer"
 	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/plugin"
 	"github.com/DevSymphony/sym-cli/internal/llm"
 	"github.com/DevSymphony/sym-cli/internal/policy"
 	"github.com/DevSymphony/sym-cli/internal/roles"
@@ -25,15 +26,10 @@ import (
 // ConvertPolicyWithLLM converts user policy to code policy using LLM.
 // This is extracted from cmd/mcp.go's autoConvertPolicy for reuse.
 func ConvertPolicyWithLLM(userPolicyPath, codePolicyPath string) error {
-	// Load user policy
-	data, err := os.ReadFile(userPolicyPath)
+	// Load user policy with inherited (extends) policies merged
+	userPolicy, err := policy.NewLoader(false).ResolveUserPolicy(userPolicyPath)
 	if err != nil {
-		return fmt.Errorf("failed to read user policy: %w", err)
-	}
-
-	var userPolicy schema.UserPolicy
-	if err := json.Unmarshal(data, &userPolicy); err != nil {
-		return fmt.Errorf("failed to parse user policy: %w", err)
+		return fmt.Errorf("failed to load user policy: %w", err)
 	}
 
 	// Setup LLM provider
@@ -55,7 +51,7 @@ func ConvertPolicyWithLLM(userPolicyPath, codePolicyPath string) error {
 	fmt.Fprintf(os.Stderr, "Converting %d rules...\n", len(userPolicy.Rules))
 
 	// Convert using new API
-	result, err := conv.Convert(ctx, &userPolicy)
+	result, err := conv.Convert(ctx, userPolicy)
 	if err != nil {
 		return fmt.Errorf("conversion failed: %w", err)
 	}
@@ -153,7 +149,7 @@ func (s *Server) Start() error {
 
 		// Check if conversion is needed
 		if s.userPolicy != nil {
-			needsConversion := s.needsConversion(codePolicyPath)
+			needsConversion := s.needsConversion(userPolicyPath, codePolicyPath)
 			if needsConversion {
 				fmt.Fprintf(os.Stderr, "⚙️  User policy has been updated. Converting to code policy...\n")
 				if err := s.convertUserPolicy(userPolicyPath, codePolicyPath); err != nil {
@@ -246,31 +242,41 @@ type ImportConventionsInput struct {
 
 // ConventionInput represents a single convention for add operations.
 type ConventionInput struct {
-	ID        string   `json:"id" jsonschema:"Rule ID (required)"`
-	Say       string   `json:"say" jsonschema:"Rule description in natural language (required)"`
-	Category  string   `json:"category,omitempty" jsonschema:"Category name"`
-	Languages []string `json:"languages,omitempty" jsonschema:"Programming languages"`
-	Severity  string   `json:"severity,omitempty" jsonschema:"error, warning, or info"`
-	Autofix   bool     `json:"autofix,omitempty" jsonschema:"Enable auto-fix"`
-	Message   string   `json:"message,omitempty" jsonschema:"Message to display on violation"`
-	Example   string   `json:"example,omitempty" jsonschema:"Code example"`
-	Include   []string `json:"include,omitempty" jsonschema:"File patterns to include"`
-	Exclude   []string `json:"exclude,omitempty" jsonschema:"File patterns to exclude"`
+	ID        string               `json:"id" jsonschema:"Rule ID (required)"`
+	Say       string               `json:"say" jsonschema:"Rule description in natural language (required)"`
+	Category  string               `json:"category,omitempty" jsonschema:"Category name"`
+	Languages []string             `json:"languages,omitempty" jsonschema:"Programming languages"`
+	Severity  string               `json:"severity,omitempty" jsonschema:"error, warning, or info"`
+	Autofix   bool                 `json:"autofix,omitempty" jsonschema:"Enable auto-fix"`
+	Message   string               `json:"message,omitempty" jsonschema:"Message to display on violation"`
+	Example   string               `json:"example,omitempty" jsonschema:"Code example"`
+	Examples  *schema.RuleExamples `json:"examples,omitempty" jsonschema:"Good (compliant) and bad (violating) code snippets, checked against the converted rule"`
+	Include   []string             `json:"include,omitempty" jsonschema:"File patterns to include"`
+	Exclude   []string             `json:"exclude,omitempty" jsonschema:"File patterns to exclude"`
+	Params    map[string]any       `json:"params,omitempty" jsonschema:"Rule parameters applied exactly by linters, e.g. {\"max\": 120} for a line length limit"`
+	Engines   []string             `json:"engines,omitempty" jsonschema:"Engines that enforce the rule, skipping LLM routing (e.g. eslint, semgrep)"`
+	Native    map[string]any       `json:"native,omitempty" jsonschema:"Engine to native rule config used as is without the LLM, e.g. {\"eslint\": {\"no-console\": \"error\"}}"`
+	NoLLM     bool                 `json:"noLlm,omitempty" jsonschema:"Never enforce the rule with llm-validator, not even as a fallback"`
 }
 
 // ConventionEditInput represents a single convention edit for batch operations.
 type ConventionEditInput struct {
-	ID        string   `json:"id" jsonschema:"Current rule ID (required)"`
-	NewID     string   `json:"new_id,omitempty" jsonschema:"New rule ID"`
-	Say       string   `json:"say,omitempty" jsonschema:"New description"`
-	Category  string   `json:"category,omitempty" jsonschema:"New category name"`
-	Languages []string `json:"languages,omitempty" jsonschema:"New programming languages"`
-	Severity  string   `json:"severity,omitempty" jsonschema:"New severity level"`
-	Autofix   *bool    `json:"autofix,omitempty" jsonschema:"Enable auto-fix"`
-	Message   string   `json:"message,omitempty" jsonschema:"New message"`
-	Example   string   `json:"example,omitempty" jsonschema:"New code example"`
-	Include   []string `json:"include,omitempty" jsonschema:"New file patterns to include"`
-	Exclude   []string `json:"exclude,omitempty" jsonschema:"New file patterns to exclude"`
+	ID        string               `json:"id" jsonschema:"Current rule ID (required)"`
+	NewID     string               `json:"new_id,omitempty" jsonschema:"New rule ID"`
+	Say       string               `json:"say,omitempty" jsonschema:"New description"`
+	Category  string               `json:"category,omitempty" jsonschema:"New category name"`
+	Languages []string             `json:"languages,omitempty" jsonschema:"New programming languages"`
+	Severity  string               `json:"severity,omitempty" jsonschema:"New severity level"`
+	Autofix   *bool                `json:"autofix,omitempty" jsonschema:"Enable auto-fix"`
+	Message   string               `json:"message,omitempty" jsonschema:"New message"`
+	Example   string               `json:"example,omitempty" jsonschema:"New code example"`
+	Examples  *schema.RuleExamples `json:"examples,omitempty" jsonschema:"New good/bad code snippets (replace the existing ones)"`
+	Include   []string             `json:"include,omitempty" jsonschema:"New file patterns to include"`
+	Exclude   []string             `json:"exclude,omitempty" jsonschema:"New file patterns to exclude"`
+	Params    map[string]any       `json:"params,omitempty" jsonschema:"Rule parameters, merged into the existing ones; null removes a parameter"`
+	Engines   []string             `json:"engines,omitempty" jsonschema:"New pinned engines (replace the existing ones; empty array unpins)"`
+	Native    map[string]any       `json:"native,omitempty" jsonschema:"Native config per engine, merged into the existing one; null removes an engine"`
+	NoLLM     *bool                `json:"noLlm,omitempty" jsonschema:"Never enforce the rule with llm-validator"`
 }
 
 // AddConventionInput represents the input schema for the add_convention tool (batch mode).
@@ -675,8 +681,23 @@ func (s *Server) handleValidateCode(ctx context.Context, session *sdkmcp.ServerS
 	}
 	fmt.Fprintf(os.Stderr, "✓ Using LLM provider: %s\n", llmProvider.Name())
 
-	// Create unified validator that handles all engines + RBAC
-	v := validator.NewValidator(validationPolicy, false) // verbose=false for MCP
+	// Validate each change with its nearest .sym package; the root package
+	// uses the server's policy (converted from the user policy if needed)
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return nil, &RPCError{
+			Code:    -32000,
+			Message: fmt.Sprintf("failed to find git repository: %v", err),
+		}
+	}
+	v, err := validator.NewWorkspace(repoRoot, false) // verbose=false for MCP
+	if err != nil {
+		return nil, &RPCError{
+			Code:    -32000,
+			Message: fmt.Sprintf("failed to load packages: %v", err),
+		}
+	}
+	v.SetPolicy(".", validationPolicy)
 	v.SetLLMProvider(llmProvider)
 	defer func() {
 		_ = v.Close() // Ignore close error in MCP context
@@ -826,10 +847,12 @@ func (s *Server) getValidationPolicy() (*schema.CodePolicy, error) {
 }
 
 // needsConversion checks if user policy needs to be converted to code policy.
+// The user policy is compared with inherited (extends) policies merged, as it is converted.
 // Returns true if:
 // 1. code-policy.json doesn't exist, OR
-// 2. user policy has rule IDs that don't exist in code policy (after extracting source ID)
-func (s *Server) needsConversion(codePolicyPath string) bool {
+// 2. the conversion manifest shows added, modified or removed rules, OR
+// 3. without a manifest, user policy has rule IDs that don't exist in code policy (after extracting source ID)
+func (s *Server) needsConversion(userPolicyPath, codePolicyPath string) bool {
 	// If no code policy exists, conversion is needed
 	if s.codePolicy == nil {
 		return true
@@ -840,16 +863,30 @@ func (s *Server) needsConversion(codePolicyPath string) bool {
 		return false
 	}
 
+	// s.userPolicy stays unresolved for editing and saving
+	userPolicy, err := s.loader.ResolveUserPolicy(userPolicyPath)
+	if err != nil {
+		// Let the conversion report why the policy cannot be resolved
+		return true
+	}
+
+	// Conversion is incremental, so any changed rule is worth converting
+	conv := converter.NewConverter(nil, filepath.Dir(codePolicyPath))
+	if changed, ok := conv.ChangedRules(userPolicy); ok {
+		return len(changed) > 0
+	}
+
 	// Extract source rule IDs from code policy
 	// code-policy rules have IDs like "FMT-001-eslint", we extract "FMT-001"
+	registry, _, _ := plugin.NewRegistry(linter.Global(), filepath.Dir(codePolicyPath))
 	codePolicySourceIDs := make(map[string]bool)
 	for _, rule := range s.codePolicy.Rules {
-		sourceID := extractSourceRuleID(rule.ID)
+		sourceID := extractSourceRuleID(registry, rule.ID)
 		codePolicySourceIDs[sourceID] = true
 	}
 
 	// Check if all user policy rule IDs have corresponding code policy rules
-	for _, userRule := range s.userPolicy.Rules {
+	for _, userRule := range userPolicy.Rules {
 		if !codePolicySourceIDs[userRule.ID] {
 			// Found a user rule that doesn't exist in code policy
 			return true
@@ -861,9 +898,9 @@ func (s *Server) needsConversion(codePolicyPath string) bool {
 
 // extractSourceRuleID extracts the original user-policy rule ID from a code-policy rule ID.
 // For example: "FMT-001-eslint" -> "FMT-001"
-func extractSourceRuleID(codePolicyRuleID string) string {
+func extractSourceRuleID(registry *linter.Registry, codePolicyRuleID string) string {
 	// Build linter suffixes dynamically from registry + llm-validator
-	toolNames := linter.Global().GetAllToolNames()
+	toolNames := registry.GetAllToolNames()
 	suffixes := make([]string, 0, len(toolNames)+1)
 	for _, name := range toolNames {
 		suffixes = append(suffixes, "-"+name)
@@ -1427,14 +1464,10 @@ func (s *Server) handleConvertPolicy(ctx context.Context, input ConvertPolicyInp
 		outputDir = filepath.Dir(inputPath)
 	}
 
-	// 2. Load user-policy.json
-	data, err := os.ReadFile(inputPath)
+	// 2. Load user-policy.json with inherited (extends) policies merged
+	userPolicy, err := s.loader.ResolveUserPolicy(inputPath)
 	if err != nil {
-		return nil, &RPCError{Code: -32000, Message: fmt.Sprintf("Failed to read policy: %v", err)}
-	}
-	var userPolicy schema.UserPolicy
-	if err := json.Unmarshal(data, &userPolicy); err != nil {
-		return nil, &RPCError{Code: -32000, Message: fmt.Sprintf("Failed to parse policy: %v", err)}
+		return nil, &RPCError{Code: -32000, Message: fmt.Sprintf("Failed to load policy: %v", err)}
 	}
 
 	// 3. Setup LLM provider
@@ -1447,7 +1480,7 @@ func (s *Server) handleConvertPolicy(ctx context.Context, input ConvertPolicyInp
 
 	// 4. Create converter and execute
 	conv := converter.NewConverter(llmProvider, outputDir)
-	result, err := conv.Convert(ctx, &userPolicy)
+	result, err := conv.Convert(ctx, userPolicy)
 	if err != nil {
 		if result != nil {
 			return s.buildConvertResponse(result, err), nil
@@ -1548,6 +1581,7 @@ func (s *Server) handleAddConvention(input AddConventionInput) (interface{}, *RP
 	var succeeded []string
 	var failed []FailedItem
 	var addedRules []schema.UserRule
+	engineChecker := converter.NewConverter(nil, filepath.Dir(s.getUserPolicyPath()))
 
 	// Process each convention
 	for _, conv := range input.Conventions {
@@ -1567,6 +1601,11 @@ func (s *Server) handleAddConvention(input AddConventionInput) (interface{}, *RP
 			continue
 		}
 
+		if err := engineChecker.CheckEngines(schema.UserRule{ID: conv.ID, Engines: conv.Engines, Native: conv.Native}); err != nil {
+			failed = append(failed, FailedItem{Name: conv.ID, Reason: err.Error()})
+			continue
+		}
+
 		// Add convention
 		rule := schema.UserRule{
 			ID:        conv.ID,
@@ -1577,8 +1616,13 @@ func (s *Server) handleAddConvention(input AddConventionInput) (interface{}, *RP
 			Autofix:   conv.Autofix,
 			Message:   conv.Message,
 			Example:   conv.Example,
+			Examples:  conv.Examples,
 			Include:   conv.Include,
 			Exclude:   conv.Exclude,
+			Params:    conv.Params,
+			Engines:   conv.Engines,
+			Native:    conv.Native,
+			NoLLM:     conv.NoLLM,
 		}
 		s.userPolicy.Rules = append(s.userPolicy.Rules, rule)
 		addedRules = append(addedRules, rule)
@@ -1618,6 +1662,7 @@ func (s *Server) handleEditConvention(input EditConventionInput) (interface{}, *
 	var succeeded []string
 	var failed []FailedItem
 	var editedRules []schema.UserRule
+	engineChecker := converter.NewConverter(nil, filepath.Dir(s.getUserPolicyPath()))
 
 	// Process each edit
 	for _, edit := range input.Edits {
@@ -1630,7 +1675,9 @@ func (s *Server) handleEditConvention(input EditConventionInput) (interface{}, *
 		// Check if at least one field to edit
 		hasEdit := edit.NewID != "" || edit.Say != "" || edit.Category != "" ||
 			len(edit.Languages) > 0 || edit.Severity != "" || edit.Autofix != nil ||
-			edit.Message != "" || edit.Example != "" || len(edit.Include) > 0 || len(edit.Exclude) > 0
+			edit.Message != "" || edit.Example != "" || edit.Examples != nil ||
+			len(edit.Include) > 0 || len(edit.Exclude) > 0 ||
+			edit.Params != nil || edit.Engines != nil || edit.Native != nil || edit.NoLLM != nil
 
 		if !hasEdit {
 			failed = append(failed, FailedItem{Name: edit.ID, Reason: "At least one field to edit must be provided"})
@@ -1644,6 +1691,12 @@ func (s *Server) handleEditConvention(input EditConventionInput) (interface{}, *
 			continue
 		}
 
+		// Native entries set to null are removals, which never need a registered engine
+		if err := engineChecker.CheckEngines(schema.UserRule{ID: edit.ID, Engines: edit.Engines, Native: policy.MergeRuleMap(nil, edit.Native)}); err != nil {
+			failed = append(failed, FailedItem{Name: edit.ID, Reason: err.Error()})
+			continue
+		}
+
 		resultText := edit.ID
 
 		// If renaming ID
@@ -1684,12 +1737,27 @@ func (s *Server) handleEditConvention(input EditConventionInput) (interface{}, *
 		if edit.Example != "" {
 			s.userPolicy.Rules[idx].Example = edit.Example
 		}
+		if edit.Examples != nil {
+			s.userPolicy.Rules[idx].Examples = edit.Examples
+		}
 		if len(edit.Include) > 0 {
 			s.userPolicy.Rules[idx].Include = edit.Include
 		}
 		if len(edit.Exclude) > 0 {
 			s.userPolicy.Rules[idx].Exclude = edit.Exclude
 		}
+		if edit.Params != nil {
+			s.userPolicy.Rules[idx].Params = policy.MergeRuleMap(s.userPolicy.Rules[idx].Params, edit.Params)
+		}
+		if edit.Engines != nil {
+			s.userPolicy.Rules[idx].Engines = edit.Engines
+		}
+		if edit.Native != nil {
+			s.userPolicy.Rules[idx].Native = policy.MergeRuleMap(s.userPolicy.Rules[idx].Native, edit.Native)
+		}
+		if edit.NoLLM != nil {
+			s.userPolicy.Rules[idx].NoLLM = *edit.NoLLM
+		}
 
 		editedRules = append(editedRules, s.userPolicy.Rules[idx])
 		succeeded = append(succeeded, resultText)
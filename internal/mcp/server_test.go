@@ -1,11 +1,14 @@
 package mcp
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"testing"
 
+	"github.com/DevSymphony/sym-cli/internal/converter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
 	"github.com/DevSymphony/sym-cli/internal/policy"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +22,17 @@ func runGitInit(dir string) error {
 	return cmd.Run()
 }
 
+// chdirTempRepo runs the test in a fresh git repository, so handlers that save
+// the user policy to the repository root never write into this repository.
+func chdirTempRepo(t *testing.T) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+	require.NoError(t, runGitInit(tmpDir))
+}
+
 func TestQueryConventions(t *testing.T) {
 	// Setup: Create a temporary user policy
 	tmpDir := t.TempDir()
@@ -266,6 +280,8 @@ func TestAddCategory(t *testing.T) {
 	})
 
 	t.Run("batch add multiple categories", func(t *testing.T) {
+		chdirTempRepo(t)
+
 		server := &Server{
 			loader: policy.NewLoader(false),
 			userPolicy: &schema.UserPolicy{
@@ -288,6 +304,8 @@ func TestAddCategory(t *testing.T) {
 	})
 
 	t.Run("partial failure in batch", func(t *testing.T) {
+		chdirTempRepo(t)
+
 		server := &Server{
 			loader: policy.NewLoader(false),
 			userPolicy: &schema.UserPolicy{
@@ -420,6 +438,8 @@ func TestEditCategory(t *testing.T) {
 	})
 
 	t.Run("batch edit with partial failure", func(t *testing.T) {
+		chdirTempRepo(t)
+
 		server := &Server{
 			loader: policy.NewLoader(false),
 			userPolicy: &schema.UserPolicy{
@@ -508,6 +528,8 @@ func TestRemoveCategory(t *testing.T) {
 	})
 
 	t.Run("batch remove with partial failure", func(t *testing.T) {
+		chdirTempRepo(t)
+
 		server := &Server{
 			loader: policy.NewLoader(false),
 			userPolicy: &schema.UserPolicy{
@@ -616,3 +638,82 @@ func TestListCategory(t *testing.T) {
 		assert.Contains(t, text, "Available categories (2)")
 	})
 }
+
+// llmValidatorProvider routes every rule to llm-validator.
+type llmValidatorProvider struct{}
+
+func (llmValidatorProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	return `{"linters": [], "reason": "needs semantic review"}`, nil
+}
+
+func (llmValidatorProvider) Name() string { return "llm-validator" }
+func (llmValidatorProvider) Close() error { return nil }
+
+func TestNeedsConversion_ResolvesExtends(t *testing.T) {
+	tmpDir := t.TempDir()
+	symDir := filepath.Join(tmpDir, ".sym")
+	baseDir := filepath.Join(tmpDir, "base")
+	require.NoError(t, os.MkdirAll(symDir, 0755))
+	require.NoError(t, os.MkdirAll(baseDir, 0755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(baseDir, "user-policy.json"), []byte(`{
+  "version": "1.0.0",
+  "rules": [{"id": "BASE-1", "say": "No hardcoded secrets"}]
+}`), 0644))
+	userPolicyPath := filepath.Join(symDir, "user-policy.json")
+	require.NoError(t, os.WriteFile(userPolicyPath, []byte(`{
+  "version": "1.0.0",
+  "extends": ["../base"],
+  "rules": [{"id": "R1", "say": "Handlers must log errors"}]
+}`), 0644))
+
+	loader := policy.NewLoader(false)
+	resolved, err := loader.ResolveUserPolicy(userPolicyPath)
+	require.NoError(t, err)
+	require.Len(t, resolved.Rules, 2)
+
+	conv := converter.NewConverter(llmValidatorProvider{}, symDir)
+	result, err := conv.Convert(context.Background(), resolved)
+	require.NoError(t, err)
+
+	// The server keeps the raw policy (with extends) for editing and saving
+	userPolicy, err := loader.LoadUserPolicy(userPolicyPath)
+	require.NoError(t, err)
+	server := &Server{loader: loader, userPolicy: userPolicy, codePolicy: result.CodePolicy}
+
+	codePolicyPath := filepath.Join(symDir, "code-policy.json")
+	assert.False(t, server.needsConversion(userPolicyPath, codePolicyPath), "inherited rules are not changes")
+
+	// Editing an inherited rule is a change
+	require.NoError(t, os.WriteFile(filepath.Join(baseDir, "user-policy.json"), []byte(`{
+  "version": "1.0.0",
+  "rules": [{"id": "BASE-1", "say": "No hardcoded secrets or tokens"}]
+}`), 0644))
+	assert.True(t, server.needsConversion(userPolicyPath, codePolicyPath))
+}
+
+func TestAddConvention_RejectsUnknownEngines(t *testing.T) {
+	chdirTempRepo(t)
+
+	server := &Server{
+		loader:     policy.NewLoader(false),
+		userPolicy: &schema.UserPolicy{Version: "1.0.0"},
+	}
+
+	input := AddConventionInput{
+		Conventions: []ConventionInput{
+			{ID: "TYPO", Say: "No var", Engines: []string{"eslnt"}},
+			{ID: "LLM", Say: "Handlers must log errors", Engines: []string{"llm-validator"}},
+		},
+	}
+	result, rpcErr := server.handleAddConvention(input)
+	require.Nil(t, rpcErr)
+
+	resultMap := result.(map[string]interface{})
+	content := resultMap["content"].([]map[string]interface{})
+	text := content[0]["text"].(string)
+
+	assert.Contains(t, text, "TYPO: unsupported linter: eslnt")
+	require.Len(t, server.userPolicy.Rules, 1)
+	assert.Equal(t, "LLM", server.userPolicy.Rules[0].ID)
+}
@@ -0,0 +1,208 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// ChangeKind is the kind of change of a rule or category between two policies.
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// FieldChange is a changed field of a rule or category.
+// Values are rendered as text (strings as-is, other values as compact JSON).
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// RuleChange is an added, removed or changed rule.
+type RuleChange struct {
+	ID     string        `json:"id"`
+	Kind   ChangeKind    `json:"kind"`
+	Say    string        `json:"say,omitempty"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// CategoryChange is an added, removed or changed category.
+type CategoryChange struct {
+	Name   string        `json:"name"`
+	Kind   ChangeKind    `json:"kind"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// PolicyDiff is the semantic difference between two user policies.
+type PolicyDiff struct {
+	Rules      []RuleChange     `json:"rules"`
+	Categories []CategoryChange `json:"categories"`
+}
+
+// IsEmpty reports whether the policies have the same rules and categories.
+func (d *PolicyDiff) IsEmpty() bool {
+	return len(d.Rules) == 0 && len(d.Categories) == 0
+}
+
+// Summary returns a one-line count of rule and category changes, e.g. "rules +1 ~2 -0".
+func (d *PolicyDiff) Summary() string {
+	count := func(kinds []ChangeKind) string {
+		n := map[ChangeKind]int{}
+		for _, k := range kinds {
+			n[k]++
+		}
+		return fmt.Sprintf("+%d ~%d -%d", n[ChangeAdded], n[ChangeChanged], n[ChangeRemoved])
+	}
+
+	ruleKinds := make([]ChangeKind, 0, len(d.Rules))
+	for _, r := range d.Rules {
+		ruleKinds = append(ruleKinds, r.Kind)
+	}
+	summary := "rules " + count(ruleKinds)
+
+	if len(d.Categories) > 0 {
+		catKinds := make([]ChangeKind, 0, len(d.Categories))
+		for _, c := range d.Categories {
+			catKinds = append(catKinds, c.Kind)
+		}
+		summary += ", categories " + count(catKinds)
+	}
+	return summary
+}
+
+// DiffUserPolicies compares rules (by ID) and categories (by name) of two user policies.
+// Changes are ordered like the new policy, followed by removals in old-policy order.
+// A nil policy is treated as empty.
+func DiffUserPolicies(oldPolicy, newPolicy *schema.UserPolicy) *PolicyDiff {
+	if oldPolicy == nil {
+		oldPolicy = &schema.UserPolicy{}
+	}
+	if newPolicy == nil {
+		newPolicy = &schema.UserPolicy{}
+	}
+
+	diff := &PolicyDiff{
+		Rules:      []RuleChange{},
+		Categories: []CategoryChange{},
+	}
+
+	// Rules
+	oldRules := make(map[string]schema.UserRule, len(oldPolicy.Rules))
+	for _, rule := range oldPolicy.Rules {
+		oldRules[rule.ID] = rule
+	}
+	newIDs := make(map[string]bool, len(newPolicy.Rules))
+	for _, rule := range newPolicy.Rules {
+		newIDs[rule.ID] = true
+		old, ok := oldRules[rule.ID]
+		if !ok {
+			diff.Rules = append(diff.Rules, RuleChange{ID: rule.ID, Kind: ChangeAdded, Say: rule.Say})
+			continue
+		}
+		if fields := diffFields(old, rule); len(fields) > 0 {
+			diff.Rules = append(diff.Rules, RuleChange{ID: rule.ID, Kind: ChangeChanged, Say: rule.Say, Fields: fields})
+		}
+	}
+	for _, rule := range oldPolicy.Rules {
+		if !newIDs[rule.ID] {
+			diff.Rules = append(diff.Rules, RuleChange{ID: rule.ID, Kind: ChangeRemoved, Say: rule.Say})
+		}
+	}
+
+	// Categories
+	oldCats := make(map[string]schema.CategoryDef, len(oldPolicy.Category))
+	for _, cat := range oldPolicy.Category {
+		oldCats[cat.Name] = cat
+	}
+	newCats := make(map[string]bool, len(newPolicy.Category))
+	for _, cat := range newPolicy.Category {
+		newCats[cat.Name] = true
+		old, ok := oldCats[cat.Name]
+		if !ok {
+			diff.Categories = append(diff.Categories, CategoryChange{Name: cat.Name, Kind: ChangeAdded})
+			continue
+		}
+		if fields := diffFields(old, cat); len(fields) > 0 {
+			diff.Categories = append(diff.Categories, CategoryChange{Name: cat.Name, Kind: ChangeChanged, Fields: fields})
+		}
+	}
+	for _, cat := range oldPolicy.Category {
+		if !newCats[cat.Name] {
+			diff.Categories = append(diff.Categories, CategoryChange{Name: cat.Name, Kind: ChangeRemoved})
+		}
+	}
+
+	return diff
+}
+
+// diffFields compares two values field by field using their JSON field names,
+// so new schema fields are covered without changes here. Fields are sorted by name.
+func diffFields(oldValue, newValue any) []FieldChange {
+	oldFields := toFieldMap(oldValue)
+	newFields := toFieldMap(newValue)
+
+	names := make(map[string]bool)
+	for name := range oldFields {
+		names[name] = true
+	}
+	for name := range newFields {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var changes []FieldChange
+	for _, name := range sorted {
+		oldField, newField := oldFields[name], newFields[name]
+		if reflect.DeepEqual(oldField, newField) {
+			continue
+		}
+		changes = append(changes, FieldChange{
+			Field: name,
+			Old:   formatFieldValue(oldField),
+			New:   formatFieldValue(newField),
+		})
+	}
+	return changes
+}
+
+// toFieldMap converts a struct to its JSON object form.
+func toFieldMap(value any) map[string]any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// formatFieldValue renders a JSON value for display.
+func formatFieldValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return strings.TrimSpace(string(data))
+	}
+}
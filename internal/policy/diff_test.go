@@ -0,0 +1,64 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffUserPolicies(t *testing.T) {
+	oldPolicy := &schema.UserPolicy{
+		Category: []schema.CategoryDef{
+			{Name: "style", Description: "old"},
+			{Name: "legacy", Description: "gone"},
+		},
+		Rules: []schema.UserRule{
+			{ID: "A", Say: "a", Severity: "warning"},
+			{ID: "B", Say: "b"},
+			{ID: "C", Say: "c", Languages: []string{"go"}},
+		},
+	}
+	newPolicy := &schema.UserPolicy{
+		Category: []schema.CategoryDef{
+			{Name: "style", Description: "new"},
+			{Name: "security"},
+		},
+		Rules: []schema.UserRule{
+			{ID: "A", Say: "a", Severity: "error", Params: map[string]any{"max": 3}},
+			{ID: "C", Say: "c", Languages: []string{"go"}},
+			{ID: "D", Say: "d"},
+		},
+	}
+
+	diff := DiffUserPolicies(oldPolicy, newPolicy)
+
+	require.Len(t, diff.Rules, 3)
+	assert.Equal(t, RuleChange{ID: "A", Kind: ChangeChanged, Say: "a", Fields: []FieldChange{
+		{Field: "params", New: `{"max":3}`},
+		{Field: "severity", Old: "warning", New: "error"},
+	}}, diff.Rules[0])
+	assert.Equal(t, RuleChange{ID: "D", Kind: ChangeAdded, Say: "d"}, diff.Rules[1])
+	assert.Equal(t, RuleChange{ID: "B", Kind: ChangeRemoved, Say: "b"}, diff.Rules[2])
+
+	assert.Equal(t, []CategoryChange{
+		{Name: "style", Kind: ChangeChanged, Fields: []FieldChange{{Field: "description", Old: "old", New: "new"}}},
+		{Name: "security", Kind: ChangeAdded},
+		{Name: "legacy", Kind: ChangeRemoved},
+	}, diff.Categories)
+
+	assert.Equal(t, "rules +1 ~1 -1, categories +1 ~1 -1", diff.Summary())
+}
+
+func TestDiffUserPolicies_NilAndEqual(t *testing.T) {
+	p := &schema.UserPolicy{Rules: []schema.UserRule{{ID: "A", Say: "a"}}}
+
+	diff := DiffUserPolicies(nil, p)
+	require.Len(t, diff.Rules, 1)
+	assert.Equal(t, ChangeAdded, diff.Rules[0].Kind)
+
+	diff = DiffUserPolicies(p, p)
+	assert.True(t, diff.IsEmpty())
+	assert.Equal(t, "rules +0 ~0 -0", diff.Summary())
+}
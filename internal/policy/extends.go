@@ -0,0 +1,242 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// ResolveUserPolicy loads a user policy and merges every policy it extends.
+//
+// Extends entries are paths relative to the declaring file; a directory (e.g. a
+// vendored git subtree) resolves to its user-policy.json or .sym/user-policy.json.
+// Parents are merged in order, then the child is merged on top (see MergeUserPolicies).
+// The returned policy has no Extends or Disable entries. A cycle is an error, and
+// so is a Disable entry that drops nothing: a policy without extends, or a rule
+// ID none of the extended policies define.
+func (l *Loader) ResolveUserPolicy(path string) (*schema.UserPolicy, error) {
+	return l.resolveUserPolicy(path, nil)
+}
+
+func (l *Loader) resolveUserPolicy(path string, chain []string) (*schema.UserPolicy, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve policy path: %w", err)
+	}
+
+	for i, p := range chain {
+		if p == absPath {
+			cycle := append(append([]string{}, chain[i:]...), absPath)
+			return nil, fmt.Errorf("policy extends cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	chain = append(chain, absPath)
+
+	child, err := l.LoadUserPolicy(absPath)
+	if err != nil {
+		if len(chain) > 1 {
+			return nil, fmt.Errorf("%s: %w", absPath, err)
+		}
+		return nil, err
+	}
+	if len(child.Extends) == 0 {
+		if len(child.Disable) > 0 {
+			return nil, fmt.Errorf("%s: disable has no effect without extends: %s", absPath, strings.Join(child.Disable, ", "))
+		}
+		return child, nil
+	}
+
+	var base *schema.UserPolicy
+	for _, ref := range child.Extends {
+		parentPath, err := resolveExtendsPath(filepath.Dir(absPath), ref)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", absPath, err)
+		}
+		parent, err := l.resolveUserPolicy(parentPath, chain)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			base = parent
+		} else {
+			base = MergeUserPolicies(base, parent)
+		}
+	}
+
+	if unknown := unknownRuleIDs(base, child.Disable); len(unknown) > 0 {
+		return nil, fmt.Errorf("%s: disable lists rules no extended policy defines: %s", absPath, strings.Join(unknown, ", "))
+	}
+
+	return MergeUserPolicies(base, child), nil
+}
+
+// unknownRuleIDs returns the IDs in ids that no rule of p has, in order.
+func unknownRuleIDs(p *schema.UserPolicy, ids []string) []string {
+	known := make(map[string]bool, len(p.Rules))
+	for _, rule := range p.Rules {
+		known[rule.ID] = true
+	}
+	var unknown []string
+	for _, id := range ids {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	return unknown
+}
+
+// MergeUserPolicies returns child merged on top of parent:
+//   - rules: child rules replace parent rules with the same ID in place, new rules are appended;
+//     parent rules listed in child.Disable are dropped
+//   - categories: merged by name, a non-empty child description wins
+//   - RBAC roles: merged by name, child roles win
+//   - defaults: child values win, languages are combined
+//   - version: child version wins when set
+//
+// Neither input is modified. The result has no Extends or Disable entries.
+func MergeUserPolicies(parent, child *schema.UserPolicy) *schema.UserPolicy {
+	merged := &schema.UserPolicy{
+		Version: parent.Version,
+		Rules:   []schema.UserRule{},
+	}
+	if child.Version != "" {
+		merged.Version = child.Version
+	}
+
+	// Rules
+	disabled := make(map[string]bool, len(child.Disable))
+	for _, id := range child.Disable {
+		disabled[id] = true
+	}
+	index := make(map[string]int)
+	for _, rule := range parent.Rules {
+		if disabled[rule.ID] {
+			continue
+		}
+		index[rule.ID] = len(merged.Rules)
+		merged.Rules = append(merged.Rules, rule)
+	}
+	for _, rule := range child.Rules {
+		if i, ok := index[rule.ID]; ok {
+			merged.Rules[i] = rule
+			continue
+		}
+		index[rule.ID] = len(merged.Rules)
+		merged.Rules = append(merged.Rules, rule)
+	}
+
+	// Categories
+	catIndex := make(map[string]int)
+	for _, cats := range [][]schema.CategoryDef{parent.Category, child.Category} {
+		for _, cat := range cats {
+			if i, ok := catIndex[cat.Name]; ok {
+				if cat.Description != "" {
+					merged.Category[i].Description = cat.Description
+				}
+				continue
+			}
+			catIndex[cat.Name] = len(merged.Category)
+			merged.Category = append(merged.Category, cat)
+		}
+	}
+
+	// RBAC
+	if parent.RBAC != nil || child.RBAC != nil {
+		merged.RBAC = &schema.UserRBAC{Roles: make(map[string]schema.UserRole)}
+		for _, rbac := range []*schema.UserRBAC{parent.RBAC, child.RBAC} {
+			if rbac == nil {
+				continue
+			}
+			for name, role := range rbac.Roles {
+				merged.RBAC.Roles[name] = role
+			}
+		}
+	}
+
+	merged.Defaults = mergeDefaults(parent.Defaults, child.Defaults)
+
+	return merged
+}
+
+// mergeDefaults merges child defaults on top of parent defaults.
+func mergeDefaults(parent, child *schema.UserDefaults) *schema.UserDefaults {
+	if parent == nil && child == nil {
+		return nil
+	}
+
+	merged := &schema.UserDefaults{}
+	if parent != nil {
+		*merged = *parent
+		merged.Languages = append([]string{}, parent.Languages...)
+	}
+	if child == nil {
+		return merged
+	}
+
+	for _, lang := range child.Languages {
+		if !containsString(merged.Languages, lang) {
+			merged.Languages = append(merged.Languages, lang)
+		}
+	}
+	if child.DefaultLanguage != "" {
+		merged.DefaultLanguage = child.DefaultLanguage
+	}
+	if len(child.Include) > 0 {
+		merged.Include = child.Include
+	}
+	if len(child.Exclude) > 0 {
+		merged.Exclude = child.Exclude
+	}
+	if child.Severity != "" {
+		merged.Severity = child.Severity
+	}
+	if child.Autofix {
+		merged.Autofix = true
+	}
+	if child.MergeProjectConfig {
+		merged.MergeProjectConfig = true
+	}
+	if child.Execution != nil {
+		merged.Execution = child.Execution
+	}
+	return merged
+}
+
+// resolveExtendsPath resolves an extends entry against the declaring file's directory.
+func resolveExtendsPath(baseDir, ref string) (string, error) {
+	path := ref
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, filepath.FromSlash(ref))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("extends %q: %w", ref, err)
+	}
+	if !info.IsDir() {
+		return path, nil
+	}
+
+	for _, candidate := range []string{
+		filepath.Join(path, "user-policy.json"),
+		filepath.Join(path, ".sym", "user-policy.json"),
+	} {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("extends %q: no user-policy.json in directory", ref)
+}
+
+// containsString reports whether s is in list.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
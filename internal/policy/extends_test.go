@@ -0,0 +1,123 @@
+package policy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeUserPolicy(t *testing.T, path string, p schema.UserPolicy) {
+	t.Helper()
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+}
+
+func ruleIDs(p *schema.UserPolicy) []string {
+	ids := make([]string, 0, len(p.Rules))
+	for _, r := range p.Rules {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestResolveUserPolicy(t *testing.T) {
+	root := t.TempDir()
+
+	// Org pack vendored as a git subtree: resolved through its directory
+	writeUserPolicy(t, filepath.Join(root, "vendor", "org", "user-policy.json"), schema.UserPolicy{
+		Version:  "1.0.0",
+		Category: []schema.CategoryDef{{Name: "security", Description: "org security"}, {Name: "style", Description: "org style"}},
+		Defaults: &schema.UserDefaults{Languages: []string{"go"}, Severity: "warning"},
+		Rules: []schema.UserRule{
+			{ID: "SEC-1", Say: "no secrets"},
+			{ID: "STY-1", Say: "tabs"},
+			{ID: "STY-2", Say: "max 120 columns"},
+		},
+	})
+	writeUserPolicy(t, filepath.Join(root, "shared", "team.json"), schema.UserPolicy{
+		Rules: []schema.UserRule{{ID: "TEAM-1", Say: "use context"}},
+	})
+	childPath := filepath.Join(root, ".sym", "user-policy.json")
+	writeUserPolicy(t, childPath, schema.UserPolicy{
+		Version:  "2.0.0",
+		Extends:  []string{"../vendor/org", "../shared/team.json"},
+		Disable:  []string{"STY-2"},
+		Category: []schema.CategoryDef{{Name: "style", Description: "our style"}, {Name: "testing"}},
+		Defaults: &schema.UserDefaults{Languages: []string{"typescript"}},
+		Rules: []schema.UserRule{
+			{ID: "STY-1", Say: "spaces"},
+			{ID: "LOCAL-1", Say: "local rule"},
+		},
+	})
+
+	resolved, err := NewLoader(false).ResolveUserPolicy(childPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.0.0", resolved.Version)
+	assert.Empty(t, resolved.Extends)
+	assert.Empty(t, resolved.Disable)
+	assert.Equal(t, []string{"SEC-1", "STY-1", "TEAM-1", "LOCAL-1"}, ruleIDs(resolved))
+	assert.Equal(t, "spaces", resolved.Rules[1].Say)
+	assert.Equal(t, []schema.CategoryDef{
+		{Name: "security", Description: "org security"},
+		{Name: "style", Description: "our style"},
+		{Name: "testing"},
+	}, resolved.Category)
+	assert.Equal(t, []string{"go", "typescript"}, resolved.Defaults.Languages)
+	assert.Equal(t, "warning", resolved.Defaults.Severity)
+}
+
+func TestResolveUserPolicy_Cycle(t *testing.T) {
+	root := t.TempDir()
+	writeUserPolicy(t, filepath.Join(root, "a.json"), schema.UserPolicy{Extends: []string{"b.json"}})
+	writeUserPolicy(t, filepath.Join(root, "b.json"), schema.UserPolicy{Extends: []string{"a.json"}})
+
+	_, err := NewLoader(false).ResolveUserPolicy(filepath.Join(root, "a.json"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy extends cycle")
+}
+
+func TestResolveUserPolicy_Diamond(t *testing.T) {
+	root := t.TempDir()
+	writeUserPolicy(t, filepath.Join(root, "base.json"), schema.UserPolicy{Rules: []schema.UserRule{{ID: "B", Say: "base"}}})
+	writeUserPolicy(t, filepath.Join(root, "left.json"), schema.UserPolicy{Extends: []string{"base.json"}})
+	writeUserPolicy(t, filepath.Join(root, "right.json"), schema.UserPolicy{Extends: []string{"base.json"}})
+	writeUserPolicy(t, filepath.Join(root, "top.json"), schema.UserPolicy{Extends: []string{"left.json", "right.json"}})
+
+	resolved, err := NewLoader(false).ResolveUserPolicy(filepath.Join(root, "top.json"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"B"}, ruleIDs(resolved))
+}
+
+func TestResolveUserPolicy_MissingParent(t *testing.T) {
+	root := t.TempDir()
+	writeUserPolicy(t, filepath.Join(root, "child.json"), schema.UserPolicy{Extends: []string{"missing"}})
+
+	_, err := NewLoader(false).ResolveUserPolicy(filepath.Join(root, "child.json"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `extends "missing"`)
+}
+
+func TestResolveUserPolicy_UnusedDisable(t *testing.T) {
+	root := t.TempDir()
+	writeUserPolicy(t, filepath.Join(root, "base.json"), schema.UserPolicy{Rules: []schema.UserRule{{ID: "B", Say: "base"}}})
+
+	// Without extends, disable entries have nothing to drop
+	writeUserPolicy(t, filepath.Join(root, "alone.json"), schema.UserPolicy{Disable: []string{"B", "C"}})
+	_, err := NewLoader(false).ResolveUserPolicy(filepath.Join(root, "alone.json"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disable has no effect without extends: B, C")
+
+	// IDs the extended policies do not define are reported too
+	writeUserPolicy(t, filepath.Join(root, "child.json"), schema.UserPolicy{Extends: []string{"base.json"}, Disable: []string{"B", "TYPO"}})
+	_, err = NewLoader(false).ResolveUserPolicy(filepath.Join(root, "child.json"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disable lists rules no extended policy defines: TYPO")
+}
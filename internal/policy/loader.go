@@ -24,6 +24,12 @@ func (l *Loader) LoadUserPolicy(path string) (*schema.UserPolicy, error) {
 		return nil, fmt.Errorf("failed to read policy file: %w", err)
 	}
 
+	return ParseUserPolicy(data)
+}
+
+// ParseUserPolicy parses user-friendly policy (A schema) content,
+// e.g. a user-policy.json read from a git revision
+func ParseUserPolicy(data []byte) (*schema.UserPolicy, error) {
 	var policy schema.UserPolicy
 	if err := json.Unmarshal(data, &policy); err != nil {
 		return nil, fmt.Errorf("failed to parse policy: %w", err)
@@ -108,6 +108,17 @@ func ValidatePolicy(policy *schema.UserPolicy) error {
 		return fmt.Errorf("policy version is required")
 	}
 
+	for i, ref := range policy.Extends {
+		if ref == "" {
+			return fmt.Errorf("extends %d: path cannot be empty", i+1)
+		}
+	}
+	for i, id := range policy.Disable {
+		if id == "" {
+			return fmt.Errorf("disable %d: rule id cannot be empty", i+1)
+		}
+	}
+
 	// Validate rules
 	ruleIDs := make(map[string]bool)
 	for i, rule := range policy.Rules {
@@ -121,6 +132,9 @@ func ValidatePolicy(policy *schema.UserPolicy) error {
 			return fmt.Errorf("duplicate rule id: %s", rule.ID)
 		}
 		ruleIDs[rule.ID] = true
+		if err := validateRouting(rule); err != nil {
+			return fmt.Errorf("rule %s: %w", rule.ID, err)
+		}
 	}
 
 	// Validate RBAC roles
@@ -147,6 +161,47 @@ func ValidatePolicy(policy *schema.UserPolicy) error {
 	return nil
 }
 
+// validateRouting checks a rule's routing overrides (engines, native, noLlm).
+func validateRouting(rule schema.UserRule) error {
+	for _, engine := range rule.Engines {
+		if engine == "" {
+			return fmt.Errorf("engine name cannot be empty")
+		}
+		if engine == "llm-validator" && rule.NoLLM {
+			return fmt.Errorf("llm-validator is pinned but noLlm is set")
+		}
+	}
+	for engine := range rule.Native {
+		if engine == "" {
+			return fmt.Errorf("native engine name cannot be empty")
+		}
+		if engine == "llm-validator" {
+			return fmt.Errorf("llm-validator has no native config")
+		}
+	}
+	return nil
+}
+
+// MergeRuleMap applies edits to a map field of a rule (native, params): nil
+// values remove a key. Returns nil when no key is left.
+func MergeRuleMap(current, edits map[string]any) map[string]any {
+	merged := make(map[string]any, len(current)+len(edits))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range edits {
+		if value == nil {
+			delete(merged, key)
+		} else {
+			merged[key] = value
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
 // PolicyExists checks if the policy file exists
 func PolicyExists(customPath string) (bool, error) {
 	policyPath, err := GetPolicyPath(customPath)
@@ -0,0 +1,142 @@
+package policy
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Package is a directory that has its own .sym configuration.
+// In a monorepo each package (e.g. services/api, web) can define its own
+// conventions; files belong to the package of their nearest .sym ancestor.
+type Package struct {
+	// Dir is the package directory relative to the repository root, slash-separated ("." for the root)
+	Dir string
+
+	// SymDir is the absolute path of the package's .sym directory
+	SymDir string
+}
+
+// UserPolicyPath returns the package's user-policy.json path.
+func (p Package) UserPolicyPath() string {
+	return filepath.Join(p.SymDir, "user-policy.json")
+}
+
+// CodePolicyPath returns the package's code-policy.json path.
+func (p Package) CodePolicyPath() string {
+	return filepath.Join(p.SymDir, "code-policy.json")
+}
+
+// HasUserPolicy reports whether the package has a user-policy.json.
+func (p Package) HasUserPolicy() bool {
+	_, err := os.Stat(p.UserPolicyPath())
+	return err == nil
+}
+
+// skippedDirs are never searched for nested .sym directories.
+var skippedDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// FindPackages returns every directory under repoRoot whose .sym directory
+// contains a user-policy.json or code-policy.json, sorted by Dir.
+// Hidden directories and dependency directories (node_modules, vendor) are skipped.
+func FindPackages(repoRoot string) ([]Package, error) {
+	var packages []Package
+
+	err := filepath.WalkDir(repoRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		name := d.Name()
+		if path != repoRoot && (skippedDirs[name] || strings.HasPrefix(name, ".")) {
+			return filepath.SkipDir
+		}
+
+		symDir := filepath.Join(path, ".sym")
+		if !hasPolicyFile(symDir) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(repoRoot, path)
+		if err != nil {
+			return err
+		}
+		packages = append(packages, Package{
+			Dir:    filepath.ToSlash(rel),
+			SymDir: symDir,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].Dir < packages[j].Dir
+	})
+	return packages, nil
+}
+
+// FindPackage returns the package whose directory is dir (relative to the repository root).
+func FindPackage(packages []Package, dir string) (Package, bool) {
+	dir = normalizePackageDir(dir)
+	for _, pkg := range packages {
+		if pkg.Dir == dir {
+			return pkg, true
+		}
+	}
+	return Package{}, false
+}
+
+// NearestPackage returns the package of the nearest .sym ancestor of filePath.
+// filePath is relative to the repository root, as reported by git.
+func NearestPackage(packages []Package, filePath string) (Package, bool) {
+	filePath = filepath.ToSlash(filepath.Clean(filePath))
+
+	var best Package
+	bestDepth := -1
+	for _, pkg := range packages {
+		depth := 0
+		if pkg.Dir != "." {
+			if !strings.HasPrefix(filePath, pkg.Dir+"/") {
+				continue
+			}
+			depth = strings.Count(pkg.Dir, "/") + 1
+		}
+		if depth > bestDepth {
+			best = pkg
+			bestDepth = depth
+		}
+	}
+	return best, bestDepth >= 0
+}
+
+// hasPolicyFile reports whether symDir contains a user or code policy.
+func hasPolicyFile(symDir string) bool {
+	for _, name := range []string{"user-policy.json", "code-policy.json"} {
+		if _, err := os.Stat(filepath.Join(symDir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizePackageDir converts a user-supplied package directory to the Dir form.
+func normalizePackageDir(dir string) string {
+	dir = filepath.ToSlash(filepath.Clean(dir))
+	dir = strings.TrimPrefix(dir, "./")
+	dir = strings.TrimSuffix(dir, "/")
+	if dir == "" {
+		return "."
+	}
+	return dir
+}
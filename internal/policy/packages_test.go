@@ -0,0 +1,64 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindPackages(t *testing.T) {
+	root := t.TempDir()
+	for _, path := range []string{
+		".sym/code-policy.json",
+		"services/api/.sym/user-policy.json",
+		"web/.sym/code-policy.json",
+		"web/node_modules/dep/.sym/code-policy.json",
+		"tools/.sym/config.json", // no policy file
+	} {
+		full := filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		require.NoError(t, os.WriteFile(full, []byte("{}"), 0o644))
+	}
+
+	packages, err := FindPackages(root)
+	require.NoError(t, err)
+
+	var dirs []string
+	for _, pkg := range packages {
+		dirs = append(dirs, pkg.Dir)
+	}
+	assert.Equal(t, []string{".", "services/api", "web"}, dirs)
+
+	api, ok := FindPackage(packages, "./services/api/")
+	require.True(t, ok)
+	assert.True(t, api.HasUserPolicy())
+	assert.Equal(t, filepath.Join(root, "services", "api", ".sym", "code-policy.json"), api.CodePolicyPath())
+}
+
+func TestNearestPackage(t *testing.T) {
+	packages := []Package{{Dir: "."}, {Dir: "services"}, {Dir: "services/api"}}
+
+	tests := []struct {
+		file     string
+		expected string
+	}{
+		{"main.go", "."},
+		{"services/worker/main.go", "services"},
+		{"services/api/handler/user.go", "services/api"},
+		{"services/apigw/main.go", "services"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			pkg, ok := NearestPackage(packages, tt.file)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, pkg.Dir)
+		})
+	}
+
+	_, ok := NearestPackage([]Package{{Dir: "web"}}, "services/api/main.go")
+	assert.False(t, ok)
+}
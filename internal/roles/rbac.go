@@ -38,9 +38,9 @@ func LoadUserPolicyFromRepo() (*schema.UserPolicy, error) {
 		return nil, fmt.Errorf("user-policy.json not found at %s. Run 'sym init' to create it", policyPath)
 	}
 
-	// Use existing loader
+	// Use existing loader; inherited (extends) policies are merged
 	loader := policy.NewLoader(false)
-	return loader.LoadUserPolicy(policyPath)
+	return loader.ResolveUserPolicy(policyPath)
 }
 
 // matchPattern checks if a file path matches a glob pattern
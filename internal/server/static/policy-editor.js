@@ -792,6 +792,14 @@ function renderRules() {
         textarea.addEventListener('input', handleRuleUpdate);
     });
 
+    document.querySelectorAll('.engines-input, .native-input').forEach(input => {
+        input.addEventListener('input', handleRuleUpdate);
+    });
+
+    document.querySelectorAll('.no-llm-input').forEach(checkbox => {
+        checkbox.addEventListener('change', handleRuleUpdate);
+    });
+
     // Apply permissions to dynamically rendered elements
     if (appState.currentUser?.permissions) {
         applyPermissions();
@@ -844,6 +852,23 @@ function createRuleElement(rule, index) {
                         <label class="block text-sm font-medium text-slate-600 mb-1">예시 코드 (선택사항)</label>
                         <textarea class="example-input w-full px-3 py-2 bg-gray-50 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-blue-500 font-mono" rows="4" placeholder="// 좋은 예:\nconst userName = 'John';\n\n// 나쁜 예:\nconst user_name = 'John';" data-rule-id="${rule.id}">${rule.example || ''}</textarea>
                     </div>
+                    <details class="routing-details">
+                        <summary class="text-sm font-medium text-slate-600 cursor-pointer">라우팅 설정 (선택사항)</summary>
+                        <div class="mt-3 space-y-4">
+                            <div class="form-group">
+                                <label class="block text-sm font-medium text-slate-600 mb-1">고정 엔진 (쉼표로 구분)</label>
+                                <input type="text" value="${(rule.engines || []).join(', ')}" placeholder="예: eslint, semgrep" class="engines-input w-full px-3 py-2 bg-gray-50 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-blue-500" data-rule-id="${rule.id}">
+                            </div>
+                            <div class="form-group">
+                                <label class="block text-sm font-medium text-slate-600 mb-1">네이티브 설정 (JSON, LLM 변환 없이 그대로 사용)</label>
+                                <textarea class="native-input w-full px-3 py-2 bg-gray-50 border border-gray-300 rounded-md text-sm focus:ring-2 focus:ring-blue-500 font-mono" rows="3" placeholder='{"eslint": {"no-console": "error"}}' data-rule-id="${rule.id}">${rule.native ? JSON.stringify(rule.native, null, 2) : ''}</textarea>
+                            </div>
+                            <label class="flex items-center text-sm text-slate-600">
+                                <input type="checkbox" class="no-llm-input mr-2" data-rule-id="${rule.id}" ${rule.noLlm ? 'checked' : ''}>
+                                LLM 검증 사용 안함 (llm-validator로 대체하지 않음)
+                            </label>
+                        </div>
+                    </details>
                 </div>
             </div>
         </details>
@@ -878,6 +903,29 @@ function handleRuleUpdate(e) {
         }
     } else if (e.target.classList.contains('example-input')) {
         rule.example = e.target.value.trim() || undefined; // Store undefined if empty
+    } else if (e.target.classList.contains('engines-input')) {
+        const engines = e.target.value.split(',').map(engine => engine.trim()).filter(Boolean);
+        rule.engines = engines.length > 0 ? engines : undefined;
+    } else if (e.target.classList.contains('native-input')) {
+        const text = e.target.value.trim();
+        if (!text) {
+            rule.native = undefined;
+        } else {
+            let native;
+            try {
+                native = JSON.parse(text);
+            } catch {
+                native = null;
+            }
+            // Keep the last valid config until the JSON parses as an object
+            const valid = native !== null && typeof native === 'object' && !Array.isArray(native);
+            e.target.classList.toggle('border-red-500', !valid);
+            if (!valid) return;
+            rule.native = native;
+        }
+        e.target.classList.remove('border-red-500');
+    } else if (e.target.classList.contains('no-llm-input')) {
+        rule.noLlm = e.target.checked || undefined;
     }
 
     markDirty();
@@ -1582,7 +1630,7 @@ function applyPermissions() {
         document.querySelectorAll('.delete-rule-btn').forEach(el => el.classList.remove('hidden'));
 
         // Enable rule inputs
-        document.querySelectorAll('.say-input, .category-select, .language-select, .example-input').forEach(el => {
+        document.querySelectorAll('.say-input, .category-select, .language-select, .example-input, .engines-input, .native-input, .no-llm-input').forEach(el => {
             el.disabled = false;
             el.classList.remove('bg-gray-200', 'cursor-not-allowed');
         });
@@ -1644,7 +1692,7 @@ function applyPermissions() {
         document.querySelectorAll('.delete-rule-btn').forEach(el => el.classList.add('hidden'));
 
         // Disable rule inputs
-        document.querySelectorAll('.say-input, .category-select, .language-select, .example-input').forEach(el => {
+        document.querySelectorAll('.say-input, .category-select, .language-select, .example-input, .engines-input, .native-input, .no-llm-input').forEach(el => {
             el.disabled = true;
             el.classList.add('bg-gray-200', 'cursor-not-allowed');
         });
@@ -181,6 +181,66 @@ func GetStagedChanges() ([]Change, error) {
 	return changes, nil
 }
 
+// GetChangesForFiles returns the uncommitted changes for the given files only.
+// Paths are relative to the repository root, as reported by git.
+// Files without changes (e.g. reverted edits) and ignored files are omitted,
+// so callers should treat every requested path absent from the result as clean.
+func GetChangesForFiles(filePaths []string) ([]Change, error) {
+	changes := make([]Change, 0, len(filePaths))
+
+	for _, filePath := range filePaths {
+		// Tracked files (staged or unstaged): compare working tree against HEAD
+		statusCmd := exec.Command("git", "diff", "HEAD", "--name-status", "--", filePath)
+		statusOutput, err := statusCmd.Output()
+		if err != nil {
+			// If there's no HEAD (initial commit), compare against the index
+			statusCmd = exec.Command("git", "diff", "--cached", "--name-status", "--", filePath)
+			statusOutput, err = statusCmd.Output()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get status for %s: %w", filePath, err)
+			}
+		}
+
+		if fields := strings.Fields(strings.TrimSpace(string(statusOutput))); len(fields) >= 2 {
+			diffCmd := exec.Command("git", "diff", "HEAD", "--", filePath)
+			diffOutput, err := diffCmd.Output()
+			if err != nil {
+				diffCmd = exec.Command("git", "diff", "--cached", "--", filePath)
+				diffOutput, _ = diffCmd.Output()
+			}
+
+			changes = append(changes, Change{
+				FilePath: filePath,
+				Status:   fields[0],
+				Diff:     string(diffOutput),
+			})
+			continue
+		}
+
+		// Untracked files: only report them when they are not ignored
+		untrackedCmd := exec.Command("git", "ls-files", "--others", "--exclude-standard", "--", filePath)
+		untrackedOutput, err := untrackedCmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check untracked file %s: %w", filePath, err)
+		}
+		if strings.TrimSpace(string(untrackedOutput)) == "" {
+			continue
+		}
+
+		// git diff --no-index returns exit code 1 when files differ, which is expected
+		diffCmd := exec.Command("git", "diff", "--no-index", "/dev/null", filePath)
+		diffOutput, _ := diffCmd.CombinedOutput()
+
+		changes = append(changes, Change{
+			FilePath: filePath,
+			Status:   "A", // Treat untracked files as Added
+			Diff:     string(diffOutput),
+		})
+	}
+
+	return changes, nil
+}
+
 // ExtractAddedLines extracts only added lines from a diff
 func ExtractAddedLines(diff string) []string {
 	lines := strings.Split(diff, "\n")
@@ -0,0 +1,75 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Commit is a commit in the history of a file.
+type Commit struct {
+	Hash    string
+	Author  string
+	Date    time.Time
+	Subject string
+}
+
+// ResolveRevision returns the commit hash of a revision (branch, tag, HEAD~2, ...).
+func ResolveRevision(rev string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("unknown revision: %s", rev)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// ShowFile returns the content of path (relative to the repository root) at revision rev.
+func ShowFile(rev, path string) ([]byte, error) {
+	cmd := exec.Command("git", "show", rev+":"+path)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, rev, err)
+	}
+	return output, nil
+}
+
+// FileExistsAt reports whether path (relative to the repository root) exists at revision rev.
+func FileExistsAt(rev, path string) bool {
+	cmd := exec.Command("git", "cat-file", "-e", rev+":"+path)
+	return cmd.Run() == nil
+}
+
+// FileHistory returns the commits that changed path, newest first.
+// limit <= 0 returns the full history.
+func FileHistory(path string, limit int) ([]Commit, error) {
+	args := []string{"log", "--format=%H%x1f%an%x1f%aI%x1f%s"}
+	if limit > 0 {
+		args = append(args, "-n", strconv.Itoa(limit))
+	}
+	args = append(args, "--", path)
+
+	cmd := exec.Command("git", args...)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of %s: %w", path, err)
+	}
+
+	var commits []Commit
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, fields[2])
+		commits = append(commits, Commit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Date:    date,
+			Subject: fields[3],
+		})
+	}
+	return commits, nil
+}
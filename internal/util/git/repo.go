@@ -3,6 +3,7 @@ package git
 import (
 	"fmt"
 	"os/exec"
+	"path/filepath"
 	"strings"
 )
 
@@ -17,6 +18,17 @@ func GetRepoRoot() (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
+// IndexPath returns the absolute path of the git index file.
+// It also resolves the index of linked worktrees, where .git is a file.
+func IndexPath() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--git-path", "index")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate git index: %w", err)
+	}
+	return filepath.Abs(strings.TrimSpace(string(output)))
+}
+
 // GetCurrentUser returns the current git user name
 func GetCurrentUser() (string, error) {
 	cmd := exec.Command("git", "config", "--get", "user.name")
@@ -26,3 +38,21 @@ func GetCurrentUser() (string, error) {
 	}
 	return strings.TrimSpace(string(output)), nil
 }
+
+// ListWorkingFiles returns tracked files plus untracked files that are not ignored.
+// Paths are relative to the repository root.
+func ListWorkingFiles() ([]string, error) {
+	cmd := exec.Command("git", "ls-files", "--cached", "--others", "--exclude-standard")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,76 @@
+package source
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GlobRegexp converts a glob into an anchored regexp.
+//
+//   - "*" matches within one path segment, "?" one character
+//   - "**" matches across segments; "**/" also matches no directory
+//   - a trailing "/**" also matches the directory itself
+//   - a glob without wildcards matches the path and everything below it
+func GlobRegexp(glob string) (*regexp.Regexp, error) {
+	glob = strings.TrimSuffix(glob, "/")
+	if !strings.ContainsAny(glob, "*?") {
+		return regexp.Compile("^" + regexp.QuoteMeta(glob) + "(?:/.*)?$")
+	}
+
+	suffix := "$"
+	if rest, ok := strings.CutSuffix(glob, "/**"); ok {
+		glob, suffix = rest, "(?:/.*)?$"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString(suffix)
+	return regexp.Compile(sb.String())
+}
+
+// MatchGlob reports whether a slash-separated path matches the glob.
+func MatchGlob(glob, path string) bool {
+	re, err := GlobRegexp(glob)
+	return err == nil && re.MatchString(path)
+}
+
+// MatchAnyGlob reports whether a slash-separated path matches any glob.
+// A glob without a slash also matches the base name, so "*.go" matches "pkg/a.go".
+func MatchAnyGlob(globs []string, path string) bool {
+	for _, glob := range globs {
+		re, err := GlobRegexp(glob)
+		if err != nil {
+			continue
+		}
+		if re.MatchString(path) {
+			return true
+		}
+		if !strings.Contains(glob, "/") {
+			base := path[strings.LastIndex(path, "/")+1:]
+			if re.MatchString(base) {
+				return true
+			}
+		}
+	}
+	return false
+}
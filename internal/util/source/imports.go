@@ -0,0 +1,133 @@
+package source
+
+import (
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Import is one import statement found in a file.
+type Import struct {
+	Path   string
+	Line   int
+	Column int
+}
+
+// importPatterns extract the imported module from a single line, per language.
+// The first submatch that is not empty is the import path.
+var importPatterns = map[string][]*regexp.Regexp{
+	"javascript": jsImportPatterns,
+	"typescript": jsImportPatterns,
+	"jsx":        jsImportPatterns,
+	"tsx":        jsImportPatterns,
+	"python": {
+		regexp.MustCompile(`^\s*from\s+([\w.]+)\s+import\b`),
+		regexp.MustCompile(`^\s*import\s+([\w.]+(?:\s*,\s*[\w.]+)*)`),
+	},
+	"java":   {regexp.MustCompile(`^\s*import\s+(?:static\s+)?([\w.]+(?:\.\*)?)\s*;`)},
+	"kotlin": {regexp.MustCompile(`^\s*import\s+([\w.]+(?:\.\*)?)`)},
+	"scala":  {regexp.MustCompile(`^\s*import\s+([\w.]+)`)},
+	"rust": {
+		regexp.MustCompile(`^\s*(?:pub(?:\([\w:]+\))?\s+)?use\s+([\w:]+)`),
+		regexp.MustCompile(`^\s*extern\s+crate\s+(\w+)`),
+	},
+	"ruby": {regexp.MustCompile(`^\s*require(?:_relative)?\s*\(?\s*['"]([^'"]+)['"]`)},
+	"php": {
+		regexp.MustCompile(`^\s*use\s+(?:function\s+|const\s+)?\\?([\w\\]+)`),
+		regexp.MustCompile(`^\s*(?:require|include)(?:_once)?\s*\(?\s*['"]([^'"]+)['"]`),
+	},
+	"shell":  {regexp.MustCompile(`^\s*(?:source|\.)\s+['"]?([^'"\s;]+)`)},
+	"c":      cIncludePatterns,
+	"cpp":    cIncludePatterns,
+	"csharp": {regexp.MustCompile(`^\s*using\s+(?:static\s+)?([\w.]+)\s*;`)},
+	"swift":  {regexp.MustCompile(`^\s*import\s+(\w+)`)},
+}
+
+var jsImportPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^\s*(?:import|export)\s[^'"]*?\bfrom\s*['"]([^'"]+)['"]`),
+	regexp.MustCompile(`^\s*import\s*['"]([^'"]+)['"]`),
+	regexp.MustCompile(`\brequire\s*\(\s*['"]([^'"]+)['"]\s*\)`),
+	regexp.MustCompile(`\bimport\s*\(\s*['"]([^'"]+)['"]\s*\)`),
+}
+
+var cIncludePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^\s*#\s*include\s*[<"]([^>"]+)[>"]`),
+}
+
+// Imports returns the imports of a file, in source order. Go files are parsed
+// with go/parser; other languages are scanned line by line.
+func Imports(file string, content []byte) []Import {
+	lang := Language(file)
+	if lang == "go" {
+		return extractGoImports(file, content)
+	}
+
+	patterns := importPatterns[lang]
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	var refs []Import
+	for i, line := range strings.Split(string(content), "\n") {
+		for _, re := range patterns {
+			m := re.FindStringSubmatchIndex(line)
+			if m == nil || m[2] < 0 {
+				continue
+			}
+			// Python allows "import a, b"
+			for _, path := range strings.Split(line[m[2]:m[3]], ",") {
+				path = strings.TrimSpace(path)
+				if path == "" {
+					continue
+				}
+				refs = append(refs, Import{Path: path, Line: i + 1, Column: m[2] + 1})
+			}
+			break
+		}
+	}
+	return refs
+}
+
+// extractGoImports parses the import block of a Go file.
+func extractGoImports(file string, content []byte) []Import {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, content, parser.ImportsOnly)
+	if err != nil && f == nil {
+		return nil
+	}
+
+	refs := make([]Import, 0, len(f.Imports))
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		pos := fset.Position(imp.Path.Pos())
+		refs = append(refs, Import{Path: path, Line: pos.Line, Column: pos.Column})
+	}
+	return refs
+}
+
+// ImportMatches reports whether an import is the module or one of its
+// submodules ("lodash" matches "lodash/fp", "os" matches "os.path").
+// A trailing "*" matches any import with the preceding prefix.
+func ImportMatches(imported, module string) bool {
+	if prefix, ok := strings.CutSuffix(module, "*"); ok {
+		return strings.HasPrefix(imported, prefix)
+	}
+	if imported == module {
+		return true
+	}
+	if !strings.HasPrefix(imported, module) {
+		return false
+	}
+	rest := imported[len(module):]
+	for _, sep := range []string{"/", ".", "::", "\\"} {
+		if strings.HasPrefix(rest, sep) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+// Package source provides language-agnostic helpers for reading source files:
+// language detection, import extraction and path globs.
+package source
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Language determines the language of a file from its name or extension.
+// Returns "" for unknown extensions.
+func Language(path string) string {
+	if isDockerfile(filepath.Base(path)) {
+		return "dockerfile"
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".js", ".mjs", ".cjs":
+		return "javascript"
+	case ".ts", ".mts", ".cts":
+		return "typescript"
+	case ".jsx":
+		return "jsx"
+	case ".tsx":
+		return "tsx"
+	case ".go":
+		return "go"
+	case ".py":
+		return "python"
+	case ".java":
+		return "java"
+	case ".kt", ".kts":
+		return "kotlin"
+	case ".c", ".h":
+		return "c"
+	case ".cpp", ".cc", ".cxx", ".hpp", ".hh", ".hxx":
+		return "cpp"
+	case ".cs":
+		return "csharp"
+	case ".rs":
+		return "rust"
+	case ".rb":
+		return "ruby"
+	case ".php":
+		return "php"
+	case ".sh", ".bash":
+		return "shell"
+	case ".css":
+		return "css"
+	case ".scss":
+		return "scss"
+	case ".swift":
+		return "swift"
+	case ".scala":
+		return "scala"
+	default:
+		return ""
+	}
+}
+
+// extensions maps languages to the canonical extension of their files.
+var extensions = map[string]string{
+	"javascript": ".js",
+	"typescript": ".ts",
+	"jsx":        ".jsx",
+	"tsx":        ".tsx",
+	"go":         ".go",
+	"python":     ".py",
+	"java":       ".java",
+	"kotlin":     ".kt",
+	"c":          ".c",
+	"cpp":        ".cpp",
+	"csharp":     ".cs",
+	"rust":       ".rs",
+	"ruby":       ".rb",
+	"php":        ".php",
+	"shell":      ".sh",
+	"css":        ".css",
+	"scss":       ".scss",
+	"swift":      ".swift",
+	"scala":      ".scala",
+	"dockerfile": ".dockerfile",
+}
+
+// languageAliases maps common short names to language names.
+var languageAliases = map[string]string{
+	"js":   "javascript",
+	"ts":   "typescript",
+	"py":   "python",
+	"kt":   "kotlin",
+	"c++":  "cpp",
+	"c#":   "csharp",
+	"rs":   "rust",
+	"rb":   "ruby",
+	"sh":   "shell",
+	"bash": "shell",
+}
+
+// Extension returns the canonical file extension for a language, so that
+// Language of a file named "x"+Extension(lang) is lang. Common aliases
+// (js, ts, py, bash, ...) are accepted. Returns "" for unknown languages.
+func Extension(language string) string {
+	language = strings.ToLower(language)
+	if alias, ok := languageAliases[language]; ok {
+		language = alias
+	}
+	return extensions[language]
+}
+
+// DetectLanguage is like Language, but falls back to the shebang line for
+// files without an extension (e.g., scripts/deploy).
+func DetectLanguage(path string) string {
+	if lang := Language(path); lang != "" || filepath.Ext(path) != "" {
+		return lang
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = f.Close() }()
+
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && line == "" {
+		return ""
+	}
+	return ShebangLanguage(line)
+}
+
+// ShebangLanguage determines the language from a shebang line such as
+// "#!/bin/bash" or "#!/usr/bin/env -S python3 -u".
+// Returns "" when the line is not a shebang or the interpreter is unknown.
+func ShebangLanguage(line string) string {
+	if !strings.HasPrefix(line, "#!") {
+		return ""
+	}
+
+	fields := strings.Fields(line[2:])
+	if len(fields) == 0 {
+		return ""
+	}
+
+	interpreter := filepath.Base(fields[0])
+	if interpreter == "env" {
+		interpreter = ""
+		for _, arg := range fields[1:] {
+			if strings.HasPrefix(arg, "-") || strings.Contains(arg, "=") {
+				continue
+			}
+			interpreter = filepath.Base(arg)
+			break
+		}
+	}
+
+	switch {
+	case interpreter == "sh", interpreter == "bash", interpreter == "dash",
+		interpreter == "ksh", interpreter == "mksh", interpreter == "ash":
+		return "shell"
+	case strings.HasPrefix(interpreter, "python"):
+		return "python"
+	case interpreter == "node", interpreter == "nodejs":
+		return "javascript"
+	case interpreter == "ruby":
+		return "ruby"
+	case interpreter == "php":
+		return "php"
+	default:
+		return ""
+	}
+}
+
+// isDockerfile reports whether a base name is a Dockerfile:
+// Dockerfile, Dockerfile.<suffix>, <name>.dockerfile or Containerfile.
+func isDockerfile(name string) bool {
+	lower := strings.ToLower(name)
+	return lower == "dockerfile" || lower == "containerfile" ||
+		strings.HasPrefix(lower, "dockerfile.") ||
+		strings.HasSuffix(lower, ".dockerfile")
+}
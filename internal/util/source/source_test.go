@@ -0,0 +1,156 @@
+package source
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguage(t *testing.T) {
+	assert.Equal(t, "go", Language("cmd/main.go"))
+	assert.Equal(t, "typescript", Language("src/App.TS"))
+	assert.Equal(t, "shell", Language("scripts/build.sh"))
+	assert.Equal(t, "", Language("README.md"))
+	assert.Equal(t, "css", Language("web/styles/app.css"))
+	assert.Equal(t, "scss", Language("web/styles/_tokens.scss"))
+	assert.Equal(t, "dockerfile", Language("Dockerfile"))
+	assert.Equal(t, "dockerfile", Language("deploy/Dockerfile.prod"))
+	assert.Equal(t, "dockerfile", Language("api.dockerfile"))
+	assert.Equal(t, "dockerfile", Language("Containerfile"))
+}
+
+func TestExtension(t *testing.T) {
+	for lang := range extensions {
+		assert.Equal(t, lang, Language("example"+Extension(lang)), lang)
+	}
+	assert.Equal(t, ".ts", Extension("TS"))
+	assert.Equal(t, ".sh", Extension("bash"))
+	assert.Equal(t, "", Extension("cobol"))
+}
+
+func TestShebangLanguage(t *testing.T) {
+	assert.Equal(t, "shell", ShebangLanguage("#!/bin/sh\n"))
+	assert.Equal(t, "shell", ShebangLanguage("#!/usr/bin/env bash"))
+	assert.Equal(t, "python", ShebangLanguage("#!/usr/bin/env -S python3 -u"))
+	assert.Equal(t, "javascript", ShebangLanguage("#! /usr/bin/node"))
+	assert.Equal(t, "", ShebangLanguage("#!/usr/bin/env"))
+	assert.Equal(t, "", ShebangLanguage("echo hi"))
+}
+
+func TestDetectLanguage(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "deploy")
+	assert.NoError(t, os.WriteFile(script, []byte("#!/usr/bin/env bash\necho deploy\n"), 0755))
+	text := filepath.Join(dir, "NOTES")
+	assert.NoError(t, os.WriteFile(text, []byte("no shebang\n"), 0644))
+
+	assert.Equal(t, "shell", DetectLanguage(script))
+	assert.Equal(t, "", DetectLanguage(text))
+	assert.Equal(t, "", DetectLanguage(filepath.Join(dir, "missing")))
+	assert.Equal(t, "go", DetectLanguage("main.go"))
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		glob  string
+		path  string
+		match bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "pkg/main.go", false},
+		{"src/**/*.ts", "src/a/b/c.ts", true},
+		{"src/**/*.ts", "src/c.ts", true},
+		{"internal/db/**", "internal/db", true},
+		{"internal/db/**", "internal/db/sql", true},
+		{"internal/db/**", "internal/dbx", false},
+		{"internal/db", "internal/db/sql/conn.go", true},
+		{"internal/db", "internal/dbx", false},
+		{"**/handlers/*", "app/handlers/user.go", true},
+		{"file?.js", "file1.js", true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, MatchGlob(tt.glob, tt.path), "%s ~ %s", tt.glob, tt.path)
+	}
+
+	assert.True(t, MatchAnyGlob([]string{"*_test.go"}, "pkg/a_test.go"))
+	assert.False(t, MatchAnyGlob([]string{"db"}, "internal/db/conn.go"))
+}
+
+func TestImports(t *testing.T) {
+	tests := []struct {
+		name  string
+		file  string
+		code  string
+		paths []string
+		lines []int
+	}{
+		{
+			name:  "go",
+			file:  "main.go",
+			code:  "package main\n\nimport (\n\t\"fmt\"\n\tdb \"example.com/app/internal/db\"\n)\n",
+			paths: []string{"fmt", "example.com/app/internal/db"},
+			lines: []int{4, 5},
+		},
+		{
+			name:  "typescript",
+			file:  "a.ts",
+			code:  "import { x } from './x';\nexport * from \"../y\";\nconst z = require('z');\nimport './side-effect';\n",
+			paths: []string{"./x", "../y", "z", "./side-effect"},
+			lines: []int{1, 2, 3, 4},
+		},
+		{
+			name:  "python",
+			file:  "a.py",
+			code:  "import os, sys\nfrom ..models import User\nfrom app.db import conn\n",
+			paths: []string{"os", "sys", "..models", "app.db"},
+			lines: []int{1, 1, 2, 3},
+		},
+		{
+			name:  "java",
+			file:  "A.java",
+			code:  "package com.acme.web;\n\nimport com.acme.db.Repo;\nimport static com.acme.util.Strings.trim;\nimport java.util.*;\n",
+			paths: []string{"com.acme.db.Repo", "com.acme.util.Strings.trim", "java.util.*"},
+			lines: []int{3, 4, 5},
+		},
+		{
+			name:  "rust",
+			file:  "lib.rs",
+			code:  "use std::collections::HashMap;\npub use crate::db;\n",
+			paths: []string{"std::collections::HashMap", "crate::db"},
+			lines: []int{1, 2},
+		},
+		{
+			name:  "unknown language",
+			file:  "notes.txt",
+			code:  "import x\n",
+			paths: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			var lines []int
+			for _, imp := range Imports(tt.file, []byte(tt.code)) {
+				paths = append(paths, imp.Path)
+				lines = append(lines, imp.Line)
+			}
+			assert.Equal(t, tt.paths, paths)
+			if tt.lines != nil {
+				assert.Equal(t, tt.lines, lines)
+			}
+		})
+	}
+}
+
+func TestImportMatches(t *testing.T) {
+	assert.True(t, ImportMatches("lodash", "lodash"))
+	assert.True(t, ImportMatches("lodash/fp", "lodash"))
+	assert.False(t, ImportMatches("lodash-es", "lodash"))
+	assert.True(t, ImportMatches("os.path", "os"))
+	assert.True(t, ImportMatches("std::fs::File", "std::fs"))
+	assert.True(t, ImportMatches("github.com/acme/x", "github.com/acme/*"))
+}
@@ -0,0 +1,220 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// fileState is the part of a file's metadata used to detect modifications.
+type fileState struct {
+	modTime time.Time
+	size    int64
+	exists  bool
+}
+
+// Watcher polls a set of files and reports debounced batches of changed paths.
+//
+// Polling is used instead of OS notifications so the watcher behaves the same
+// on every platform and naturally follows the file list (e.g. git ls-files),
+// which already excludes ignored and generated files. Set IndexFile to avoid
+// re-listing on every poll; each poll then only stats the listed files.
+type Watcher struct {
+	// Root is the directory the listed paths are relative to.
+	Root string
+
+	// Interval is how often files are polled.
+	// Default: 500ms
+	Interval time.Duration
+
+	// Debounce is how long the file set must stay quiet before a batch is emitted.
+	// Rapid successive saves are merged into a single batch.
+	// Default: 300ms
+	Debounce time.Duration
+
+	// ListFiles returns the paths to watch, relative to Root.
+	// Without IndexFile it is called on every poll so new files are picked up.
+	ListFiles func() ([]string, error)
+
+	// IndexFile, when set, is a file whose changes signal a new file list (e.g. the git index).
+	// ListFiles is then only called again when IndexFile or a directory of the
+	// watched files changes, so files added, removed or renamed are still picked up.
+	IndexFile string
+
+	snapshot map[string]fileState
+	files    []string
+	stamp    map[string]fileState
+}
+
+// New creates a watcher for root using the given file lister.
+func New(root string, listFiles func() ([]string, error)) *Watcher {
+	return &Watcher{
+		Root:      root,
+		Interval:  500 * time.Millisecond,
+		Debounce:  300 * time.Millisecond,
+		ListFiles: listFiles,
+	}
+}
+
+// Run polls until ctx is cancelled, sending each debounced batch of changed
+// paths (sorted, relative to Root) to out.
+// The initial state of the files is taken as the baseline and is not reported.
+func (w *Watcher) Run(ctx context.Context, out chan<- []string) error {
+	snapshot, err := w.scan()
+	if err != nil {
+		return err
+	}
+	w.snapshot = snapshot
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	pending := make(map[string]bool)
+	var lastChange time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			changed, err := w.poll()
+			if err != nil {
+				// Transient listing failures (e.g. git lock contention) are retried on the next tick
+				continue
+			}
+			for _, path := range changed {
+				pending[path] = true
+			}
+			if len(changed) > 0 {
+				lastChange = now
+			}
+
+			if len(pending) == 0 || now.Sub(lastChange) < w.Debounce {
+				continue
+			}
+
+			batch := make([]string, 0, len(pending))
+			for path := range pending {
+				batch = append(batch, path)
+			}
+			sort.Strings(batch)
+			pending = make(map[string]bool)
+
+			select {
+			case out <- batch:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// poll rescans the files and returns the paths that differ from the previous snapshot.
+func (w *Watcher) poll() ([]string, error) {
+	current, err := w.scan()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := diffSnapshots(w.snapshot, current)
+	w.snapshot = current
+	return changed, nil
+}
+
+// scan stats every listed file.
+func (w *Watcher) scan() (map[string]fileState, error) {
+	files, err := w.listFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := make(map[string]fileState, len(files))
+	for _, path := range files {
+		info, err := os.Stat(filepath.Join(w.Root, path))
+		if err != nil {
+			// Listed but missing on disk (deleted tracked file)
+			snapshot[path] = fileState{}
+			continue
+		}
+		if info.IsDir() {
+			continue
+		}
+		snapshot[path] = fileState{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+			exists:  true,
+		}
+	}
+	return snapshot, nil
+}
+
+// listFiles returns the files to watch, re-listing only when the list stamp changed.
+func (w *Watcher) listFiles() ([]string, error) {
+	if w.IndexFile == "" {
+		return w.ListFiles()
+	}
+
+	// The stamp is taken before listing: a change racing with ListFiles shows up
+	// as a stamp difference on the next poll instead of being missed.
+	stamp := w.listStamp()
+	if w.files != nil && len(diffSnapshots(w.stamp, stamp)) == 0 {
+		return w.files, nil
+	}
+
+	files, err := w.ListFiles()
+	if err != nil {
+		return nil, err
+	}
+	w.files = files
+	w.stamp = stamp
+	return files, nil
+}
+
+// listStamp stats IndexFile and the directories of the current file list.
+// Creating, deleting or renaming a file updates its directory's modification time.
+func (w *Watcher) listStamp() map[string]fileState {
+	paths := map[string]bool{w.IndexFile: true, w.Root: true}
+	for _, path := range w.files {
+		paths[filepath.Join(w.Root, filepath.Dir(path))] = true
+	}
+
+	stamp := make(map[string]fileState, len(paths))
+	for path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			stamp[path] = fileState{}
+			continue
+		}
+		stamp[path] = fileState{modTime: info.ModTime(), size: info.Size(), exists: true}
+	}
+	return stamp
+}
+
+// diffSnapshots returns the sorted paths that were added, removed or modified.
+func diffSnapshots(previous, current map[string]fileState) []string {
+	var changed []string
+
+	for path, cur := range current {
+		prev, ok := previous[path]
+		if !ok {
+			if cur.exists {
+				changed = append(changed, path)
+			}
+			continue
+		}
+		if prev.exists != cur.exists || !prev.modTime.Equal(cur.modTime) || prev.size != cur.size {
+			changed = append(changed, path)
+		}
+	}
+
+	for path, prev := range previous {
+		if _, ok := current[path]; !ok && prev.exists {
+			changed = append(changed, path)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,122 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	t0 := time.Unix(1000, 0)
+	t1 := time.Unix(2000, 0)
+
+	previous := map[string]fileState{
+		"same.go":     {modTime: t0, size: 10, exists: true},
+		"modified.go": {modTime: t0, size: 10, exists: true},
+		"resized.go":  {modTime: t0, size: 10, exists: true},
+		"removed.go":  {modTime: t0, size: 10, exists: true},
+		"deleted.go":  {modTime: t0, size: 10, exists: true},
+	}
+	current := map[string]fileState{
+		"same.go":     {modTime: t0, size: 10, exists: true},
+		"modified.go": {modTime: t1, size: 10, exists: true},
+		"resized.go":  {modTime: t0, size: 20, exists: true},
+		"deleted.go":  {},
+		"added.go":    {modTime: t1, size: 5, exists: true},
+	}
+
+	changed := diffSnapshots(previous, current)
+	assert.Equal(t, []string{"added.go", "deleted.go", "modified.go", "removed.go", "resized.go"}, changed)
+}
+
+func TestWatcher_Run_DebouncesBatch(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{"a.go", "b.go"}
+	for _, f := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte("package a\n"), 0644))
+	}
+
+	w := New(dir, func() ([]string, error) { return files, nil })
+	w.Interval = 10 * time.Millisecond
+	w.Debounce = 50 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	out := make(chan []string, 1)
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx, out) }()
+
+	// Let the baseline snapshot be taken before editing
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n\nvar x = 1\n"), 0644))
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.go"), []byte("package a\n\nvar y = 2\n"), 0644))
+
+	select {
+	case batch := <-out:
+		assert.Equal(t, []string{"a.go", "b.go"}, batch)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for batch")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestWatcher_ListFiles_OnlyWhenStampChanges(t *testing.T) {
+	dir := t.TempDir()
+	index := filepath.Join(dir, "index")
+	require.NoError(t, os.WriteFile(index, []byte("v1"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "pkg"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "a.go"), []byte("package a\n"), 0644))
+
+	calls := 0
+	w := New(dir, func() ([]string, error) {
+		calls++
+		entries, err := os.ReadDir(filepath.Join(dir, "pkg"))
+		if err != nil {
+			return nil, err
+		}
+		var files []string
+		for _, e := range entries {
+			files = append(files, filepath.Join("pkg", e.Name()))
+		}
+		return files, nil
+	})
+	w.IndexFile = index
+
+	_, err := w.scan()
+	require.NoError(t, err)
+	// The first stamp only covers the index and root, so one re-list settles the directories
+	_, err = w.scan()
+	require.NoError(t, err)
+	listed := calls
+
+	for i := 0; i < 3; i++ {
+		_, err = w.scan()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, listed, calls, "unchanged stamp should reuse the file list")
+
+	// A new file changes its directory's modification time
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "b.go"), []byte("package a\n"), 0644))
+	snapshot, err := w.scan()
+	require.NoError(t, err)
+	assert.Greater(t, calls, listed)
+	assert.Contains(t, snapshot, filepath.Join("pkg", "b.go"))
+	listed = calls
+
+	// So does an index update
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, os.WriteFile(index, []byte("v2"), 0644))
+	_, err = w.scan()
+	require.NoError(t, err)
+	assert.Greater(t, calls, listed)
+}
@@ -0,0 +1,292 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/plugin"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/internal/util/source"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Example kinds
+const (
+	ExampleGood = "good" // Must not be flagged
+	ExampleBad  = "bad"  // Must be flagged
+)
+
+// ExampleTest describes a run of one rule's examples on one engine.
+type ExampleTest struct {
+	Rule     schema.PolicyRule   // Policy rule; check.engine selects the engine, check.ruleIds filter findings
+	Config   []byte              // Linter config enforcing the rule (unused for llm-validator)
+	Examples schema.RuleExamples // Snippets to check
+	Language string              // Snippet language, selects the example file extension
+}
+
+// ExampleOptions configures how examples are run.
+type ExampleOptions struct {
+	WorkDir     string       // Example files are written to a temp dir under WorkDir (default: OS temp dir)
+	SymDir      string       // .sym directory, for the tools.lock used by installs and linter plugins
+	Install     bool         // Install missing linters; otherwise the engine is skipped
+	LLMProvider llm.Provider // Required for llm-validator; nil skips it
+}
+
+// ExampleCase is the outcome of one example.
+type ExampleCase struct {
+	Kind     string `json:"kind"`     // ExampleGood or ExampleBad
+	Index    int    `json:"index"`    // 1-based position among the examples of its kind
+	Findings int    `json:"findings"` // Violations reported for the rule
+	Passed   bool   `json:"passed"`
+}
+
+// ExampleResult is the outcome of a rule's examples on one engine.
+type ExampleResult struct {
+	RuleID  string        `json:"ruleId"`
+	Engine  string        `json:"engine"`
+	Cases   []ExampleCase `json:"cases,omitempty"`
+	Skipped string        `json:"skipped,omitempty"` // Why the engine was not run
+	Error   string        `json:"error,omitempty"`
+}
+
+// Passed reports whether the engine ran and every example behaved as expected.
+func (r *ExampleResult) Passed() bool {
+	if r.Skipped != "" || r.Error != "" {
+		return false
+	}
+	for _, c := range r.Cases {
+		if !c.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Failures describes the examples that did not behave as expected.
+func (r *ExampleResult) Failures() []string {
+	var failures []string
+	for _, c := range r.Cases {
+		switch {
+		case c.Passed:
+		case c.Kind == ExampleBad:
+			failures = append(failures, fmt.Sprintf("bad example %d not flagged", c.Index))
+		default:
+			failures = append(failures, fmt.Sprintf("good example %d flagged (%d finding(s))", c.Index, c.Findings))
+		}
+	}
+	return failures
+}
+
+// exampleFile is an example written to disk.
+type exampleFile struct {
+	kind  string
+	index int
+	code  string
+	path  string
+}
+
+// TestExamples writes the examples to files in a temp dir, runs the rule's
+// engine over them and checks that every bad example is flagged and no
+// good example is. Problems running the engine are reported in the result.
+func TestExamples(ctx context.Context, test ExampleTest, opts ExampleOptions) *ExampleResult {
+	engine := getEngineName(test.Rule)
+	result := &ExampleResult{RuleID: test.Rule.ID, Engine: engine}
+
+	if len(test.Examples.Good) == 0 && len(test.Examples.Bad) == 0 {
+		result.Skipped = "no examples"
+		return result
+	}
+	ext := source.Extension(test.Language)
+	if ext == "" {
+		result.Skipped = fmt.Sprintf("unknown example language %q", test.Language)
+		return result
+	}
+
+	// Examples go to the OS temp dir so nothing is created in the project.
+	// Linters run them with the rule's own config (never merged with the
+	// project's), so they need nothing from the project; a WorkDir inside the
+	// project is only for tools that must find project files next to the code.
+	dir, err := os.MkdirTemp(opts.WorkDir, "sym-examples-")
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to create example dir: %v", err)
+		return result
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	var lntr linter.Linter
+	if engine != "llm-validator" {
+		if lntr, err = exampleLinter(engine, opts.SymDir); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+
+	var files []exampleFile
+	var names []string
+	for _, kind := range []string{ExampleBad, ExampleGood} {
+		snippets := test.Examples.Bad
+		if kind == ExampleGood {
+			snippets = test.Examples.Good
+		}
+		for i, code := range snippets {
+			files = append(files, exampleFile{kind: kind, index: i + 1, code: code})
+			names = append(names, fmt.Sprintf("%s_%d", kind, i+1))
+		}
+	}
+
+	// Linters that only check files inside a project (a Go module, a Cargo
+	// crate) get the examples laid out as one and run inside it.
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = filepath.Join(dir, name+ext)
+	}
+	if scaffolder, ok := lntr.(linter.ExampleScaffolder); ok {
+		if paths, err = scaffolder.ScaffoldExamples(dir, names, ext); err != nil {
+			result.Error = fmt.Sprintf("failed to scaffold example project: %v", err)
+			return result
+		}
+		ctx = linter.WithProjectDir(ctx, dir)
+	}
+	for i := range files {
+		files[i].path = paths[i]
+		if err := os.WriteFile(paths[i], []byte(files[i].code+"\n"), 0644); err != nil {
+			result.Error = fmt.Sprintf("failed to write example: %v", err)
+			return result
+		}
+	}
+
+	var findings map[string]int
+	if engine == "llm-validator" {
+		if opts.LLMProvider == nil {
+			result.Skipped = "no LLM provider"
+			return result
+		}
+		findings, err = llmExampleFindings(ctx, opts.LLMProvider, test.Rule, files)
+	} else {
+		var skipped string
+		findings, skipped, err = linterExampleFindings(ctx, lntr, test, files, opts)
+		result.Skipped = skipped
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if result.Skipped != "" {
+		return result
+	}
+
+	for _, file := range files {
+		n := findings[file.path]
+		result.Cases = append(result.Cases, ExampleCase{
+			Kind:     file.kind,
+			Index:    file.index,
+			Findings: n,
+			Passed:   (n > 0) == (file.kind == ExampleBad),
+		})
+	}
+	return result
+}
+
+// exampleLinter looks up the linter for an engine, including the project's
+// linter plugins when a .sym directory is given.
+func exampleLinter(engine, symDir string) (linter.Linter, error) {
+	registry := linter.Global()
+	if symDir != "" {
+		registry, _, _ = plugin.NewRegistry(registry, symDir)
+	}
+	lntr, err := registry.GetLinter(engine)
+	if err != nil {
+		return nil, fmt.Errorf("linter not found: %s", engine)
+	}
+	return lntr, nil
+}
+
+// linterExampleFindings runs the linter once over all example files and
+// counts the rule's violations per file.
+func linterExampleFindings(ctx context.Context, lntr linter.Linter, test ExampleTest, files []exampleFile, opts ExampleOptions) (map[string]int, string, error) {
+	engine := getEngineName(test.Rule)
+	installConfig, err := linter.NewInstallConfig(opts.SymDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load tool lock: %w", err)
+	}
+	ctx = linter.WithToolLock(ctx, installConfig.Lock)
+
+	if err := lntr.CheckAvailability(ctx); err != nil {
+		if !opts.Install {
+			return nil, fmt.Sprintf("%s is not installed", engine), nil
+		}
+		if err := lntr.Install(ctx, installConfig); err != nil {
+			return nil, "", fmt.Errorf("failed to install %s: %w", engine, err)
+		}
+	}
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = file.path
+	}
+	output, err := lntr.Execute(ctx, test.Config, paths)
+	if err != nil {
+		return nil, "", fmt.Errorf("linter execution failed: %w", err)
+	}
+	violations, err := lntr.ParseOutput(output)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse output: %w", err)
+	}
+
+	ruleIDs := nativeRuleIDs(test.Rule.Check["ruleIds"])
+	findings := make(map[string]int)
+	for _, v := range violations {
+		if len(ruleIDs) > 0 && !matchesAnyNativeRuleID(v.RuleID, ruleIDs) {
+			continue
+		}
+		for _, file := range files {
+			if sameExampleFile(v.File, file.path) {
+				findings[file.path]++
+				break
+			}
+		}
+	}
+	return findings, "", nil
+}
+
+// llmExampleFindings asks the LLM validator about each example.
+func llmExampleFindings(ctx context.Context, provider llm.Provider, rule schema.PolicyRule, files []exampleFile) (map[string]int, error) {
+	validator := newLLMValidator(provider, &schema.CodePolicy{Rules: []schema.PolicyRule{rule}})
+	findings := make(map[string]int)
+	for _, file := range files {
+		change := git.Change{FilePath: file.path, Status: "A", Diff: file.code}
+		violation, err := validator.checkRule(ctx, change, strings.Split(file.code, "\n"), rule)
+		if err != nil {
+			return nil, fmt.Errorf("%s example %d: %w", file.kind, file.index, err)
+		}
+		if violation != nil {
+			findings[file.path]++
+		}
+	}
+	return findings, nil
+}
+
+func matchesAnyNativeRuleID(reported string, configured []string) bool {
+	for _, id := range configured {
+		if matchesNativeRuleID(reported, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameExampleFile reports whether a reported path names an example file.
+// Tools report paths relative to their working directory or absolute.
+func sameExampleFile(reported, path string) bool {
+	if filepath.Clean(reported) == filepath.Clean(path) {
+		return true
+	}
+	absReported, err1 := filepath.Abs(reported)
+	absPath, err2 := filepath.Abs(path)
+	return err1 == nil && err2 == nil && absReported == absPath
+}
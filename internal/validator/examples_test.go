@@ -0,0 +1,227 @@
+package validator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// varLinter reports "no-var" for every example file containing "var ".
+// It records the files it was run on.
+type varLinter struct {
+	name  string
+	files []string
+}
+
+func (l *varLinter) Name() string { return l.name }
+
+func (l *varLinter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{Name: l.name}
+}
+
+func (l *varLinter) CheckAvailability(ctx context.Context) error { return nil }
+
+func (l *varLinter) Install(ctx context.Context, config linter.InstallConfig) error { return nil }
+
+func (l *varLinter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	l.files = append(l.files, files...)
+	var flagged []string
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(string(content), "var ") {
+			flagged = append(flagged, file)
+		}
+	}
+	return &linter.ToolOutput{Stdout: strings.Join(flagged, "\n")}, nil
+}
+
+func (l *varLinter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	var violations []linter.Violation
+	for _, file := range strings.Fields(output.Stdout) {
+		violations = append(violations,
+			linter.Violation{File: file, Line: 1, RuleID: "no-var"},
+			linter.Violation{File: file, Line: 1, RuleID: "other-rule"},
+		)
+	}
+	return violations, nil
+}
+
+// moduleLinter is a varLinter that, like golangci-lint's ./..., only checks
+// files of the module in its project dir (the current directory by default).
+type moduleLinter struct {
+	varLinter
+}
+
+func (l *moduleLinter) ScaffoldExamples(dir string, names []string, ext string) ([]string, error) {
+	if err := os.WriteFile(filepath.Join(dir, "module.txt"), nil, 0644); err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = filepath.Join(dir, name, name+ext)
+		if err := os.MkdirAll(filepath.Dir(paths[i]), 0755); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+func (l *moduleLinter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	projectDir := linter.ProjectDir(ctx)
+	if projectDir == "" {
+		projectDir, _ = os.Getwd()
+	}
+	if _, err := os.Stat(filepath.Join(projectDir, "module.txt")); err != nil {
+		return &linter.ToolOutput{}, nil
+	}
+	var inModule []string
+	for _, file := range files {
+		if rel, err := filepath.Rel(projectDir, file); err == nil && !strings.HasPrefix(rel, "..") {
+			inModule = append(inModule, file)
+		}
+	}
+	return l.varLinter.Execute(ctx, config, inModule)
+}
+
+// flagProvider reports a violation when the prompt contains "FLAGME".
+type flagProvider struct{}
+
+func (flagProvider) Execute(ctx context.Context, prompt string, format llm.ResponseFormat) (string, error) {
+	if strings.Contains(prompt, "FLAGME") {
+		return `{"violates": true, "description": "flagged"}`, nil
+	}
+	return `{"violates": false}`, nil
+}
+
+func (flagProvider) Name() string { return "flag" }
+func (flagProvider) Close() error { return nil }
+
+func TestTestExamples_Linter(t *testing.T) {
+	l := &varLinter{name: "examples-test-var"}
+	require.NoError(t, linter.Global().RegisterTool(l, nil, ""))
+	rule := schema.PolicyRule{
+		ID:    "R1-" + l.name,
+		Check: map[string]interface{}{"engine": l.name, "ruleIds": []interface{}{"no-var"}},
+	}
+	dir := t.TempDir()
+
+	result := TestExamples(context.Background(), ExampleTest{
+		Rule:     rule,
+		Examples: schema.RuleExamples{Good: []string{"const a = 1;"}, Bad: []string{"var a = 1;"}},
+		Language: "javascript",
+	}, ExampleOptions{WorkDir: dir})
+
+	assert.True(t, result.Passed(), "%+v", result)
+	assert.Equal(t, []ExampleCase{
+		{Kind: ExampleBad, Index: 1, Findings: 1, Passed: true},
+		{Kind: ExampleGood, Index: 1, Findings: 0, Passed: true},
+	}, result.Cases)
+
+	// A good example that is flagged fails, and so does an unflagged bad one
+	result = TestExamples(context.Background(), ExampleTest{
+		Rule:     rule,
+		Examples: schema.RuleExamples{Good: []string{"var ok = 1;"}, Bad: []string{"let a = 1;"}},
+		Language: "javascript",
+	}, ExampleOptions{WorkDir: dir})
+
+	assert.False(t, result.Passed())
+	assert.Equal(t, []string{"bad example 1 not flagged", "good example 1 flagged (1 finding(s))"}, result.Failures())
+
+	// Example files are cleaned up
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestTestExamples_DefaultsToOSTempDir(t *testing.T) {
+	l := &varLinter{name: "examples-test-tempdir"}
+	require.NoError(t, linter.Global().RegisterTool(l, nil, ""))
+
+	// Nothing is written to the working directory
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	project := t.TempDir()
+	require.NoError(t, os.Chdir(project))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	result := TestExamples(context.Background(), ExampleTest{
+		Rule:     schema.PolicyRule{ID: "R1-" + l.name, Check: map[string]interface{}{"engine": l.name}},
+		Examples: schema.RuleExamples{Bad: []string{"var a = 1;"}},
+		Language: "javascript",
+	}, ExampleOptions{})
+	assert.True(t, result.Passed(), "%+v", result)
+
+	require.Len(t, l.files, 1)
+	assert.Equal(t, filepath.Clean(os.TempDir()), filepath.Dir(filepath.Dir(l.files[0])))
+	entries, err := os.ReadDir(project)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestTestExamples_ModuleScopedLinter(t *testing.T) {
+	l := &moduleLinter{varLinter{name: "examples-test-module"}}
+	require.NoError(t, linter.Global().RegisterTool(l, nil, ""))
+
+	// The examples are checked inside their scaffolded module, not the cwd
+	result := TestExamples(context.Background(), ExampleTest{
+		Rule:     schema.PolicyRule{ID: "R1-" + l.name, Check: map[string]interface{}{"engine": l.name}},
+		Examples: schema.RuleExamples{Good: []string{"const a = 1;"}, Bad: []string{"var a = 1;", "var b = 2;"}},
+		Language: "javascript",
+	}, ExampleOptions{})
+
+	assert.True(t, result.Passed(), "%+v", result)
+	assert.Equal(t, []ExampleCase{
+		{Kind: ExampleBad, Index: 1, Findings: 2, Passed: true},
+		{Kind: ExampleBad, Index: 2, Findings: 2, Passed: true},
+		{Kind: ExampleGood, Index: 1, Findings: 0, Passed: true},
+	}, result.Cases)
+	require.Len(t, l.files, 3)
+	assert.Equal(t, "bad_1.js", filepath.Base(l.files[0]))
+	assert.Equal(t, "bad_1", filepath.Base(filepath.Dir(l.files[0])))
+}
+
+func TestTestExamples_LLMValidator(t *testing.T) {
+	rule := schema.PolicyRule{
+		ID:    "R1-llm-validator",
+		Desc:  "Do not flag",
+		Check: map[string]interface{}{"engine": "llm-validator"},
+	}
+	test := ExampleTest{
+		Rule:     rule,
+		Examples: schema.RuleExamples{Good: []string{"ok()"}, Bad: []string{"bad() // FLAGME"}},
+		Language: "javascript",
+	}
+
+	result := TestExamples(context.Background(), test, ExampleOptions{WorkDir: t.TempDir(), LLMProvider: flagProvider{}})
+	assert.True(t, result.Passed(), "%+v", result)
+	assert.Len(t, result.Cases, 2)
+
+	result = TestExamples(context.Background(), test, ExampleOptions{WorkDir: t.TempDir()})
+	assert.False(t, result.Passed())
+	assert.Equal(t, "no LLM provider", result.Skipped)
+}
+
+func TestTestExamples_Skips(t *testing.T) {
+	rule := schema.PolicyRule{ID: "R1-eslint", Check: map[string]interface{}{"engine": "eslint"}}
+
+	result := TestExamples(context.Background(), ExampleTest{Rule: rule, Language: "javascript"}, ExampleOptions{WorkDir: t.TempDir()})
+	assert.Equal(t, "no examples", result.Skipped)
+
+	result = TestExamples(context.Background(), ExampleTest{
+		Rule:     rule,
+		Examples: schema.RuleExamples{Bad: []string{"x"}},
+		Language: "brainfuck",
+	}, ExampleOptions{WorkDir: t.TempDir()})
+	assert.Contains(t, result.Skipped, "unknown example language")
+}
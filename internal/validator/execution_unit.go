@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
@@ -30,7 +31,8 @@ type executionUnit interface {
 }
 
 // linterExecutionUnit groups multiple rules for the same linter
-// All rules are validated in a single linter execution
+// All rules are validated together; large file sets are split into chunks
+// that run in parallel (see planChunks)
 type linterExecutionUnit struct {
 	engineName string
 	rules      []schema.PolicyRule
@@ -38,13 +40,20 @@ type linterExecutionUnit struct {
 	registry   *linter.Registry
 	symDir     string
 	verbose    bool
+	// mergeProjectConfig layers the generated config on the project's own config
+	mergeProjectConfig bool
+	// execution configures chunking (nil = argv limits only, default concurrency)
+	execution *schema.ExecutionSettings
 }
 
-// Execute runs the linter once with all rules and files
+// Execute runs the linter with all rules over the files, one run per chunk
 func (u *linterExecutionUnit) Execute(ctx context.Context) ([]Violation, error) {
 	if len(u.files) == 0 {
 		return nil, nil
 	}
+	if u.mergeProjectConfig {
+		ctx = linter.WithProjectConfigMerge(ctx)
+	}
 
 	// Get linter from registry
 	lntr, err := u.registry.GetLinter(u.engineName)
@@ -52,14 +61,19 @@ func (u *linterExecutionUnit) Execute(ctx context.Context) ([]Violation, error)
 		return nil, fmt.Errorf("linter not found: %s: %w", u.engineName, err)
 	}
 
+	// Locate and install with the package's tools.lock, not the one found from the working directory
+	installConfig, err := linter.NewInstallConfig(u.symDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tool lock: %w", err)
+	}
+	ctx = linter.WithToolLock(ctx, installConfig.Lock)
+
 	// Check availability and install if needed
 	if err := lntr.CheckAvailability(ctx); err != nil {
 		if u.verbose {
 			fmt.Printf("   📦 Installing %s...\n", lntr.Name())
 		}
-		if err := lntr.Install(ctx, linter.InstallConfig{
-			ToolsDir: filepath.Join(os.Getenv("HOME"), ".sym", "tools"),
-		}); err != nil {
+		if err := lntr.Install(ctx, installConfig); err != nil {
 			return nil, fmt.Errorf("failed to install %s: %w", lntr.Name(), err)
 		}
 	}
@@ -70,29 +84,154 @@ func (u *linterExecutionUnit) Execute(ctx context.Context) ([]Violation, error)
 		return nil, fmt.Errorf("failed to get config: %w", err)
 	}
 
-	// Execute linter ONCE with ALL files
+	// Split files into chunks that fit the tool's input limits
+	settings := resolveChunkSettings(u.execution, u.engineName)
+	caps := lntr.GetCapabilities()
+	fileList := settings.fileList && caps.FileList
+	if fileList {
+		ctx = linter.WithFileListInput(ctx)
+	}
+	chunks := planChunks(u.files, caps.FileInput, settings.chunkSize, fileList)
+
 	startTime := time.Now()
-	output, err := lntr.Execute(ctx, config, u.files)
+	results := u.executeChunks(ctx, lntr, config, chunks, settings.concurrency)
+	wallMs := time.Since(startTime).Milliseconds()
+
+	// Merge chunk results in file order
+	var violations []Violation
+	hasOutput := false
+	for i, result := range results {
+		if result.err != nil {
+			if len(chunks) > 1 {
+				return nil, fmt.Errorf("chunk %d/%d: %w", i+1, len(chunks), result.err)
+			}
+			return nil, result.err
+		}
+		violations = append(violations, result.violations...)
+		hasOutput = hasOutput || result.hasOutput
+	}
+
+	if u.verbose && hasOutput {
+		if len(chunks) > 1 {
+			fmt.Printf("   📋 %s output (%dms, %d chunks): %d violation(s)\n", u.engineName, wallMs, len(chunks), len(violations))
+		} else {
+			fmt.Printf("   📋 %s output (%dms): %d violation(s)\n", u.engineName, wallMs, len(violations))
+		}
+	}
+
+	return violations, nil
+}
+
+// chunkResult is the outcome of one linter run over a chunk of files.
+type chunkResult struct {
+	violations []Violation
+	hasOutput  bool
+	err        error
+}
+
+// executeChunks runs the linter over each chunk, at most concurrency at a time.
+// Results are returned in chunk order.
+func (u *linterExecutionUnit) executeChunks(ctx context.Context, lntr linter.Linter, config []byte, chunks [][]string, concurrency int) []chunkResult {
+	results := make([]chunkResult, len(chunks))
+	if len(chunks) == 1 {
+		results[0] = u.executeChunk(ctx, lntr, config, chunks[0])
+		return results
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, files := range chunks {
+		wg.Add(1)
+		go func(i int, files []string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = chunkResult{err: ctx.Err()}
+				return
+			}
+			defer func() { <-sem }()
+
+			results[i] = u.executeChunk(ctx, lntr, config, files)
+		}(i, files)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// executeChunk runs the linter once over files. Violations carry the
+// execution time of this run, not of the whole unit.
+func (u *linterExecutionUnit) executeChunk(ctx context.Context, lntr linter.Linter, config []byte, files []string) chunkResult {
+	startTime := time.Now()
+	output, err := lntr.Execute(ctx, config, files)
 	execMs := time.Since(startTime).Milliseconds()
 
 	if err != nil {
-		return nil, fmt.Errorf("linter execution failed: %w", err)
+		return chunkResult{err: fmt.Errorf("linter execution failed: %w", err)}
 	}
 
 	// Parse output to violations
 	linterViolations, err := lntr.ParseOutput(output)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse output: %w", err)
+		return chunkResult{err: fmt.Errorf("failed to parse output: %w", err)}
 	}
 
 	// Map linter violations to our Violation type
-	violations := u.mapViolationsToRules(linterViolations, output, execMs)
+	return chunkResult{
+		violations: u.mapViolationsToRules(linterViolations, output, execMs),
+		hasOutput:  output.Stdout != "",
+	}
+}
+
+// chunkSettings is the chunking of one linter, resolved from ExecutionSettings.
+type chunkSettings struct {
+	chunkSize   int  // max files per run (0 = no file count limit)
+	concurrency int  // max parallel runs
+	fileList    bool // use tool-native file lists where supported
+}
 
-	if u.verbose && output.Stdout != "" {
-		fmt.Printf("   📋 %s output (%dms): %d violation(s)\n", u.engineName, execMs, len(violations))
+// resolveChunkSettings applies the per-tool overrides of settings for engine.
+func resolveChunkSettings(settings *schema.ExecutionSettings, engine string) chunkSettings {
+	resolved := chunkSettings{concurrency: getDefaultConcurrency()}
+	if settings == nil {
+		return resolved
 	}
 
-	return violations, nil
+	resolved.chunkSize = settings.ChunkSize
+	resolved.fileList = settings.FileList
+	if settings.Concurrency > 0 {
+		resolved.concurrency = settings.Concurrency
+	}
+
+	if tool, ok := settings.Tools[engine]; ok {
+		if tool.ChunkSize > 0 {
+			resolved.chunkSize = tool.ChunkSize
+		}
+		if tool.Concurrency > 0 {
+			resolved.concurrency = tool.Concurrency
+		}
+		if tool.FileList != nil {
+			resolved.fileList = *tool.FileList
+		}
+	}
+	return resolved
+}
+
+// planChunks splits files for one linter:
+// - project-wide tools run once (they check the whole project anyway)
+// - tools reading files outside argv are split only by chunkSize
+// - tools taking files as arguments are also kept within the OS argv limit
+func planChunks(files []string, fileInput string, chunkSize int, fileList bool) [][]string {
+	switch {
+	case fileInput == linter.FileInputProject:
+		return [][]string{files}
+	case fileInput == linter.FileInputList || fileList:
+		return linter.ChunkFiles(files, chunkSize, 0)
+	default:
+		return linter.ChunkFiles(files, chunkSize, linter.MaxArgBytes())
+	}
 }
 
 // getConfig retrieves the linter configuration
@@ -172,14 +311,23 @@ func (u *linterExecutionUnit) mapViolationsToRules(
 	return violations
 }
 
-// findPolicyRule finds the policy rule that corresponds to a linter rule ID
+// findPolicyRule finds the policy rule that corresponds to a linter rule ID.
+// Explicit mappings (check "ruleId" or "ruleIds") take precedence over rule ID matching.
 func (u *linterExecutionUnit) findPolicyRule(linterRuleID string) *schema.PolicyRule {
 	for i, rule := range u.rules {
-		// Check if this policy rule's check config matches the linter rule
-		if checkID, ok := rule.Check["ruleId"].(string); ok && checkID == linterRuleID {
+		// Check if this policy rule's check config maps the linter rule
+		if checkID, ok := rule.Check["ruleId"].(string); ok && matchesNativeRuleID(linterRuleID, checkID) {
 			return &u.rules[i]
 		}
-		// Also check if the rule ID contains the linter rule ID
+		for _, id := range nativeRuleIDs(rule.Check["ruleIds"]) {
+			if matchesNativeRuleID(linterRuleID, id) {
+				return &u.rules[i]
+			}
+		}
+	}
+
+	// Also check if the rule ID contains the linter rule ID
+	for i, rule := range u.rules {
 		if strings.Contains(rule.ID, linterRuleID) {
 			return &u.rules[i]
 		}
@@ -193,6 +341,36 @@ func (u *linterExecutionUnit) findPolicyRule(linterRuleID string) *schema.Policy
 	return nil
 }
 
+// matchesNativeRuleID reports whether a reported rule ID matches a configured one.
+// Tools such as semgrep qualify rule IDs with a path ("rules.no-eval"), so a
+// reported ID ending in "."+id or "/"+id also matches.
+func matchesNativeRuleID(reported, configured string) bool {
+	if configured == "" {
+		return false
+	}
+	return reported == configured ||
+		strings.HasSuffix(reported, "."+configured) ||
+		strings.HasSuffix(reported, "/"+configured)
+}
+
+// nativeRuleIDs returns the "ruleIds" check value, which is []string when built
+// in memory and []interface{} when loaded from code-policy.json.
+func nativeRuleIDs(value interface{}) []string {
+	switch ids := value.(type) {
+	case []string:
+		return ids
+	case []interface{}:
+		result := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if s, ok := id.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
 // GetRuleIDs returns the IDs of all rules in this execution unit
 func (u *linterExecutionUnit) GetRuleIDs() []string {
 	ids := make([]string, len(u.rules))
@@ -361,6 +539,9 @@ Confidence levels:
 		if rule.Category != "" {
 			sb.WriteString(fmt.Sprintf("  Category: %s\n", rule.Category))
 		}
+		if params := ruleParams(rule); len(params) > 0 {
+			sb.WriteString(fmt.Sprintf("  Parameters (use these exact values): %s\n", linter.FormatParams(params)))
+		}
 		if rule.When != nil && len(rule.When.Languages) > 0 {
 			sb.WriteString(fmt.Sprintf("  Applies to: %s\n", strings.Join(rule.When.Languages, ", ")))
 		}
@@ -0,0 +1,161 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chunkLinter reports one violation per file and records how it was called.
+type chunkLinter struct {
+	name      string
+	fileInput string
+	fileList  bool
+
+	mu        sync.Mutex
+	calls     [][]string
+	fileLists []bool
+	running   int32
+	peak      int32
+}
+
+func (l *chunkLinter) Name() string { return l.name }
+
+func (l *chunkLinter) GetCapabilities() linter.Capabilities {
+	return linter.Capabilities{Name: l.name, FileInput: l.fileInput, FileList: l.fileList}
+}
+
+func (l *chunkLinter) CheckAvailability(ctx context.Context) error { return nil }
+
+func (l *chunkLinter) Install(ctx context.Context, config linter.InstallConfig) error { return nil }
+
+func (l *chunkLinter) Execute(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	running := atomic.AddInt32(&l.running, 1)
+	defer atomic.AddInt32(&l.running, -1)
+	for {
+		peak := atomic.LoadInt32(&l.peak)
+		if running <= peak || atomic.CompareAndSwapInt32(&l.peak, peak, running) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	l.mu.Lock()
+	l.calls = append(l.calls, files)
+	l.fileLists = append(l.fileLists, linter.FileListInput(ctx))
+	l.mu.Unlock()
+
+	return &linter.ToolOutput{Stdout: strings.Join(files, "\n")}, nil
+}
+
+func (l *chunkLinter) ParseOutput(output *linter.ToolOutput) ([]linter.Violation, error) {
+	var violations []linter.Violation
+	for _, file := range strings.Split(output.Stdout, "\n") {
+		violations = append(violations, linter.Violation{File: file, Line: 1, RuleID: "chunk-rule"})
+	}
+	return violations, nil
+}
+
+func registerChunkLinter(t *testing.T, l *chunkLinter) {
+	t.Helper()
+	require.NoError(t, linter.Global().RegisterTool(l, nil, ""))
+}
+
+func newChunkUnit(l *chunkLinter, files []string, execution *schema.ExecutionSettings) *linterExecutionUnit {
+	return &linterExecutionUnit{
+		engineName: l.name,
+		rules:      []schema.PolicyRule{{ID: "R1", Check: map[string]interface{}{"engine": l.name}}},
+		files:      files,
+		registry:   linter.Global(),
+		symDir:     "",
+		execution:  execution,
+	}
+}
+
+func chunkTestFiles(n int) []string {
+	files := make([]string, n)
+	for i := range files {
+		files[i] = fmt.Sprintf("src/file%02d.java", i)
+	}
+	return files
+}
+
+func TestLinterExecutionUnit_ChunkedExecution(t *testing.T) {
+	l := &chunkLinter{name: "chunk-test-args"}
+	registerChunkLinter(t, l)
+
+	files := chunkTestFiles(10)
+	unit := newChunkUnit(l, files, &schema.ExecutionSettings{
+		ChunkSize:   3,
+		Concurrency: 4,
+		Tools:       map[string]schema.ToolExecution{l.name: {Concurrency: 2}},
+	})
+
+	violations, err := unit.Execute(context.Background())
+	require.NoError(t, err)
+
+	// 10 files in chunks of 3, at most 2 running at once (per-tool override)
+	assert.Len(t, l.calls, 4)
+	assert.LessOrEqual(t, atomic.LoadInt32(&l.peak), int32(2))
+
+	// Results are merged in file order and timed per chunk
+	require.Len(t, violations, len(files))
+	for i, v := range violations {
+		assert.Equal(t, files[i], v.File)
+		assert.Equal(t, "R1", v.RuleID)
+		assert.GreaterOrEqual(t, v.ExecutionMs, int64(10))
+		assert.Less(t, v.ExecutionMs, int64(1000))
+	}
+}
+
+func TestLinterExecutionUnit_FileListInput(t *testing.T) {
+	l := &chunkLinter{name: "chunk-test-filelist", fileList: true}
+	registerChunkLinter(t, l)
+
+	unit := newChunkUnit(l, chunkTestFiles(5), &schema.ExecutionSettings{FileList: true})
+	_, err := unit.Execute(context.Background())
+	require.NoError(t, err)
+
+	// File lists are not bound by argv, so one run without a chunk size
+	require.Len(t, l.calls, 1)
+	assert.True(t, l.fileLists[0])
+}
+
+func TestPlanChunks(t *testing.T) {
+	// Paths long enough that the argv limit splits them
+	long := strings.Repeat("x", linter.MaxArgBytes()/2)
+	files := []string{long + "1", long + "2", long + "3"}
+
+	assert.Len(t, planChunks(files, linter.FileInputArgs, 0, false), 3)
+	assert.Len(t, planChunks(files, "", 0, false), 3)
+	assert.Len(t, planChunks(files, linter.FileInputArgs, 0, true), 1)
+	assert.Len(t, planChunks(files, linter.FileInputList, 0, false), 1)
+	assert.Len(t, planChunks(files, linter.FileInputList, 2, false), 2)
+	assert.Len(t, planChunks(files, linter.FileInputProject, 1, false), 1)
+}
+
+func TestResolveChunkSettings(t *testing.T) {
+	defaults := resolveChunkSettings(nil, "pmd")
+	assert.Equal(t, chunkSettings{concurrency: getDefaultConcurrency()}, defaults)
+
+	off := false
+	settings := &schema.ExecutionSettings{
+		ChunkSize:   100,
+		Concurrency: 4,
+		FileList:    true,
+		Tools: map[string]schema.ToolExecution{
+			"pmd": {ChunkSize: 500, Concurrency: 1, FileList: &off},
+		},
+	}
+	assert.Equal(t, chunkSettings{chunkSize: 500, concurrency: 1, fileList: false}, resolveChunkSettings(settings, "pmd"))
+	assert.Equal(t, chunkSettings{chunkSize: 100, concurrency: 4, fileList: true}, resolveChunkSettings(settings, "eslint"))
+}
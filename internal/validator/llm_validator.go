@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/DevSymphony/sym-cli/internal/linter"
 	"github.com/DevSymphony/sym-cli/internal/llm"
 	"github.com/DevSymphony/sym-cli/internal/util/git"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
@@ -102,7 +103,7 @@ Response:
 === CODE TO REVIEW ===
 %s
 
-Analyze the code and determine if it violates the rule. Respond with JSON only.`, change.FilePath, rule.Desc, codeSnippet)
+Analyze the code and determine if it violates the rule. Respond with JSON only.`, change.FilePath, rule.Desc+linter.ParamsPrompt(ruleParams(rule)), codeSnippet)
 
 	// Call LLM
 	prompt := systemPrompt + "\n\n" + userPrompt
@@ -147,6 +148,12 @@ type jsonValidationResponse struct {
 	Suggestion  string `json:"suggestion"`
 }
 
+// ruleParams returns the rule params stored in an llm-validator rule's check.
+func ruleParams(rule schema.PolicyRule) map[string]any {
+	params, _ := rule.Check["params"].(map[string]any)
+	return params
+}
+
 func parseValidationResponse(response string) validationResponse {
 	// Default to no violation (conservative approach)
 	result := validationResponse{
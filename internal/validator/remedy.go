@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// CanApplyRemedy reports whether rule has an autofix remedy backed by a linter that can fix files.
+func (v *Validator) CanApplyRemedy(rule schema.PolicyRule) bool {
+	tool := remedyTool(rule)
+	if tool == "" {
+		return false
+	}
+
+	lntr, err := v.linterRegistry().GetLinter(tool)
+	if err != nil {
+		return false
+	}
+	_, ok := lntr.(linter.Fixer)
+	return ok
+}
+
+// ApplyRemedy runs the rule's remedy tool in fix mode on files, modifying them in place.
+// Only the rule itself is fixed: the validation config (.sym/<config file>) is
+// narrowed to the rule's native rule IDs first.
+func (v *Validator) ApplyRemedy(ctx context.Context, rule schema.PolicyRule, files []string) error {
+	fixer, config, err := v.remedyFixer(rule)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fixer.Fix(ctx, config, files); err != nil {
+		return fmt.Errorf("%s fix failed: %w", remedyTool(rule), err)
+	}
+	return nil
+}
+
+// FixContent runs the rule's remedy on content as if it were the file at path
+// and returns the fixed content. The fix runs on a temp copy outside the
+// project, so path itself is never read or written.
+func (v *Validator) FixContent(ctx context.Context, rule schema.PolicyRule, path, content string) (string, error) {
+	fixer, config, err := v.remedyFixer(rule)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := os.MkdirTemp("", "sym-remedy-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	// Keep the file name: tools select parsers by extension (or name, e.g. Dockerfile)
+	tmpPath := filepath.Join(dir, filepath.Base(path))
+	if err := os.WriteFile(tmpPath, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("failed to write temp copy: %w", err)
+	}
+
+	if _, err := fixer.Fix(ctx, config, []string{tmpPath}); err != nil {
+		return "", fmt.Errorf("%s fix failed: %w", remedyTool(rule), err)
+	}
+
+	fixed, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read fixed copy: %w", err)
+	}
+	return string(fixed), nil
+}
+
+// remedyFixer returns the rule's remedy fixer and a config enabling only the rule.
+func (v *Validator) remedyFixer(rule schema.PolicyRule) (linter.Fixer, []byte, error) {
+	tool := remedyTool(rule)
+	if tool == "" {
+		return nil, nil, fmt.Errorf("rule %s has no autofix remedy", rule.ID)
+	}
+
+	registry := v.linterRegistry()
+	lntr, err := registry.GetLinter(tool)
+	if err != nil {
+		return nil, nil, fmt.Errorf("linter not found: %s: %w", tool, err)
+	}
+
+	fixer, ok := lntr.(linter.Fixer)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s does not support autofix", tool)
+	}
+
+	// Reuse the execution unit's config resolution so fixes match validation
+	unit := &linterExecutionUnit{
+		engineName: tool,
+		rules:      []schema.PolicyRule{rule},
+		registry:   registry,
+		symDir:     v.symDir,
+		verbose:    v.verbose,
+	}
+	config, err := unit.getConfig(lntr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	config, err = fixer.RuleConfig(config, remedyRuleIDs(rule))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build %s config for rule %s: %w", tool, rule.ID, err)
+	}
+	return fixer, config, nil
+}
+
+// remedyTool returns the tool that fixes the rule, or "" if the rule has no autofix remedy.
+// The remedy tool defaults to the rule's engine.
+func remedyTool(rule schema.PolicyRule) string {
+	if rule.Remedy == nil || !rule.Remedy.Autofix {
+		return ""
+	}
+	if rule.Remedy.Tool != "" {
+		return rule.Remedy.Tool
+	}
+	return getEngineName(rule)
+}
+
+// remedyRuleIDs returns the native rule IDs the remedy tool should fix.
+// They are only known when the remedy tool is the rule's engine.
+func remedyRuleIDs(rule schema.PolicyRule) []string {
+	if remedyTool(rule) != getEngineName(rule) {
+		return nil
+	}
+	ids := append([]string{}, nativeRuleIDs(rule.Check["ruleIds"])...)
+	if id, ok := rule.Check["ruleId"].(string); ok && id != "" {
+		ids = append(ids, id)
+	}
+	return ids
+}
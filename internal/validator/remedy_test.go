@@ -0,0 +1,138 @@
+package validator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upperFixer upper-cases files and records the config it fixed with.
+type upperFixer struct {
+	varLinter
+	config string
+	files  []string
+}
+
+func (f *upperFixer) RuleConfig(config []byte, ruleIDs []string) ([]byte, error) {
+	return linter.KeepJSONRules(config, ruleIDs)
+}
+
+func (f *upperFixer) Fix(ctx context.Context, config []byte, files []string) (*linter.ToolOutput, error) {
+	f.config = string(config)
+	f.files = files
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(file, []byte(strings.ToUpper(string(data))), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return &linter.ToolOutput{}, nil
+}
+
+func TestRemedyTool(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     schema.PolicyRule
+		expected string
+	}{
+		{
+			name:     "no remedy",
+			rule:     schema.PolicyRule{Check: map[string]interface{}{"engine": "eslint"}},
+			expected: "",
+		},
+		{
+			name: "autofix disabled",
+			rule: schema.PolicyRule{
+				Check:  map[string]interface{}{"engine": "eslint"},
+				Remedy: &schema.Remedy{Autofix: false, Tool: "eslint"},
+			},
+			expected: "",
+		},
+		{
+			name: "explicit tool",
+			rule: schema.PolicyRule{
+				Check:  map[string]interface{}{"engine": "eslint"},
+				Remedy: &schema.Remedy{Autofix: true, Tool: "prettier"},
+			},
+			expected: "prettier",
+		},
+		{
+			name: "defaults to engine",
+			rule: schema.PolicyRule{
+				Check:  map[string]interface{}{"engine": "eslint"},
+				Remedy: &schema.Remedy{Autofix: true},
+			},
+			expected: "eslint",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, remedyTool(tt.rule))
+		})
+	}
+}
+
+func TestApplyRemedy_Errors(t *testing.T) {
+	v := NewValidatorWithWorkDir(&schema.CodePolicy{}, false, t.TempDir())
+	defer func() { _ = v.Close() }()
+
+	noRemedy := schema.PolicyRule{ID: "R1", Check: map[string]interface{}{"engine": "eslint"}}
+	assert.False(t, v.CanApplyRemedy(noRemedy))
+	assert.ErrorContains(t, v.ApplyRemedy(context.Background(), noRemedy, []string{"a.js"}), "no autofix remedy")
+
+	unknownTool := schema.PolicyRule{ID: "R2", Remedy: &schema.Remedy{Autofix: true, Tool: "no-such-linter"}}
+	assert.False(t, v.CanApplyRemedy(unknownTool))
+	assert.ErrorContains(t, v.ApplyRemedy(context.Background(), unknownTool, []string{"a.js"}), "linter not found")
+}
+
+func TestFixContent_OnlyTheRule(t *testing.T) {
+	fixer := &upperFixer{varLinter: varLinter{name: "remedy-test-upper"}}
+	require.NoError(t, linter.Global().RegisterTool(fixer, nil, ".upperrc.json"))
+
+	workDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, ".sym"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, ".sym", ".upperrc.json"),
+		[]byte(`{"env": {"node": true}, "rules": {"no-var": "error", "semi": "error"}}`), 0644))
+	path := filepath.Join(workDir, "a.js")
+	require.NoError(t, os.WriteFile(path, []byte("on disk"), 0644))
+
+	v := NewValidatorWithWorkDir(&schema.CodePolicy{}, false, workDir)
+	defer func() { _ = v.Close() }()
+
+	rule := schema.PolicyRule{
+		ID:     "R1",
+		Check:  map[string]interface{}{"engine": fixer.name, "ruleIds": []interface{}{"no-var"}},
+		Remedy: &schema.Remedy{Autofix: true},
+	}
+	fixed, err := v.FixContent(context.Background(), rule, "a.js", "var a = 1")
+	require.NoError(t, err)
+	assert.Equal(t, "VAR A = 1", fixed)
+
+	// The fix ran on a temp copy with a config enabling only the rule
+	assert.JSONEq(t, `{"env": {"node": true}, "rules": {"no-var": "error"}}`, fixer.config)
+	require.Len(t, fixer.files, 1)
+	assert.Equal(t, "a.js", filepath.Base(fixer.files[0]))
+	assert.NotEqual(t, path, fixer.files[0])
+	_, err = os.Stat(fixer.files[0])
+	assert.True(t, os.IsNotExist(err), "temp copy should be removed")
+
+	onDisk, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "on disk", string(onDisk))
+
+	// Without native rule IDs the fix cannot be narrowed to the rule
+	noIDs := schema.PolicyRule{ID: "R2", Check: map[string]interface{}{"engine": fixer.name}, Remedy: &schema.Remedy{Autofix: true}}
+	_, err = v.FixContent(context.Background(), noIDs, "a.js", "var a = 1")
+	assert.ErrorIs(t, err, linter.ErrNoRuleIDs)
+}
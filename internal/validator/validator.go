@@ -11,9 +11,11 @@ import (
 	"time"
 
 	"github.com/DevSymphony/sym-cli/internal/linter"
+	"github.com/DevSymphony/sym-cli/internal/linter/plugin"
 	"github.com/DevSymphony/sym-cli/internal/llm"
 	"github.com/DevSymphony/sym-cli/internal/roles"
 	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/internal/util/source"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
 )
 
@@ -37,7 +39,6 @@ type Violation struct {
 type Validator struct {
 	policy          *schema.CodePolicy
 	verbose         bool
-	linterRegistry  *linter.Registry
 	workDir         string
 	symDir          string // .sym directory for config files
 	ctx             context.Context
@@ -54,17 +55,17 @@ func NewValidator(policy *schema.CodePolicy, verbose bool) *Validator {
 	}
 
 	symDir := filepath.Join(workDir, ".sym")
+	reportPlugins(symDir, verbose)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 
 	return &Validator{
-		policy:         policy,
-		verbose:        verbose,
-		linterRegistry: linter.Global(),
-		workDir:        workDir,
-		symDir:         symDir,
-		ctx:            ctx,
-		ctxCancel:      cancel,
-		llmProvider:    nil,
+		policy:      policy,
+		verbose:     verbose,
+		workDir:     workDir,
+		symDir:      symDir,
+		ctx:         ctx,
+		ctxCancel:   cancel,
+		llmProvider: nil,
 	}
 }
 
@@ -72,20 +73,41 @@ func NewValidator(policy *schema.CodePolicy, verbose bool) *Validator {
 // symDir is automatically set to workDir/.sym
 func NewValidatorWithWorkDir(policy *schema.CodePolicy, verbose bool, workDir string) *Validator {
 	symDir := filepath.Join(workDir, ".sym")
+	reportPlugins(symDir, verbose)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 
 	return &Validator{
-		policy:         policy,
-		verbose:        verbose,
-		linterRegistry: linter.Global(),
-		workDir:        workDir,
-		symDir:         symDir,
-		ctx:            ctx,
-		ctxCancel:      cancel,
-		llmProvider:    nil,
+		policy:      policy,
+		verbose:     verbose,
+		workDir:     workDir,
+		symDir:      symDir,
+		ctx:         ctx,
+		ctxCancel:   cancel,
+		llmProvider: nil,
 	}
 }
 
+// reportPlugins loads the declarative linter plugins in symDir/linters and
+// reports them. Name conflicts are reported on stderr; the other plugins are
+// still registered.
+func reportPlugins(symDir string, verbose bool) {
+	_, names, err := plugin.NewRegistry(linter.Global(), symDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %v\n", err)
+	}
+	if verbose && len(names) > 0 {
+		fmt.Printf("🔌 Linter plugins: %s\n", strings.Join(names, ", "))
+	}
+}
+
+// linterRegistry returns the built-in linters plus the plugins in the
+// validator's .sym directory. Plugins never leak into other validators, and
+// edited specs are reloaded on the next call.
+func (v *Validator) linterRegistry() *linter.Registry {
+	registry, _, _ := plugin.NewRegistry(linter.Global(), v.symDir)
+	return registry
+}
+
 // SetLLMProvider sets the LLM provider for this validator
 func (v *Validator) SetLLMProvider(provider llm.Provider) {
 	v.llmProvider = provider
@@ -95,6 +117,11 @@ func (v *Validator) SetLLMProvider(provider llm.Provider) {
 	}
 }
 
+// Policy returns the code policy the validator checks.
+func (v *Validator) Policy() *schema.CodePolicy {
+	return v.policy
+}
+
 // getEngineName extracts the engine name from a rule
 func getEngineName(rule schema.PolicyRule) string {
 	if engine, ok := rule.Check["engine"].(string); ok {
@@ -199,12 +226,13 @@ func (v *Validator) groupRulesByEngine(rules []schema.PolicyRule, changes []git.
 // - LLM (parallel_api mode): 1 file × 1 rule = N units (OpenAI API)
 func (v *Validator) createExecutionUnits(groups map[string]*ruleGroup) []executionUnit {
 	var units []executionUnit
+	registry := v.linterRegistry()
 
 	for engineName, group := range groups {
 		if engineName == "llm-validator" {
 			units = append(units, v.createLLMExecutionUnits(group)...)
 		} else {
-			// Linter: all files + all rules = 1 unit
+			// Linter: all files + all rules = 1 unit (split into chunks on execution)
 			files := make([]string, 0, len(group.files))
 			for f := range group.files {
 				files = append(files, f)
@@ -213,9 +241,12 @@ func (v *Validator) createExecutionUnits(groups map[string]*ruleGroup) []executi
 				engineName: engineName,
 				rules:      group.rules,
 				files:      files,
-				registry:   v.linterRegistry,
+				registry:   registry,
 				symDir:     v.symDir,
 				verbose:    v.verbose,
+
+				mergeProjectConfig: v.policy.Enforce.MergeProjectConfig,
+				execution:          v.policy.Enforce.Execution,
 			})
 		}
 	}
@@ -330,12 +361,27 @@ func (v *Validator) executeUnitsParallel(ctx context.Context, units []executionU
 	return allViolations, allErrors
 }
 
+// ValidateOptions narrows a validation run.
+// The zero value validates every engine, including RBAC checks.
+type ValidateOptions struct {
+	// EngineFilter keeps only the rule groups whose engine it accepts (nil = all engines)
+	EngineFilter func(engine string) bool
+	// SkipRBAC skips the RBAC permission phase (e.g. when a previous pass already reported it)
+	SkipRBAC bool
+}
+
 // ValidateChanges validates git changes using adapters directly
 // Rules are grouped by engine for efficient batch execution:
 // - Linter rules (eslint, pylint, etc.) are batched into single executions per linter
 // - LLM rules are executed as individual units
 // Execution units run in parallel with CPU/2 concurrency (min 1, max 8)
 func (v *Validator) ValidateChanges(ctx context.Context, changes []git.Change) (*ValidationResult, error) {
+	return v.ValidateChangesWithOptions(ctx, changes, ValidateOptions{})
+}
+
+// ValidateChangesWithOptions runs the validation pipeline restricted by opts.
+// Used by watch mode to run linter and LLM engines on separate schedules.
+func (v *Validator) ValidateChangesWithOptions(ctx context.Context, changes []git.Change, opts ValidateOptions) (*ValidationResult, error) {
 	if v.policy == nil {
 		return nil, fmt.Errorf("policy is not loaded")
 	}
@@ -348,7 +394,7 @@ func (v *Validator) ValidateChanges(ctx context.Context, changes []git.Change) (
 	}
 
 	// Phase 1: Check RBAC permissions first
-	if v.policy.Enforce.RBACConfig != nil && v.policy.Enforce.RBACConfig.Enabled {
+	if !opts.SkipRBAC && v.policy.Enforce.RBACConfig != nil && v.policy.Enforce.RBACConfig.Enabled {
 		currentRole, err := roles.GetCurrentRole()
 		if err == nil && currentRole != "" {
 			if v.verbose {
@@ -383,6 +429,13 @@ func (v *Validator) ValidateChanges(ctx context.Context, changes []git.Change) (
 
 	// Phase 2: Group rules by engine
 	groups := v.groupRulesByEngine(v.policy.Rules, changes)
+	if opts.EngineFilter != nil {
+		for engineName := range groups {
+			if !opts.EngineFilter(engineName) {
+				delete(groups, engineName)
+			}
+		}
+	}
 
 	// Phase 3: Create execution units
 	units := v.createExecutionUnits(groups)
@@ -475,38 +528,8 @@ func (v *Validator) Close() error {
 	return nil
 }
 
-// getLanguageFromFile determines the programming language from a file path
+// getLanguageFromFile determines the programming language from a file path.
+// Dockerfiles are detected by name and extensionless scripts by their shebang.
 func getLanguageFromFile(filePath string) string {
-	ext := filepath.Ext(filePath)
-
-	switch ext {
-	case ".js", ".mjs", ".cjs":
-		return "javascript"
-	case ".ts", ".mts", ".cts":
-		return "typescript"
-	case ".jsx":
-		return "jsx"
-	case ".tsx":
-		return "tsx"
-	case ".go":
-		return "go"
-	case ".py":
-		return "python"
-	case ".java":
-		return "java"
-	case ".c", ".h":
-		return "c"
-	case ".cpp", ".cc", ".cxx", ".hpp", ".hh", ".hxx":
-		return "cpp"
-	case ".rs":
-		return "rust"
-	case ".rb":
-		return "ruby"
-	case ".php":
-		return "php"
-	case ".sh", ".bash":
-		return "shell"
-	default:
-		return ""
-	}
+	return source.DetectLanguage(filePath)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/DevSymphony/sym-cli/internal/util/git"
 	"github.com/DevSymphony/sym-cli/pkg/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetEngineName(t *testing.T) {
@@ -102,6 +103,12 @@ func TestGetLanguageFromFile(t *testing.T) {
 		// Shell
 		{"sh file", "script.sh", "shell"},
 		{"bash file", "script.bash", "shell"},
+		// Stylesheets
+		{"css file", "styles/app.css", "css"},
+		{"scss file", "styles/_vars.scss", "scss"},
+		// Dockerfile
+		{"dockerfile", "Dockerfile", "dockerfile"},
+		{"dockerfile variant", "build/Dockerfile.dev", "dockerfile"},
 		// Unknown/unsupported
 		{"unknown extension", "file.xyz", ""},
 		{"no extension", "Makefile", ""},
@@ -326,6 +333,30 @@ func TestFindPolicyRule(t *testing.T) {
 	})
 }
 
+func TestFindPolicyRule_NativeRuleIDs(t *testing.T) {
+	rules := []schema.PolicyRule{
+		{ID: "SEC-1-semgrep", Check: map[string]interface{}{"ruleIds": []interface{}{"no-eval", "no-exec"}}},
+		{ID: "SEC-2-semgrep", Check: map[string]interface{}{"ruleIds": []string{"hardcoded-secret"}}},
+	}
+	unit := &linterExecutionUnit{rules: rules}
+
+	tests := []struct {
+		reported string
+		want     string
+	}{
+		{"no-exec", "SEC-1-semgrep"},
+		{"hardcoded-secret", "SEC-2-semgrep"},
+		{".sym.semgrep.hardcoded-secret", "SEC-2-semgrep"}, // path-qualified ID
+		{"rules/no-eval", "SEC-1-semgrep"},
+		{"not-hardcoded-secret", "SEC-1-semgrep"}, // no match: first rule
+	}
+	for _, tt := range tests {
+		result := unit.findPolicyRule(tt.reported)
+		require.NotNil(t, result, tt.reported)
+		assert.Equal(t, tt.want, result.ID, tt.reported)
+	}
+}
+
 func TestMapViolationsToRules(t *testing.T) {
 	rules := []schema.PolicyRule{
 		{ID: "policy-rule-1", Severity: "warning", Check: map[string]interface{}{"ruleId": "no-console"}},
@@ -608,6 +639,7 @@ func TestAgenticLLMExecutionUnit_BuildPrompt(t *testing.T) {
 	rules := []schema.PolicyRule{
 		{ID: "security-1", Severity: "error", Desc: "No hardcoded secrets", Category: "security"},
 		{ID: "style-1", Severity: "warning", Desc: "Use const instead of let", When: &schema.Selector{Languages: []string{"javascript"}}},
+		{ID: "len-1", Severity: "warning", Desc: "Keep lines short", Check: map[string]any{"engine": "llm-validator", "params": map[string]any{"max": float64(100)}}},
 	}
 	changes := []git.Change{
 		{FilePath: "app.js", Status: "M", Diff: "+const API_KEY = 'secret123';"},
@@ -632,6 +664,7 @@ func TestAgenticLLMExecutionUnit_BuildPrompt(t *testing.T) {
 	assert.Contains(t, prompt, "No hardcoded secrets")
 	assert.Contains(t, prompt, "style-1")
 	assert.Contains(t, prompt, "Use const instead of let")
+	assert.Contains(t, prompt, "Parameters (use these exact values): max: 100")
 
 	// Check files are included
 	assert.Contains(t, prompt, "app.js")
@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// WatchScope identifies which engines produced a set of results in watch mode.
+// Linter and LLM engines run on different schedules, so their results are
+// replaced independently.
+type WatchScope int
+
+const (
+	// WatchScopeLinter covers every engine except llm-validator
+	WatchScopeLinter WatchScope = iota
+	// WatchScopeLLM covers llm-validator
+	WatchScopeLLM
+)
+
+// LinterEnginesOnly is a ValidateOptions.EngineFilter that excludes llm-validator.
+func LinterEnginesOnly(engine string) bool {
+	return engine != "llm-validator"
+}
+
+// LLMEngineOnly is a ValidateOptions.EngineFilter that keeps only llm-validator.
+func LLMEngineOnly(engine string) bool {
+	return engine == "llm-validator"
+}
+
+// WatchState accumulates per-file validation results across incremental runs.
+//
+// Each run only re-validates the files that changed, so the state keeps the
+// last known violations of every other file and replaces only the entries of
+// the re-validated files for the scope that ran.
+type WatchState struct {
+	mu         sync.Mutex
+	root       string
+	files      map[string]bool
+	violations map[WatchScope]map[string][]Violation
+	errors     map[WatchScope][]ValidationError
+}
+
+// NewWatchState creates an empty state. Absolute violation paths are made
+// relative to root so they match the git-relative paths used for updates.
+func NewWatchState(root string) *WatchState {
+	return &WatchState{
+		root:  root,
+		files: make(map[string]bool),
+		violations: map[WatchScope]map[string][]Violation{
+			WatchScopeLinter: {},
+			WatchScopeLLM:    {},
+		},
+		errors: make(map[WatchScope][]ValidationError),
+	}
+}
+
+// Update replaces the scope's results for files with those in result.
+// Engine errors of the scope are replaced by the errors of this run.
+func (s *WatchState) Update(scope WatchScope, files []string, result *ValidationResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byFile := s.violations[scope]
+	for _, f := range files {
+		f = s.normalize(f)
+		s.files[f] = true
+		delete(byFile, f)
+	}
+
+	if result == nil {
+		s.errors[scope] = nil
+		return
+	}
+
+	for _, v := range result.Violations {
+		f := s.normalize(v.File)
+		byFile[f] = append(byFile[f], v)
+	}
+	s.errors[scope] = result.Errors
+}
+
+// Forget drops all results for files that are no longer changed (reverted or deleted).
+func (s *WatchState) Forget(files []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range files {
+		f = s.normalize(f)
+		delete(s.files, f)
+		for _, byFile := range s.violations {
+			delete(byFile, f)
+		}
+	}
+}
+
+// Files returns the tracked files in sorted order.
+func (s *WatchState) Files() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files := make([]string, 0, len(s.files))
+	for f := range s.files {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Summary merges both scopes into a single result.
+// Violations are ordered by file, then line.
+func (s *WatchState) Summary() *ValidationResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &ValidationResult{
+		Violations: make([]Violation, 0),
+	}
+
+	failedFiles := make(map[string]bool)
+	for _, scope := range []WatchScope{WatchScopeLinter, WatchScopeLLM} {
+		for f, violations := range s.violations[scope] {
+			if len(violations) == 0 {
+				continue
+			}
+			result.Violations = append(result.Violations, violations...)
+			failedFiles[f] = true
+		}
+		result.Errors = append(result.Errors, s.errors[scope]...)
+	}
+
+	sort.SliceStable(result.Violations, func(i, j int) bool {
+		a, b := result.Violations[i], result.Violations[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+
+	checked := len(s.files)
+	for f := range failedFiles {
+		if !s.files[f] {
+			checked++
+		}
+	}
+	result.Checked = checked
+	result.Failed = len(failedFiles)
+	result.Passed = result.Checked - result.Failed
+
+	return result
+}
+
+// normalize converts a path to the root-relative form used as map key.
+func (s *WatchState) normalize(path string) string {
+	path = filepath.Clean(path)
+	if filepath.IsAbs(path) && s.root != "" {
+		if rel, err := filepath.Rel(s.root, path); err == nil {
+			path = rel
+		}
+	}
+	return filepath.ToSlash(path)
+}
@@ -0,0 +1,192 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/DevSymphony/sym-cli/internal/llm"
+	"github.com/DevSymphony/sym-cli/internal/policy"
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+)
+
+// Workspace validates a repository whose packages may each have their own .sym directory.
+//
+// Every change is validated with the code policy and linter configs of its
+// nearest .sym ancestor, and the results of all packages are merged.
+// A repository with a single root .sym behaves exactly like a Validator.
+type Workspace struct {
+	repoRoot    string
+	verbose     bool
+	packages    []policy.Package
+	validators  map[string]*Validator // by package Dir
+	loadErrors  map[string]error      // by package Dir
+	llmProvider llm.Provider
+}
+
+// NewWorkspace discovers the packages under repoRoot and loads their code policies.
+// Packages without a readable code-policy.json are kept and reported as
+// validation errors when they have changes.
+func NewWorkspace(repoRoot string, verbose bool) (*Workspace, error) {
+	packages, err := policy.FindPackages(repoRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find .sym directories: %w", err)
+	}
+
+	w := &Workspace{
+		repoRoot:   repoRoot,
+		verbose:    verbose,
+		packages:   packages,
+		validators: make(map[string]*Validator),
+		loadErrors: make(map[string]error),
+	}
+
+	loader := policy.NewLoader(verbose)
+	for _, pkg := range packages {
+		codePolicy, err := loader.LoadCodePolicy(pkg.CodePolicyPath())
+		if err != nil {
+			w.loadErrors[pkg.Dir] = err
+			continue
+		}
+		w.validators[pkg.Dir] = w.newPackageValidator(pkg, codePolicy)
+	}
+
+	return w, nil
+}
+
+// Packages returns the discovered packages sorted by directory.
+func (w *Workspace) Packages() []policy.Package {
+	return w.packages
+}
+
+// HasPolicies reports whether at least one package has a loaded code policy.
+func (w *Workspace) HasPolicies() bool {
+	return len(w.validators) > 0
+}
+
+// SetPolicy replaces the code policy of the package at dir (e.g. a --policy override
+// for the root package). The package is added if it was not discovered.
+func (w *Workspace) SetPolicy(dir string, codePolicy *schema.CodePolicy) {
+	pkg, ok := policy.FindPackage(w.packages, dir)
+	if !ok {
+		pkg = policy.Package{Dir: filepath.ToSlash(filepath.Clean(dir)), SymDir: filepath.Join(w.repoRoot, dir, ".sym")}
+		w.packages = append(w.packages, pkg)
+		sort.Slice(w.packages, func(i, j int) bool { return w.packages[i].Dir < w.packages[j].Dir })
+	}
+
+	if old := w.validators[pkg.Dir]; old != nil {
+		_ = old.Close()
+	}
+	delete(w.loadErrors, pkg.Dir)
+	w.validators[pkg.Dir] = w.newPackageValidator(pkg, codePolicy)
+}
+
+// SetLLMProvider sets the LLM provider for llm-validator rules of every package.
+func (w *Workspace) SetLLMProvider(provider llm.Provider) {
+	w.llmProvider = provider
+	for _, v := range w.validators {
+		v.SetLLMProvider(provider)
+	}
+}
+
+// ValidatorFor returns the validator of filePath's nearest package.
+// It reports false when the file is outside every package or its package has no code policy.
+func (w *Workspace) ValidatorFor(filePath string) (*Validator, bool) {
+	pkg, ok := policy.NearestPackage(w.packages, filePath)
+	if !ok {
+		return nil, false
+	}
+	v := w.validators[pkg.Dir]
+	return v, v != nil
+}
+
+// ValidateChanges validates changes, each with the policy of its nearest package.
+func (w *Workspace) ValidateChanges(ctx context.Context, changes []git.Change) (*ValidationResult, error) {
+	return w.ValidateChangesWithOptions(ctx, changes, ValidateOptions{})
+}
+
+// ValidateChangesWithOptions groups changes by nearest package, validates each
+// group with that package's validator and merges the results.
+// Changes outside every package are not validated.
+func (w *Workspace) ValidateChangesWithOptions(ctx context.Context, changes []git.Change, opts ValidateOptions) (*ValidationResult, error) {
+	groups := make(map[string][]git.Change)
+	for _, change := range changes {
+		pkg, ok := policy.NearestPackage(w.packages, change.FilePath)
+		if !ok {
+			if w.verbose {
+				fmt.Printf("   [skip] %s: no .sym directory in any parent\n", change.FilePath)
+			}
+			continue
+		}
+		groups[pkg.Dir] = append(groups[pkg.Dir], change)
+	}
+
+	dirs := make([]string, 0, len(groups))
+	for dir := range groups {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	results := make([]*ValidationResult, 0, len(dirs))
+	for _, dir := range dirs {
+		v := w.validators[dir]
+		if v == nil {
+			results = append(results, &ValidationResult{
+				Errors: []ValidationError{{
+					Engine:  "policy",
+					Message: fmt.Sprintf("package %s has no code policy (%v): run 'sym convert --package %s'", dir, w.loadErrors[dir], dir),
+				}},
+			})
+			continue
+		}
+
+		if w.verbose && len(w.packages) > 1 {
+			fmt.Printf("📦 Package %s: %d changed file(s)\n", dir, len(groups[dir]))
+		}
+
+		result, err := v.ValidateChangesWithOptions(ctx, groups[dir], opts)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", dir, err)
+		}
+		results = append(results, result)
+	}
+
+	return MergeResults(results...), nil
+}
+
+// Close releases the resources of every package validator.
+func (w *Workspace) Close() error {
+	for _, v := range w.validators {
+		_ = v.Close()
+	}
+	return nil
+}
+
+// newPackageValidator creates a validator reading linter configs from the package's .sym.
+func (w *Workspace) newPackageValidator(pkg policy.Package, codePolicy *schema.CodePolicy) *Validator {
+	v := NewValidatorWithWorkDir(codePolicy, w.verbose, filepath.Dir(pkg.SymDir))
+	if w.llmProvider != nil {
+		v.SetLLMProvider(w.llmProvider)
+	}
+	return v
+}
+
+// MergeResults combines results of disjoint file sets into one result.
+func MergeResults(results ...*ValidationResult) *ValidationResult {
+	merged := &ValidationResult{
+		Violations: make([]Violation, 0),
+	}
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		merged.Violations = append(merged.Violations, r.Violations...)
+		merged.Errors = append(merged.Errors, r.Errors...)
+		merged.Checked += r.Checked
+		merged.Passed += r.Passed
+		merged.Failed += r.Failed
+	}
+	return merged
+}
@@ -0,0 +1,156 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DevSymphony/sym-cli/internal/util/git"
+	"github.com/DevSymphony/sym-cli/pkg/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCodePolicy writes a code policy with one rule for engine into dir/.sym.
+func writeCodePolicy(t *testing.T, dir, engine string) {
+	t.Helper()
+	policy := schema.CodePolicy{
+		Version: "1.0.0",
+		Rules: []schema.PolicyRule{{
+			ID:      engine + "-rule",
+			Enabled: true,
+			Check:   map[string]interface{}{"engine": engine},
+		}},
+	}
+	data, err := json.Marshal(policy)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".sym"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".sym", "code-policy.json"), data, 0o644))
+}
+
+func errorEngines(result *ValidationResult) []string {
+	var engines []string
+	for _, e := range result.Errors {
+		engines = append(engines, e.Engine)
+	}
+	return engines
+}
+
+func TestWorkspace_ValidatesWithNearestPackage(t *testing.T) {
+	root := t.TempDir()
+	writeCodePolicy(t, root, "root-engine")
+	writeCodePolicy(t, filepath.Join(root, "services", "api"), "api-engine")
+
+	ws, err := NewWorkspace(root, false)
+	require.NoError(t, err)
+	defer func() { _ = ws.Close() }()
+
+	require.Len(t, ws.Packages(), 2)
+	assert.True(t, ws.HasPolicies())
+
+	// Unregistered engines report an error per unit, which shows which policy was applied
+	result, err := ws.ValidateChanges(context.Background(), []git.Change{
+		{FilePath: "services/api/main.go", Status: "M"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"api-engine"}, errorEngines(result))
+
+	result, err = ws.ValidateChanges(context.Background(), []git.Change{
+		{FilePath: "services/web/main.go", Status: "M"},
+		{FilePath: "services/api/main.go", Status: "M"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"root-engine", "api-engine"}, errorEngines(result))
+	assert.Equal(t, 2, result.Checked)
+}
+
+func TestWorkspace_PackageWithoutCodePolicy(t *testing.T) {
+	root := t.TempDir()
+	writeCodePolicy(t, root, "root-engine")
+	webSym := filepath.Join(root, "web", ".sym")
+	require.NoError(t, os.MkdirAll(webSym, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(webSym, "user-policy.json"), []byte(`{"version":"1.0.0"}`), 0o644))
+
+	ws, err := NewWorkspace(root, false)
+	require.NoError(t, err)
+	defer func() { _ = ws.Close() }()
+
+	result, err := ws.ValidateChanges(context.Background(), []git.Change{
+		{FilePath: "web/app.js", Status: "A"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "policy", result.Errors[0].Engine)
+	assert.Contains(t, result.Errors[0].Message, "sym convert --package web")
+}
+
+func TestWorkspace_SetPolicy(t *testing.T) {
+	root := t.TempDir()
+
+	ws, err := NewWorkspace(root, false)
+	require.NoError(t, err)
+	defer func() { _ = ws.Close() }()
+	assert.False(t, ws.HasPolicies())
+
+	ws.SetPolicy(".", &schema.CodePolicy{Rules: []schema.PolicyRule{{
+		ID:      "override",
+		Enabled: true,
+		Check:   map[string]interface{}{"engine": "override-engine"},
+	}}})
+	assert.True(t, ws.HasPolicies())
+
+	result, err := ws.ValidateChanges(context.Background(), []git.Change{
+		{FilePath: "main.go", Status: "M"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"override-engine"}, errorEngines(result))
+}
+
+func TestMergeResults(t *testing.T) {
+	merged := MergeResults(
+		&ValidationResult{Violations: []Violation{{RuleID: "A"}}, Checked: 2, Passed: 1, Failed: 1},
+		nil,
+		&ValidationResult{Errors: []ValidationError{{RuleID: "B"}}, Checked: 3, Passed: 3},
+	)
+
+	assert.Len(t, merged.Violations, 1)
+	assert.Len(t, merged.Errors, 1)
+	assert.Equal(t, 5, merged.Checked)
+	assert.Equal(t, 4, merged.Passed)
+	assert.Equal(t, 1, merged.Failed)
+}
+
+func TestWorkspace_PluginsScopedToPackage(t *testing.T) {
+	root := t.TempDir()
+	writeCodePolicy(t, root, "root-engine")
+	apiDir := filepath.Join(root, "services", "api")
+	writeCodePolicy(t, apiDir, "api-engine")
+	pluginDir := filepath.Join(apiDir, ".sym", "linters")
+	require.NoError(t, os.MkdirAll(pluginDir, 0o755))
+	spec := `{"name": "api-checks", "command": "check", "languages": ["%s"], "output": {"format": "sarif"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "api.json"), []byte(fmt.Sprintf(spec, "go")), 0o644))
+
+	ws, err := NewWorkspace(root, false)
+	require.NoError(t, err)
+	defer func() { _ = ws.Close() }()
+
+	api, ok := ws.ValidatorFor("services/api/main.go")
+	require.True(t, ok)
+	web, ok := ws.ValidatorFor("services/web/main.go")
+	require.True(t, ok)
+
+	l, err := api.linterRegistry().GetLinter("api-checks")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go"}, l.GetCapabilities().SupportedLanguages)
+	_, err = web.linterRegistry().GetLinter("api-checks")
+	assert.Error(t, err, "plugins do not leak into other packages")
+
+	// Edits to the spec are picked up by the same validator
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "api.json"), []byte(fmt.Sprintf(spec, "python")), 0o644))
+	l, err = api.linterRegistry().GetLinter("api-checks")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"python"}, l.GetCapabilities().SupportedLanguages)
+}
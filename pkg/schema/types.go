@@ -9,6 +9,8 @@ type CategoryDef struct {
 // UserPolicy represents the user-friendly policy schema (A schema)
 type UserPolicy struct {
 	Version  string        `json:"version,omitempty"`
+	Extends  []string      `json:"extends,omitempty"`  // Parent policies (paths relative to this file, or directories)
+	Disable  []string      `json:"disable,omitempty"`  // Inherited rule IDs to drop
 	Category []CategoryDef `json:"category,omitempty"` // User-defined categories with descriptions
 	RBAC     *UserRBAC     `json:"rbac,omitempty"`
 	Defaults *UserDefaults `json:"defaults,omitempty"`
@@ -37,6 +39,31 @@ type UserDefaults struct {
 	Exclude         []string `json:"exclude,omitempty"`
 	Severity        string   `json:"severity,omitempty"`
 	Autofix         bool     `json:"autofix,omitempty"`
+	// MergeProjectConfig layers generated linter configs on top of the
+	// project's existing configs (.eslintrc, tsconfig.json, ...) instead of replacing them
+	MergeProjectConfig bool `json:"mergeProjectConfig,omitempty"`
+	// Execution splits linter runs over large file sets into chunks
+	Execution *ExecutionSettings `json:"execution,omitempty"`
+}
+
+// ExecutionSettings controls how a linter run over many files is split into chunks.
+// Chunks always respect the OS argument length limit.
+type ExecutionSettings struct {
+	// ChunkSize is the max number of files per tool invocation (0 = no file count limit)
+	ChunkSize int `json:"chunkSize,omitempty"`
+	// Concurrency is the max number of chunks of one tool run in parallel (0 = CPU/2, max 8)
+	Concurrency int `json:"concurrency,omitempty"`
+	// FileList passes files via tool-native file lists where supported (e.g., PMD --file-list)
+	FileList bool `json:"fileList,omitempty"`
+	// Tools overrides the settings per linter (e.g., "pmd", "checkstyle")
+	Tools map[string]ToolExecution `json:"tools,omitempty"`
+}
+
+// ToolExecution overrides ExecutionSettings for one linter. Zero values keep the defaults.
+type ToolExecution struct {
+	ChunkSize   int   `json:"chunkSize,omitempty"`
+	Concurrency int   `json:"concurrency,omitempty"`
+	FileList    *bool `json:"fileList,omitempty"`
 }
 
 // UserRule represents a single rule in user schema
@@ -52,6 +79,20 @@ type UserRule struct {
 	Params    map[string]any `json:"params,omitempty"`
 	Message   string         `json:"message,omitempty"`
 	Example   string         `json:"example,omitempty"`
+	Examples  *RuleExamples  `json:"examples,omitempty"`
+
+	// Routing overrides; set any of them to bypass LLM routing
+	Engines []string       `json:"engines,omitempty"` // Engines that enforce the rule, instead of LLM routing
+	Native  map[string]any `json:"native,omitempty"`  // Engine -> native rule config, used as is without the LLM
+	NoLLM   bool           `json:"noLlm,omitempty"`   // Never enforce with llm-validator, not even as a fallback
+}
+
+// RuleExamples holds code snippets that comply with (good) and violate (bad)
+// a rule. `sym convention test` checks converted rules against them.
+type RuleExamples struct {
+	Language string   `json:"language,omitempty"` // Snippet language (default: first rule language)
+	Good     []string `json:"good,omitempty"`
+	Bad      []string `json:"bad,omitempty"`
 }
 
 // CodePolicy represents the formal validation schema (B schema)
@@ -138,6 +179,10 @@ type EnforceSettings struct {
 	Stages     []string     `json:"stages"`
 	FailOn     []string     `json:"fail_on,omitempty"`
 	RBACConfig *RBACEnforce `json:"rbac,omitempty"`
+	// MergeProjectConfig runs linters with the project's own configs merged in
+	MergeProjectConfig bool `json:"merge_project_config,omitempty"`
+	// Execution splits linter runs into chunks (copied from defaults.execution)
+	Execution *ExecutionSettings `json:"execution,omitempty"`
 }
 
 // RBACEnforce represents RBAC enforcement settings